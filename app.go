@@ -3,27 +3,26 @@ package main
 import (
 	"Sector/internal/api"
 	v1 "Sector/internal/api/v1"
+	"Sector/internal/config"
 	"context"
+	"errors"
 	"fmt"
 	"log"
-	"net"
-	"net/http"
-
-	"github.com/rs/cors"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
-	"github.com/gorilla/mux"
 )
 
 // App struct
 type App struct {
 	ctx    context.Context
-	server http.Server
+	config config.Config
+	server *api.Server
 }
 
 // NewApp creates a new App application struct
-func NewApp() *App {
-	return &App{}
+func NewApp(cfg config.Config) *App {
+	return &App{config: cfg}
 }
 
 // startup is called when the app starts. The context is saved
@@ -33,28 +32,33 @@ func (a *App) startup(ctx context.Context) {
 	// Startup the Database instance here
 	// Startup the API interfaces here
 
-	r := mux.NewRouter().StrictSlash(true)
-	sectorAPI := v1.NewSector(context.WithoutCancel(openapi3.NewLoader().Context), "log.txt", "cache")
-	api.AddV1SectorAPIToRouter(r, sectorAPI)
-
-	c := cors.New(cors.Options{
-		AllowedOrigins:   []string{"http://localhost:8000"},
-		AllowCredentials: true,
-	})
-
-	// Serve HTTP
-	a.server = http.Server{
-		Handler: c.Handler(r),
-		Addr:    net.JoinHostPort("127.0.0.1", "3000"),
-	}
+	sectorAPI := v1.OpenSector(context.WithoutCancel(openapi3.NewLoader().Context), a.config)
+	a.server = api.NewServer(sectorAPI, a.config)
 
-	// Start the http server
+	// Start the http server, it serves the health checks while the stores load
 	go func() {
-		log.Println("Starting server on :3000")
+		log.Println("Starting server on", a.server.Address())
 		if err := a.server.ListenAndServe(); err != nil {
 			log.Fatal(err)
 		}
 	}()
+	go func() {
+		if err := sectorAPI.Connect(); err != nil && !errors.Is(err, v1.ErrClosed) {
+			log.Fatal(err)
+		}
+	}()
+}
+
+// shutdown is called when the app is closing, it stops the server and disconnects from the database
+func (a *App) shutdown(ctx context.Context) {
+	if a.server == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	if err := a.server.Shutdown(ctx); err != nil {
+		log.Println("Error shutting down server:", err)
+	}
 }
 
 // Greet returns a greeting for the given name
@@ -0,0 +1,72 @@
+// Command sectord runs a Sector node without the desktop app, serving the API until it is sent SIGINT or SIGTERM.
+package main
+
+import (
+	"Sector/internal/api"
+	v1 "Sector/internal/api/v1"
+	"Sector/internal/config"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+func main() {
+	load := config.AddFlags(flag.CommandLine)
+	timeout := flag.Duration("shutdown-timeout", 10*time.Second, "how long to wait for requests to finish when stopping")
+	flag.Parse()
+
+	cfg, err := load()
+	if err != nil {
+		log.Fatalln("Error:", err.Error())
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// Serve the health checks while the stores load, so the node can be seen to be starting rather than down
+	sectorAPI := v1.OpenSector(context.Background(), cfg)
+	server := api.NewServer(sectorAPI, cfg)
+
+	errs := make(chan error, 2)
+	connecting := make(chan struct{})
+	go func() {
+		log.Println("Starting server on", server.Address())
+		if err := server.ListenAndServe(); err != nil {
+			errs <- err
+		}
+	}()
+	go func() {
+		defer close(connecting)
+		// Closing the API while it connects makes it disconnect once the stores have loaded, rather than fail
+		if err := sectorAPI.Connect(); err != nil && !errors.Is(err, v1.ErrClosed) {
+			errs <- fmt.Errorf("cannot connect to the database: %w", err)
+		}
+	}()
+
+	// Stop taking requests and close the API, see api.Server.Shutdown, then wait for the database to be disconnected
+	shutdown := func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), *timeout)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			log.Println("Error shutting down server:", err.Error())
+		}
+		<-connecting
+	}
+
+	select {
+	case err := <-errs:
+		log.Println("Error:", err.Error())
+		shutdown()
+		os.Exit(1)
+	case <-ctx.Done():
+		log.Println("Shutting down")
+		stop()
+		shutdown()
+	}
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	v1 "Sector/internal/api/v1"
+	"Sector/internal/config"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+)
+
+// The commands that can be run instead of the app, by giving their name as the first argument
+var commands = map[string]func(args []string) error{
+	"integrity": integrityCommand,
+}
+
+// Run the command named by the arguments, reporting false if they do not name one so that the app starts instead
+func runCommand(args []string) bool {
+	if len(args) == 0 {
+		return false
+	}
+	command, ok := commands[args[0]]
+	if !ok {
+		return false
+	}
+
+	if err := command(args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err.Error())
+		os.Exit(1)
+	}
+	return true
+}
+
+// Check the references between the items in the database, repairing them with -repair, and print the report
+func integrityCommand(args []string) error {
+	flags := flag.NewFlagSet("integrity", flag.ContinueOnError)
+	repair := flags.Bool("repair", false, "remove missing members from groups and quarantine orphaned channels and messages")
+	load := config.AddFlags(flags)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	cfg, err := load()
+	if err != nil {
+		return err
+	}
+
+	sectorAPI := v1.NewSector(context.Background(), cfg)
+	defer sectorAPI.Close()
+
+	report, err := v1.ScanIntegrity(context.Background(), sectorAPI.DB, *repair)
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
@@ -28,7 +28,11 @@ export interface paths {
             path?: never;
             cookie?: never;
         };
-        /** Health Check */
+        /**
+         * Health Check
+         * @deprecated
+         * @description The same as the liveness check, kept for clients from before there was a readiness check.
+         */
         get: operations["GetHealth"];
         put?: never;
         post?: never;
@@ -38,6 +42,46 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
+    "/health/live": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /**
+         * Liveness Check
+         * @description Succeeds whenever the node is serving requests, whether or not its database is ready.
+         */
+        get: operations["GetLiveness"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/health/ready": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /**
+         * Readiness Check
+         * @description Reports the state of the stores of the database and of the IPFS node under them. The node is ready once every store has been loaded and has reported it is ready, the IPFS node is online with its repo readable, and enough peers are connected. The health checks are served while the stores are still loading, every other request is answered 503 until they have.
+         */
+        get: operations["GetReadiness"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
     "/challenge": {
         parameters: {
             query?: never;
@@ -72,6 +116,40 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
+    "/refresh": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /** Swap a refresh token for new tokens, each refresh token can only be used once */
+        post: operations["RefreshToken"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/logout": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /** End the session of the token used, revoking every token issued in it */
+        post: operations["Logout"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
     "/account/": {
         parameters: {
             query?: never;
@@ -81,7 +159,7 @@ export interface paths {
         };
         get?: never;
         put?: never;
-        /** Create an account */
+        /** Create an account, signing the challenge from /account/challenge with its key */
         post: {
             parameters: {
                 query?: never;
@@ -92,7 +170,7 @@ export interface paths {
             /** @description Account details to add. */
             requestBody: {
                 content: {
-                    "application/json": components["schemas"]["Account"];
+                    "application/json": components["schemas"]["NewAccount"];
                 };
             };
             responses: {
@@ -105,6 +183,70 @@ export interface paths {
                         "application/json": components["schemas"]["Account"];
                     };
                 };
+                /** @description The username is taken. */
+                409: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
+                };
+                default: components["responses"]["Problem"];
+            };
+        };
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/account/challenge": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /** Get the challenge to sign to create an account */
+        post: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path?: never;
+                cookie?: never;
+            };
+            /** @description The username and key of the account to create. */
+            requestBody: {
+                content: {
+                    "application/json": components["schemas"]["RegistrationChallengeRequest"];
+                };
+            };
+            responses: {
+                /** @description Challenge issued */
+                200: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": {
+                            /** Format: base64 */
+                            challenge: string;
+                        };
+                    };
+                };
+                /** @description The username is taken. */
+                409: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
+                };
+                default: components["responses"]["Problem"];
             };
         };
         delete?: never;
@@ -142,6 +284,7 @@ export interface paths {
                         "application/json": components["schemas"]["Account"];
                     };
                 };
+                default: components["responses"]["Problem"];
             };
         };
         /** Update Account By ID */
@@ -171,6 +314,7 @@ export interface paths {
                         "application/json": components["schemas"]["Account"];
                     };
                 };
+                default: components["responses"]["Problem"];
             };
         };
         post?: never;
@@ -194,6 +338,167 @@ export interface paths {
                     };
                     content?: never;
                 };
+                default: components["responses"]["Problem"];
+            };
+        };
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/account/{id}/keys": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List the device keys of an account */
+        get: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path: {
+                    /** @description ID of account to list the keys of. */
+                    id: string;
+                };
+                cookie?: never;
+            };
+            requestBody?: never;
+            responses: {
+                /** @description The device keys of the account. */
+                200: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": components["schemas"]["DeviceKey"][];
+                    };
+                };
+                default: components["responses"]["Problem"];
+            };
+        };
+        put?: never;
+        /** Add a device key to an account, authorized by signatures of its challenge from an existing key and the new key */
+        post: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path: {
+                    /** @description ID of account to add the key to. */
+                    id: string;
+                };
+                cookie?: never;
+            };
+            /** @description The key to add. */
+            requestBody: {
+                content: {
+                    "application/json": components["schemas"]["NewDeviceKey"];
+                };
+            };
+            responses: {
+                /** @description The key that was added. */
+                201: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": components["schemas"]["DeviceKey"];
+                    };
+                };
+                default: components["responses"]["Problem"];
+            };
+        };
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/account/{id}/keys/challenge": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /**
+         * Get the challenge to sign to add a device key to an account
+         * @description The challenge can only be used to add the key it was issued for, and has to be signed by both one of the existing keys of the account and the new key.
+         */
+        post: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path: {
+                    /** @description ID of account to add the key to. */
+                    id: string;
+                };
+                cookie?: never;
+            };
+            /** @description The key to add. */
+            requestBody: {
+                content: {
+                    "application/json": components["schemas"]["DeviceChallengeRequest"];
+                };
+            };
+            responses: {
+                /** @description Challenge issued */
+                200: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": {
+                            /** Format: base64 */
+                            challenge: string;
+                        };
+                    };
+                };
+                default: components["responses"]["Problem"];
+            };
+        };
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/account/{id}/keys/{keyId}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        post?: never;
+        /** Revoke a device key, ending every session that was signed in with it */
+        delete: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path: {
+                    /** @description ID of account to revoke the key of. */
+                    id: string;
+                    /** @description ID of key to revoke. */
+                    keyId: string;
+                };
+                cookie?: never;
+            };
+            requestBody?: never;
+            responses: {
+                /** @description Key was revoked. */
+                204: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content?: never;
+                };
+                default: components["responses"]["Problem"];
             };
         };
         options?: never;
@@ -231,9 +536,10 @@ export interface paths {
                         [name: string]: unknown;
                     };
                     content: {
-                        "application/json": components["schemas"]["Account"][];
+                        "application/json": components["schemas"]["AccountPage"];
                     };
                 };
+                default: components["responses"]["Problem"];
             };
         };
         delete?: never;
@@ -275,6 +581,7 @@ export interface paths {
                         "application/json": components["schemas"]["Group"];
                     };
                 };
+                default: components["responses"]["Problem"];
             };
         };
         delete?: never;
@@ -313,9 +620,10 @@ export interface paths {
                         [name: string]: unknown;
                     };
                     content: {
-                        "application/json": components["schemas"]["Group"][];
+                        "application/json": components["schemas"]["GroupPage"];
                     };
                 };
+                default: components["responses"]["Problem"];
             };
         };
         delete?: never;
@@ -353,6 +661,7 @@ export interface paths {
                         "application/json": components["schemas"]["Group"];
                     };
                 };
+                default: components["responses"]["Problem"];
             };
         };
         /** Update Group By ID */
@@ -382,6 +691,7 @@ export interface paths {
                         "application/json": components["schemas"]["Group"];
                     };
                 };
+                default: components["responses"]["Problem"];
             };
         };
         post?: never;
@@ -398,13 +708,18 @@ export interface paths {
             };
             requestBody?: never;
             responses: {
-                /** @description Group was deleted. */
-                204: {
+                /** @description The group is being deleted, along with its channels and their messages. The deletion carries on in the background, follow it through the job. */
+                202: {
                     headers: {
+                        /** @description Where the status of the job can be followed. */
+                        Location?: string;
                         [name: string]: unknown;
                     };
-                    content?: never;
+                    content: {
+                        "application/json": components["schemas"]["Job"];
+                    };
                 };
+                default: components["responses"]["Problem"];
             };
         };
         options?: never;
@@ -458,7 +773,7 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-    "/channel/search": {
+    "/group/{groupId}/members/{memberId}/role": {
         parameters: {
             query?: never;
             header?: never;
@@ -466,40 +781,154 @@ export interface paths {
             cookie?: never;
         };
         get?: never;
-        put?: never;
-        /** Search for channels satisfying various properties. */
-        post: {
+        /** Assign a role to a member of a group */
+        put: {
             parameters: {
                 query?: never;
                 header?: never;
-                path?: never;
+                path: {
+                    /** @description ID of group the member is in. */
+                    groupId: string;
+                    /** @description ID of member to assign the role to. */
+                    memberId: string;
+                };
                 cookie?: never;
             };
-            /** @description Channel searching parameters. */
+            /** @description Role to assign, ownership can only be given with a transfer. */
             requestBody: {
                 content: {
-                    "application/json": components["schemas"]["ChannelFilter"];
+                    "application/json": components["schemas"]["GroupRoleAssignment"];
                 };
             };
             responses: {
-                /** @description Query completed successfully. */
-                200: {
+                /** @description Updated group with the member's new role. */
+                201: {
                     headers: {
                         [name: string]: unknown;
                     };
                     content: {
-                        "application/json": components["schemas"]["Channel"][];
+                        "application/json": components["schemas"]["Group"];
                     };
                 };
+                default: components["responses"]["Problem"];
             };
         };
-        delete?: never;
-        options?: never;
-        head?: never;
-        patch?: never;
-        trace?: never;
-    };
-    "/group/{groupId}/channel/": {
+        post?: never;
+        /** Revoke the role of a member of a group, making them a plain member */
+        delete: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path: {
+                    /** @description ID of group the member is in. */
+                    groupId: string;
+                    /** @description ID of member to revoke the role of. */
+                    memberId: string;
+                };
+                cookie?: never;
+            };
+            requestBody?: never;
+            responses: {
+                /** @description Role was revoked. */
+                204: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content?: never;
+                };
+                default: components["responses"]["Problem"];
+            };
+        };
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/group/{groupId}/owner/{memberId}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        /** Transfer ownership of a group to another member, the previous owner becomes an admin */
+        put: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path: {
+                    /** @description ID of group to transfer. */
+                    groupId: string;
+                    /** @description ID of member to make the owner. */
+                    memberId: string;
+                };
+                cookie?: never;
+            };
+            requestBody?: never;
+            responses: {
+                /** @description Updated group with its new owner. */
+                201: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": components["schemas"]["Group"];
+                    };
+                };
+                default: components["responses"]["Problem"];
+            };
+        };
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/channel/search": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /** Search for channels satisfying various properties. */
+        post: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path?: never;
+                cookie?: never;
+            };
+            /** @description Channel searching parameters. */
+            requestBody: {
+                content: {
+                    "application/json": components["schemas"]["ChannelFilter"];
+                };
+            };
+            responses: {
+                /** @description Query completed successfully. */
+                200: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": components["schemas"]["ChannelPage"];
+                    };
+                };
+                default: components["responses"]["Problem"];
+            };
+        };
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/group/{groupId}/channel/": {
         parameters: {
             query?: never;
             header?: never;
@@ -535,6 +964,16 @@ export interface paths {
                         "application/json": components["schemas"]["Channel"];
                     };
                 };
+                /** @description A channel with this id already exists, or the group is being deleted. */
+                409: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
+                };
+                default: components["responses"]["Problem"];
             };
         };
         delete?: never;
@@ -574,6 +1013,7 @@ export interface paths {
                         "application/json": components["schemas"]["Channel"];
                     };
                 };
+                default: components["responses"]["Problem"];
             };
         };
         /** Update Channel in Group By ID */
@@ -605,6 +1045,7 @@ export interface paths {
                         "application/json": components["schemas"]["Channel"];
                     };
                 };
+                default: components["responses"]["Problem"];
             };
         };
         post?: never;
@@ -623,13 +1064,18 @@ export interface paths {
             };
             requestBody?: never;
             responses: {
-                /** @description Channel with specified ID deleted. */
-                204: {
+                /** @description The channel is being deleted, along with its messages. The deletion carries on in the background, follow it through the job. */
+                202: {
                     headers: {
+                        /** @description Where the status of the job can be followed. */
+                        Location?: string;
                         [name: string]: unknown;
                     };
-                    content?: never;
+                    content: {
+                        "application/json": components["schemas"]["Job"];
+                    };
                 };
+                default: components["responses"]["Problem"];
             };
         };
         options?: never;
@@ -667,9 +1113,10 @@ export interface paths {
                         [name: string]: unknown;
                     };
                     content: {
-                        "application/json": components["schemas"]["Message"][];
+                        "application/json": components["schemas"]["MessagePage"];
                     };
                 };
+                default: components["responses"]["Problem"];
             };
         };
         delete?: never;
@@ -716,6 +1163,16 @@ export interface paths {
                         "application/json": components["schemas"]["Message"];
                     };
                 };
+                /** @description A message with this id already exists, or the channel or its group is being deleted. */
+                409: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/problem+json": components["schemas"]["Problem"];
+                    };
+                };
+                default: components["responses"]["Problem"];
             };
         };
         delete?: never;
@@ -757,6 +1214,7 @@ export interface paths {
                         "application/json": components["schemas"]["Message"];
                     };
                 };
+                default: components["responses"]["Problem"];
             };
         };
         /** Update Message in Channel By ID */
@@ -790,6 +1248,7 @@ export interface paths {
                         "application/json": components["schemas"]["Message"];
                     };
                 };
+                default: components["responses"]["Problem"];
             };
         };
         post?: never;
@@ -817,6 +1276,7 @@ export interface paths {
                     };
                     content?: never;
                 };
+                default: components["responses"]["Problem"];
             };
         };
         options?: never;
@@ -824,82 +1284,564 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
-}
-export type webhooks = Record<string, never>;
-export interface components {
-    schemas: {
-        /** @description User Account Details. */
-        Account: {
-            /**
-             * Format: uuid
-             * @example 550e8400-e29b-41d4-a716-446655440000
-             */
-            id: string;
-            /** Format: date-time */
-            created_at?: string;
-            /** @example John Doe */
-            username: string;
-            /** Format: base64 */
-            profile_pic: string;
-            pubkey: string;
-        };
-        /** @description A group chat/server of users. */
-        Group: {
-            /**
-             * Format: uuid
-             * @example
-             */
-            id: string;
-            /** Format: date-time */
-            created_at?: string;
-            /** @example Testing Group */
-            name: string;
-            /** @example This is a testing group. */
-            description: string;
-            /** @example [
-             *       "550e8400-e29b-41d4-a716-446655440000"
-             *     ] */
-            members: string[];
+    "/jobs/{jobId}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        /** @description A set of messages within a Group, typically organized by topic. */
-        Channel: {
-            /** Format: uuid */
-            id: string;
-            /** Format: date-time */
-            created_at?: string;
-            /** Format: uuid */
-            group: string;
-            /** @example Main */
-            name: string;
-            /** @example General conversations go here. */
-            description?: string;
+        /** Get the status of a background job, only whoever started it can see it. */
+        get: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path: {
+                    /** @description ID of the job. */
+                    jobId: string;
+                };
+                cookie?: never;
+            };
+            requestBody?: never;
+            responses: {
+                /** @description The job with the specified ID. */
+                200: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": components["schemas"]["Job"];
+                    };
+                };
+                default: components["responses"]["Problem"];
+            };
         };
-        /** @description A message that is sent in a group. */
-        Message: {
-            /** Format: uuid */
-            id: string;
-            /** Format: date-time */
-            created_at?: string;
-            /** Format: uuid */
-            author: string;
-            /** Format: uuid */
-            channel: string;
-            pinned: boolean;
-            body: string;
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/admin/integrity": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        /** @description User Account Update Details. */
-        AccountUpdate: {
-            /** @example John Doe */
-            username?: string;
-            /** Format: base64 */
-            profile_pic?: string;
+        /** Find the items that refer to something that is gone, only admins can check. */
+        get: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path?: never;
+                cookie?: never;
+            };
+            requestBody?: never;
+            responses: {
+                /** @description The items that refer to something that is gone. */
+                200: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": components["schemas"]["IntegrityReport"];
+                    };
+                };
+                default: components["responses"]["Problem"];
+            };
         };
-        /** @description Group Update Details. */
-        GroupUpdate: {
-            name?: string;
-            description?: string;
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/admin/integrity/repair": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
         };
-        /** @description Channel Update Details. */
+        get?: never;
+        put?: never;
+        /**
+         * Find and repair the items that refer to something that is gone, only admins can repair.
+         * @description Groups have the accounts that are gone taken out of their members. Channels whose group is gone, and messages whose channel is gone, are moved to the quarantine store where they can be looked at or put back by hand.
+         */
+        post: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path?: never;
+                cookie?: never;
+            };
+            requestBody?: never;
+            responses: {
+                /** @description The items that referred to something that is gone, and how each was repaired. */
+                200: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": components["schemas"]["IntegrityReport"];
+                    };
+                };
+                default: components["responses"]["Problem"];
+            };
+        };
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/admin/node": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Get the id of this node and the addresses other peers can reach it on, only admins can see it. */
+        get: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path?: never;
+                cookie?: never;
+            };
+            requestBody?: never;
+            responses: {
+                /** @description The id and addresses of this node. */
+                200: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": components["schemas"]["NodeInfo"];
+                    };
+                };
+                default: components["responses"]["Problem"];
+            };
+        };
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/admin/peers": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List the peers this node is connected to, only admins can list them. */
+        get: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path?: never;
+                cookie?: never;
+            };
+            requestBody?: never;
+            responses: {
+                /** @description The connected peers. */
+                200: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": components["schemas"]["Peer"][];
+                    };
+                };
+                default: components["responses"]["Problem"];
+            };
+        };
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/admin/peers/connect": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /** Dial a peer, only admins can connect. */
+        post: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path?: never;
+                cookie?: never;
+            };
+            requestBody: {
+                content: {
+                    "application/json": components["schemas"]["PeerAddress"];
+                };
+            };
+            responses: {
+                /** @description Connected to the peer. */
+                204: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content?: never;
+                };
+                default: components["responses"]["Problem"];
+            };
+        };
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/admin/peers/disconnect": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /** Close the connection on an address, or every connection to a peer given only its id, only admins can disconnect. */
+        post: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path?: never;
+                cookie?: never;
+            };
+            requestBody: {
+                content: {
+                    "application/json": components["schemas"]["PeerAddress"];
+                };
+            };
+            responses: {
+                /** @description Disconnected from the peer. */
+                204: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content?: never;
+                };
+                default: components["responses"]["Problem"];
+            };
+        };
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/admin/peers/preferred": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Get the peers this node dials whenever it starts, only admins can see them. */
+        get: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path?: never;
+                cookie?: never;
+            };
+            requestBody?: never;
+            responses: {
+                /** @description The preferred peers. */
+                200: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": components["schemas"]["PreferredPeers"];
+                    };
+                };
+                default: components["responses"]["Problem"];
+            };
+        };
+        /**
+         * Replace the peers this node dials whenever it starts, only admins can change them.
+         * @description The peers are dialed in the background once they are set, and again whenever the node starts, along with the bootstrap peers.
+         */
+        put: {
+            parameters: {
+                query?: never;
+                header?: never;
+                path?: never;
+                cookie?: never;
+            };
+            requestBody: {
+                content: {
+                    "application/json": components["schemas"]["PreferredPeers"];
+                };
+            };
+            responses: {
+                /** @description The preferred peers, as saved. */
+                200: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "application/json": components["schemas"]["PreferredPeers"];
+                    };
+                };
+                default: components["responses"]["Problem"];
+            };
+        };
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/events": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /**
+         * Stream changes to items as server-sent events.
+         * @description Each change is sent as an event named after its type, with the Event as its data. Without a group or channel filter every change is streamed, otherwise only changes within the given groups or channels are. The stream ends once the access token it was opened with expires, reconnect with a new token and the Last-Event-ID of the last event received to carry on.
+         */
+        get: {
+            parameters: {
+                query?: {
+                    /** @description Only stream changes to these groups, and the channels and messages within them. */
+                    group?: string[];
+                    /** @description Only stream changes to these channels, and the messages within them. */
+                    channel?: string[];
+                };
+                header?: {
+                    /** @description The id of the last event received, to resume a stream after it. */
+                    "Last-Event-ID"?: string;
+                };
+                path?: never;
+                cookie?: never;
+            };
+            requestBody?: never;
+            responses: {
+                /** @description Stream of events. */
+                200: {
+                    headers: {
+                        [name: string]: unknown;
+                    };
+                    content: {
+                        "text/event-stream": components["schemas"]["Event"];
+                    };
+                };
+                default: components["responses"]["Problem"];
+            };
+        };
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+}
+export type webhooks = Record<string, never>;
+export interface components {
+    schemas: {
+        /** @description A short-lived access token, and the refresh token to get the next one with. */
+        TokenPair: {
+            /** @description The access token to authenticate requests with. */
+            token: string;
+            /** @description The token to get new tokens with once the access token expires. */
+            refresh_token: string;
+            /**
+             * Format: date-time
+             * @description When the access token expires.
+             */
+            expires_at: string;
+        };
+        /** @description User Account Details. */
+        Account: {
+            /**
+             * Format: uuid
+             * @example 550e8400-e29b-41d4-a716-446655440000
+             */
+            id: string;
+            /** Format: date-time */
+            created_at?: string;
+            /** @example John Doe */
+            username: string;
+            /** Format: base64 */
+            profile_pic: string;
+            /** @description PEM encoded PKIX public key the account was created with, either Ed25519, ECDSA P-256 or P-384, or RSA. It becomes the account's first device key. */
+            pubkey: string;
+            /** @description The keys of the devices that can log in to the account. */
+            devices?: components["schemas"]["DeviceKey"][];
+        };
+        /** @description Details of an account to create, with proof that the creator holds the private half of its key. */
+        NewAccount: {
+            /**
+             * Format: uuid
+             * @example 550e8400-e29b-41d4-a716-446655440000
+             */
+            id: string;
+            /**
+             * @description Must not be taken by another account, ignoring case.
+             * @example John Doe
+             */
+            username: string;
+            /** Format: base64 */
+            profile_pic: string;
+            /** @description PEM encoded PKIX public key, either Ed25519, ECDSA P-256 or P-384, or RSA. */
+            pubkey: string;
+            /**
+             * Format: base64
+             * @description Base64 signature of the challenge issued for the username and key, made in the same way as a login.
+             */
+            signature: string;
+        };
+        /** @description The username and key of an account that is about to be created. */
+        RegistrationChallengeRequest: {
+            /** @example John Doe */
+            username: string;
+            /** @description PEM encoded PKIX public key, either Ed25519, ECDSA P-256 or P-384, or RSA. */
+            pubkey: string;
+        };
+        /** @description The key about to be added to an account. */
+        DeviceChallengeRequest: {
+            /** @description PEM encoded PKIX public key, either Ed25519, ECDSA P-256 or P-384, or RSA. */
+            pubkey: string;
+        };
+        /** @description The public key of one of the devices of an account. */
+        DeviceKey: {
+            /** Format: uuid */
+            id: string;
+            /** @example Laptop */
+            name: string;
+            /** @description PEM encoded PKIX public key, either Ed25519, ECDSA P-256 or P-384, or RSA. */
+            pubkey: string;
+            /** Format: date-time */
+            added_at: string;
+            /**
+             * Format: date-time
+             * @description When the key was last used to log in.
+             */
+            last_used_at?: string;
+        };
+        /** @description A device key to add to an account. */
+        NewDeviceKey: {
+            /** @example Laptop */
+            name: string;
+            /** @description PEM encoded PKIX public key, either Ed25519, ECDSA P-256 or P-384, or RSA. */
+            pubkey: string;
+            /**
+             * Format: base64
+             * @description Base64 signature of the challenge issued for the key, made with one of the account's existing keys in the same way as a login challenge.
+             */
+            signature: string;
+            /**
+             * Format: base64
+             * @description Base64 signature of the same challenge made with the new key, proving it is held.
+             */
+            key_signature: string;
+        };
+        /** @description A group chat/server of users. */
+        Group: {
+            /**
+             * Format: uuid
+             * @example
+             */
+            id: string;
+            /** Format: date-time */
+            created_at?: string;
+            /** @example Testing Group */
+            name: string;
+            /** @example This is a testing group. */
+            description: string;
+            /** @example [
+             *       "550e8400-e29b-41d4-a716-446655440000"
+             *     ] */
+            members: string[];
+            /**
+             * @description The roles of members, by account ID. Members that are not listed have the member role.
+             * @example {
+             *       "550e8400-e29b-41d4-a716-446655440000": "owner"
+             *     }
+             */
+            roles?: {
+                [key: string]: components["schemas"]["GroupRole"];
+            };
+        };
+        /**
+         * @description The role of a member within a group, which decides what they are allowed to do in it.
+         * @enum {string}
+         */
+        GroupRole: "owner" | "admin" | "moderator" | "member";
+        /** @description The role to give to a member of a group. */
+        GroupRoleAssignment: {
+            role: components["schemas"]["GroupRole"];
+        };
+        /** @description A set of messages within a Group, typically organized by topic. */
+        Channel: {
+            /** Format: uuid */
+            id: string;
+            /** Format: date-time */
+            created_at?: string;
+            /** Format: uuid */
+            group: string;
+            /** @example Main */
+            name: string;
+            /** @example General conversations go here. */
+            description?: string;
+        };
+        /** @description A message that is sent in a group. */
+        Message: {
+            /** Format: uuid */
+            id: string;
+            /** Format: date-time */
+            created_at?: string;
+            /** Format: uuid */
+            author: string;
+            /** Format: uuid */
+            channel: string;
+            pinned: boolean;
+            body: string;
+        };
+        /** @description User Account Update Details. */
+        AccountUpdate: {
+            /** @example John Doe */
+            username?: string;
+            /** Format: base64 */
+            profile_pic?: string;
+        };
+        /** @description Group Update Details. */
+        GroupUpdate: {
+            name?: string;
+            description?: string;
+        };
+        /** @description Channel Update Details. */
         ChannelUpdate: {
             name?: string;
             description?: string;
@@ -909,6 +1851,227 @@ export interface components {
             body?: string;
             pinned?: boolean;
         };
+        /**
+         * @description The kind of item that changed and how it changed.
+         * @enum {string}
+         */
+        EventType: "account.created" | "account.updated" | "account.deleted" | "group.created" | "group.updated" | "group.deleted" | "channel.created" | "channel.updated" | "channel.deleted" | "message.created" | "message.updated" | "message.deleted";
+        /** @description A change made to an item. */
+        Event: {
+            /**
+             * Format: int64
+             * @description The position of the event in the stream, pass as Last-Event-ID to resume after it.
+             */
+            id: number;
+            type: components["schemas"]["EventType"];
+            /**
+             * Format: uuid
+             * @description The id of the item that changed.
+             */
+            item: string;
+            /**
+             * Format: uuid
+             * @description The group the item is in, if any.
+             */
+            group?: string;
+            /**
+             * Format: uuid
+             * @description The channel the item is in, if any.
+             */
+            channel?: string;
+            /** @description The item after the change. Only its id is given when it was deleted. */
+            data: Record<string, never>;
+        };
+        /**
+         * @description Where a job is up to. A job that fails puts back everything it deleted, and can be started again.
+         * @enum {string}
+         */
+        JobStatus: "pending" | "running" | "done" | "failed";
+        /** @description A deletion carried on in the background. Messages are deleted first, then channels, and the item itself last, so a job that stops part way never leaves anything behind that belongs to an item that is gone. Nothing can be added to the item while it is being deleted. If the job fails, everything it deleted is restored. Finished jobs are kept for the job retention period of the node. */
+        Job: {
+            /** Format: uuid */
+            id: string;
+            /** @description What kind of item is being deleted, group or channel. */
+            kind: string;
+            /**
+             * Format: uuid
+             * @description The id of the item being deleted.
+             */
+            item: string;
+            status: components["schemas"]["JobStatus"];
+            /** @description How many items there are to delete, once the job has counted them. */
+            total: number;
+            /** @description How many of them have been deleted so far. */
+            deleted: number;
+            /** @description How many of the deleted items were put back after the job failed. */
+            restored: number;
+            /** @description Why the job failed, if it did. */
+            error?: string;
+            /**
+             * Format: uuid
+             * @description The account that started the job.
+             */
+            requested_by: string;
+            /** Format: date-time */
+            created_at: string;
+            /** Format: date-time */
+            updated_at: string;
+        };
+        /**
+         * @description What an item refers to that is gone.
+         * @enum {string}
+         */
+        IntegrityIssueKind: "missing_member" | "missing_group" | "missing_channel";
+        /** @description An item that refers to something that is gone. */
+        IntegrityIssue: {
+            kind: components["schemas"]["IntegrityIssueKind"];
+            /** @description The store the item is in. */
+            store: string;
+            /**
+             * Format: uuid
+             * @description The id of the item.
+             */
+            item: string;
+            /**
+             * Format: uuid
+             * @description The id of what it refers to.
+             */
+            reference: string;
+            /**
+             * @description How the item is repaired, either by removing the reference or quarantining the item. A group whose last owner is removed has its ownership transferred to the highest ranked member left, or is quarantined if no one is left.
+             * @enum {string}
+             */
+            repair: "remove_reference" | "transfer_ownership" | "quarantine";
+            /** @description Whether the item was repaired. */
+            repaired: boolean;
+        };
+        /** @description The items found to refer to something that is gone. */
+        IntegrityReport: {
+            /** Format: date-time */
+            checked_at: string;
+            /** @description How many items were checked. */
+            scanned: number;
+            issues: components["schemas"]["IntegrityIssue"][];
+        };
+        /** @description That the node is serving requests. */
+        Liveness: {
+            /** @enum {string} */
+            status: "ok";
+        };
+        /** @description The state of one of the stores of the database. */
+        StoreStatus: {
+            name: string;
+            /** @description The OrbitDB address of the store. */
+            address: string;
+            /** @description Whether its entries have been loaded and indexed. */
+            loaded: boolean;
+            /** @description Whether the store has reported that it is ready. */
+            ready: boolean;
+            documents: number;
+        };
+        /** @description The state of the IPFS node the database runs on. */
+        IPFSStatus: {
+            /** @description Whether the node has networking. */
+            online: boolean;
+            /** @description The path of the repo, empty for a node kept in memory. */
+            repo: string;
+            /**
+             * Format: int64
+             * @description The bytes stored in the repo.
+             */
+            repo_size?: number;
+            /** @description Why the repo could not be read, if it could not. */
+            repo_error?: string;
+        };
+        /** @description Whether the node is ready to serve requests, and the state of what it depends on. */
+        Readiness: {
+            ready: boolean;
+            /** @description Why the node is not ready, empty when it is. */
+            problems: string[];
+            stores: components["schemas"]["StoreStatus"][];
+            /** @description How many peers the IPFS node is connected to. */
+            peers: number;
+            /** @description How many peers have to be connected for the node to be ready. */
+            min_peers: number;
+            ipfs: components["schemas"]["IPFSStatus"];
+            /**
+             * Format: date-time
+             * @description When entries were last replicated from a peer, left out if none have been since the node started.
+             */
+            last_replication?: string;
+            /**
+             * Format: double
+             * @description The seconds since entries were last replicated from a peer.
+             */
+            since_last_replication?: number;
+        };
+        /** @description The id of this node and the addresses other peers can reach it on. */
+        NodeInfo: {
+            /** @description The peer id of this node. */
+            id: string;
+            /** @description The multiaddrs the node listens on. */
+            listen_addresses: string[];
+            /** @description The multiaddrs other peers can dial the node on, ending in its id. */
+            addresses: string[];
+        };
+        /** @description A peer this node is connected to. */
+        Peer: {
+            id: string;
+            /** @description The multiaddr the connection is on. */
+            address: string;
+            /**
+             * @description Whether the peer dialed this node, or this node dialed the peer.
+             * @enum {string}
+             */
+            direction: "inbound" | "outbound" | "unknown";
+            /**
+             * Format: double
+             * @description The last known round trip time in milliseconds, left out if it is not known yet.
+             */
+            latency_ms?: number;
+            /** @description The protocols the peer supports. */
+            protocols: string[];
+            /** @description Whether the peer is one of the preferred peers. */
+            preferred: boolean;
+        };
+        /** @description The multiaddr of a peer, ending in its id, such as /ip4/10.0.0.2/tcp/4001/p2p/12D3KooW... */
+        PeerAddress: {
+            address: string;
+        };
+        /** @description The peers this node dials whenever it starts, by multiaddr ending in their id. */
+        PreferredPeers: {
+            addresses: string[];
+        };
+        /**
+         * @description The order to return search results in, by creation time.
+         * @default created_at_asc
+         * @enum {string}
+         */
+        SortOrder: "created_at_asc" | "created_at_desc";
+        /** @description A page of accounts matching a search. */
+        AccountPage: {
+            items: components["schemas"]["Account"][];
+            /** @description Pass as the cursor of the same search to get the next page. Absent on the last page. */
+            next_cursor?: string;
+        };
+        /** @description A page of groups matching a search. */
+        GroupPage: {
+            items: components["schemas"]["Group"][];
+            /** @description Pass as the cursor of the same search to get the next page. Absent on the last page. */
+            next_cursor?: string;
+        };
+        /** @description A page of channels matching a search. */
+        ChannelPage: {
+            items: components["schemas"]["Channel"][];
+            /** @description Pass as the cursor of the same search to get the next page. Absent on the last page. */
+            next_cursor?: string;
+        };
+        /** @description A page of messages matching a search. */
+        MessagePage: {
+            items: components["schemas"]["Message"][];
+            /** @description Pass as the cursor of the same search to get the next page. Absent on the last page. */
+            next_cursor?: string;
+        };
         /** @description An object that is posted to the backend to query for accounts based on filter criteria. */
         AccountFilter: {
             /** @description Get accounts that have an id within this list of ids. */
@@ -925,6 +2088,14 @@ export interface components {
             until?: string;
             /** @description Get accounts that (fuzzily) match the provided username. */
             username?: string;
+            /**
+             * @description The most accounts to return in one page.
+             * @default 100
+             */
+            limit?: number;
+            /** @description The next_cursor of a previous page, to get the accounts following it. */
+            cursor?: string;
+            sort?: components["schemas"]["SortOrder"];
         };
         /** @description An object that is posted to the backend to query for groups based on filter criteria. */
         GroupFilter: {
@@ -936,6 +2107,14 @@ export interface components {
             /** @example Testing */
             name?: string;
             members?: string[];
+            /**
+             * @description The most groups to return in one page.
+             * @default 100
+             */
+            limit?: number;
+            /** @description The next_cursor of a previous page, to get the groups following it. */
+            cursor?: string;
+            sort?: components["schemas"]["SortOrder"];
         };
         /** @description An object that is posted to the backend to query for channels based on filter criteria. */
         ChannelFilter: {
@@ -947,6 +2126,14 @@ export interface components {
             /** @example Main */
             name?: string;
             group?: string[];
+            /**
+             * @description The most channels to return in one page.
+             * @default 100
+             */
+            limit?: number;
+            /** @description The next_cursor of a previous page, to get the channels following it. */
+            cursor?: string;
+            sort?: components["schemas"]["SortOrder"];
         };
         /** @description An object that is posted to the backend to query for messages based on filter criteria. */
         MessageFilter: {
@@ -959,9 +2146,47 @@ export interface components {
             channel?: string[];
             pinned?: boolean;
             body?: string;
+            /**
+             * @description The most messages to return in one page.
+             * @default 100
+             */
+            limit?: number;
+            /** @description The next_cursor of a previous page, to get the messages following it. */
+            cursor?: string;
+            sort?: components["schemas"]["SortOrder"];
+        };
+        /** @description Why a request failed, as problem details (RFC 7807). */
+        Problem: {
+            /**
+             * @description Identifies the kind of problem, always about:blank as the code identifies it instead.
+             * @default about:blank
+             */
+            type: string;
+            /** @description The status text of the status code. */
+            title: string;
+            /** @description The status code of the response. */
+            status: number;
+            /** @description What went wrong with this request, for people rather than programs. */
+            detail?: string;
+            code: components["schemas"]["ErrorCode"];
+        };
+        /**
+         * @description What went wrong, for programs to act on.
+         * @enum {string}
+         */
+        ErrorCode: "bad_request" | "validation_failed" | "unauthorized" | "invalid_signature" | "no_challenge" | "unsupported_key" | "forbidden" | "not_found" | "conflict" | "username_taken" | "rate_limited" | "unavailable" | "peer_unreachable" | "internal";
+    };
+    responses: {
+        /** @description The request failed, the problem details say why. */
+        Problem: {
+            headers: {
+                [name: string]: unknown;
+            };
+            content: {
+                "application/problem+json": components["schemas"]["Problem"];
+            };
         };
     };
-    responses: never;
     parameters: never;
     requestBodies: never;
     headers: never;
@@ -987,6 +2212,7 @@ export interface operations {
                     "application/json": Record<string, never>;
                 };
             };
+            default: components["responses"]["Problem"];
         };
     };
     GetHealth: {
@@ -998,15 +2224,67 @@ export interface operations {
         };
         requestBody?: never;
         responses: {
-            /** @description API is healthy */
+            /** @description The node is serving requests */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Liveness"];
+                };
+            };
+            default: components["responses"]["Problem"];
+        };
+    };
+    GetLiveness: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description The node is serving requests */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Liveness"];
+                };
+            };
+            default: components["responses"]["Problem"];
+        };
+    };
+    GetReadiness: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description The node is ready */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "text/plain": string;
+                    "application/json": components["schemas"]["Readiness"];
+                };
+            };
+            /** @description The node is not ready, the problems say why */
+            503: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Readiness"];
                 };
             };
+            default: components["responses"]["Problem"];
         };
     };
     GetChallenge: {
@@ -1032,6 +2310,7 @@ export interface operations {
                     };
                 };
             };
+            default: components["responses"]["Problem"];
         };
     };
     Login: {
@@ -1050,17 +2329,62 @@ export interface operations {
             };
         };
         responses: {
-            /** @description Token issued */
+            /** @description Tokens issued for a new session */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": {
-                        token?: string;
-                    };
+                    "application/json": components["schemas"]["TokenPair"];
+                };
+            };
+            default: components["responses"]["Problem"];
+        };
+    };
+    RefreshToken: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": {
+                    refresh_token: string;
+                };
+            };
+        };
+        responses: {
+            /** @description Tokens issued for the same session */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["TokenPair"];
+                };
+            };
+            default: components["responses"]["Problem"];
+        };
+    };
+    Logout: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Session ended */
+            204: {
+                headers: {
+                    [name: string]: unknown;
                 };
+                content?: never;
             };
+            default: components["responses"]["Problem"];
         };
     };
 }
@@ -2,22 +2,29 @@ package api
 
 import (
 	v1 "Sector/internal/api/v1"
+	"Sector/internal/config"
+	"Sector/internal/metrics"
 	"Sector/internal/middleware"
+	"Sector/internal/tracing"
 	"embed"
 	"encoding/json"
 	"net/http"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
 	"github.com/gorilla/mux"
 	oapimiddleware "github.com/oapi-codegen/nethttp-middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 //go:embed v1/swagger-ui.html
 var swaggerUI embed.FS
 
-// A helper function to take any SectorAPI instance and add it to the given mux router instance.
-func AddV1SectorAPIToRouter(router *mux.Router, api *v1.SectorAPI) {
+// A helper function to take any SectorAPI instance and add it to the given mux router instance, rate limiting its routes
+// with the given limits.
+func AddV1SectorAPIToRouter(router *mux.Router, api *v1.SectorAPI, limits config.RateLimitConfig) {
 	// Setup the swagger docs.
 	swaggerV1, err := v1.GetSwagger()
 	if err != nil {
@@ -28,8 +35,17 @@ func AddV1SectorAPIToRouter(router *mux.Router, api *v1.SectorAPI) {
 	swaggerV1.Servers = nil
 	fixSwaggerPrefix("/v1/api", swaggerV1)
 
-	// Add middleware
+	// Add middleware, tracing first so that the span of a request covers everything else done with it
+	operation := operationNamer(swaggerV1)
+	router.Use(tracing.Middleware(operation))
 	router.Use(middleware.RequestLogger(api.Logger))
+	router.Use(middleware.Metrics(operation))
+	router.Use(api.WhileConnecting)
+
+	// Serve the metrics of the node, along with those of its database
+	databaseMetrics := prometheus.NewRegistry()
+	databaseMetrics.MustRegister(api.DB.Collector())
+	router.Handle("/metrics", promhttp.HandlerFor(prometheus.Gatherers{metrics.Registry, databaseMetrics}, promhttp.HandlerOpts{})).Methods("GET")
 
 	// Serve the swagger.json file directly at /docs/swagger.json
 	router.HandleFunc("/v1/swagger.json", func(w http.ResponseWriter, r *http.Request) {
@@ -49,8 +65,17 @@ func AddV1SectorAPIToRouter(router *mux.Router, api *v1.SectorAPI) {
 		w.Write([]byte(data))
 	})
 
+	// Serve the public keys tokens are signed with, at the well known location rather than under the API
+	router.HandleFunc("/.well-known/jwks.json", api.GetJWKS).Methods("GET")
+
+	// Serve the WebSocket gateway, it authenticates connections itself. Messages sent over it count as writes.
+	writes := newRateLimiter(limits.Writes)
+	api.Gateway.Writes = writes
+	router.Handle("/v1/ws", api.Gateway)
+
 	// Create public API subrouter (no JWT authentication)
 	publicRouter := router.PathPrefix("/v1/api").Subrouter()
+	publicRouter.Use(newRateLimiter(limits.Auth).Limit(middleware.ClientIP))
 	// Register /challenge
 	publicRouter.HandleFunc("/challenge", func(w http.ResponseWriter, r *http.Request) {
 		params := v1.GetChallengeParams{
@@ -62,25 +87,72 @@ func AddV1SectorAPIToRouter(router *mux.Router, api *v1.SectorAPI) {
 	// Register /login
 	publicRouter.HandleFunc("/login", api.Login).Methods("POST")
 
-	// Apply OpenAPI validation
-	publicRouter.Use(oapimiddleware.OapiRequestValidator(swaggerV1))
+	// Register /account/challenge and /account/, accounts are created by signing the challenge with their key
+	publicRouter.HandleFunc("/account/challenge", api.GetRegistrationChallenge).Methods("POST")
+	publicRouter.HandleFunc("/account/", api.PutAccount).Methods("POST")
+
+	// Register /refresh, the refresh token in the body is what authenticates it
+	publicRouter.HandleFunc("/refresh", api.RefreshToken).Methods("POST")
+
+	// Apply OpenAPI validation, errors from it are written as problem details like those of the handlers
+	publicRouter.Use(oapimiddleware.OapiRequestValidatorWithOptions(swaggerV1, &oapimiddleware.Options{
+		ErrorHandler: v1.WriteError,
+	}))
 
 	v1.HandlerWithOptions(api, v1.GorillaServerOptions{
-		BaseURL:    "/v1/api",
-		BaseRouter: router,
+		BaseURL:          "/v1/api",
+		BaseRouter:       router,
+		ErrorHandlerFunc: v1.WriteParameterError,
 		Middlewares: []v1.MiddlewareFunc{
 			oapimiddleware.OapiRequestValidatorWithOptions(
 				swaggerV1,
 				&oapimiddleware.Options{
 					Options: openapi3filter.Options{
-						AuthenticationFunc: middleware.NewAuthenticator(),
+						AuthenticationFunc: middleware.NewAuthenticator(api.Auth),
 					},
+					ErrorHandler: v1.WriteError,
 				},
 			),
+			// After authenticating, so that requests are limited by the account that made them
+			v1.MiddlewareFunc(middleware.LimitRouteGroups(
+				newRateLimiter(limits.Search),
+				writes,
+				middleware.CallerID,
+			)),
 		},
 	})
 }
 
+// Create a rate limiter that writes the requests it refuses as problem details
+func newRateLimiter(limit config.RateLimit) *middleware.RateLimiter {
+	limiter := middleware.NewRateLimiter(limit, nil)
+	limiter.ErrorHandler = v1.WriteError
+	return limiter
+}
+
+/**
+ * Name requests by the OpenAPI operation they are for, so that metrics can be grouped by it. Requests outside of the
+ * API, such as to the gateway, are named by the template of their route instead.
+ */
+func operationNamer(swagger *openapi3.T) func(*http.Request) string {
+	operations, err := gorillamux.NewRouter(swagger)
+	if err != nil {
+		panic(err)
+	}
+
+	return func(r *http.Request) string {
+		if route, _, err := operations.FindRoute(r); err == nil && route.Operation.OperationID != "" {
+			return route.Operation.OperationID
+		}
+		if route := mux.CurrentRoute(r); route != nil {
+			if template, err := route.GetPathTemplate(); err == nil {
+				return template
+			}
+		}
+		return "unmatched"
+	}
+}
+
 // Necessary so that we can use the OapiRequestValidator with a BaseURL
 func fixSwaggerPrefix(prefix string, swagger *openapi3.T) {
 	var updatedPaths openapi3.Paths = openapi3.Paths{}
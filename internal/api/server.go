@@ -0,0 +1,77 @@
+package api
+
+import (
+	v1 "Sector/internal/api/v1"
+	"Sector/internal/config"
+	"Sector/internal/middleware"
+	"Sector/internal/tracing"
+	"context"
+	"errors"
+	"net"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/rs/cors"
+)
+
+// Server serves a SectorAPI over HTTP, whether inside the desktop app or on its own
+type Server struct {
+	api     *v1.SectorAPI
+	http    *http.Server
+	cancel  context.CancelFunc          // Ends the requests still being served, such as event streams, on shutdown
+	tracing func(context.Context) error // Sends the spans still waiting to be exported
+}
+
+// Create a server for the API, tracing its requests as the config says. It does not listen until ListenAndServe is called
+func NewServer(api *v1.SectorAPI, cfg config.Config) *Server {
+	stopTracing, err := tracing.Setup(context.Background(), cfg.Tracing)
+	if err != nil {
+		panic(err)
+	}
+
+	r := mux.NewRouter().StrictSlash(true)
+	AddV1SectorAPIToRouter(r, api, cfg.RateLimits)
+
+	c := cors.New(cors.Options{
+		AllowedOrigins:   cfg.Server.AllowedOrigins,
+		AllowCredentials: true,
+		ExposedHeaders:   []string{middleware.RequestIDHeader},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Server{
+		api:     api,
+		cancel:  cancel,
+		tracing: stopTracing,
+		http: &http.Server{
+			Handler:     c.Handler(r),
+			Addr:        cfg.Server.Address,
+			BaseContext: func(net.Listener) context.Context { return ctx },
+		},
+	}
+}
+
+// The address the server listens on
+func (s *Server) Address() string {
+	return s.http.Addr
+}
+
+// Serve requests until the server is shut down, which is not reported as an error
+func (s *Server) ListenAndServe() error {
+	if err := s.http.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+/**
+ * Stop taking requests and wait for those being served to finish, or for the context to be done, then close the API
+ * and send the spans still waiting to be exported. Event streams and gateway connections last as long as the client
+ * wants, so they are ended rather than waited on.
+ */
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.cancel()
+	err := s.http.Shutdown(ctx)
+	s.api.Close()
+	return errors.Join(err, s.tracing(ctx))
+}
@@ -2,22 +2,61 @@ package v1
 
 import (
 	"Sector/internal/auth"
-	"crypto"
-	"crypto/rsa"
+	"Sector/internal/middleware"
 	"crypto/sha256"
-	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
-	"encoding/pem"
-	"fmt"
+	"errors"
 	"net/http"
-	"reflect"
+	"strings"
+	"time"
 
 	"go.uber.org/zap"
 )
 
-// Global challenge store
-var challengeStore = auth.NewChallengeStore()
+// The key a login challenge is stored under, usernames are unique ignoring case
+func loginKey(username string) string {
+	return "login:" + strings.ToLower(username)
+}
+
+// The key a registration challenge is stored under, so that it can only be used for the username and key it was
+// issued for
+func registrationKey(username, pubkey string) string {
+	hashed := sha256.Sum256([]byte(pubkey))
+	return "register:" + strings.ToLower(username) + ":" + base64.StdEncoding.EncodeToString(hashed[:])
+}
+
+/**
+ * Find which of the outstanding challenges was signed, and by which of the devices. If none of the devices have a key
+ * that can be used, the error is auth.ErrUnsupportedKey.
+ */
+func answeredChallenge(devices []DeviceKey, challenges []string, signature string) (string, int, error) {
+	err := auth.ErrInvalidSignature
+	for _, challenge := range challenges {
+		device, signErr := signingDevice(devices, challenge, signature)
+		if signErr == nil {
+			return challenge, device, nil
+		}
+		err = signErr
+	}
+	return "", -1, err
+}
+
+// Store a newly issued challenge, reporting whether it could be
+func (s *SectorAPI) storeChallenge(w http.ResponseWriter, r *http.Request, key, challenge string) bool {
+	err := s.Challenges.Add(key, challenge)
+	if errors.Is(err, auth.ErrTooManyChallenges) {
+		s.log(r).Warn("Refused challenge", zap.Error(err))
+		writeProblem(w, http.StatusTooManyRequests, RateLimited, "Too many outstanding challenges, please answer one or try again later")
+		return false
+	}
+	if err != nil {
+		s.log(r).Error("Error storing challenge", zap.Error(err))
+		writeProblem(w, http.StatusInternalServerError, Internal, "Error storing challenge")
+		return false
+	}
+	return true
+}
 
 // LoginRequest represents the login request structure
 type LoginRequest struct {
@@ -29,36 +68,36 @@ type LoginRequest struct {
 func (s *SectorAPI) GetChallenge(w http.ResponseWriter, r *http.Request, params GetChallengeParams) {
 	username := params.Username
 	if username == "" {
-		http.Error(w, "Username is required", http.StatusBadRequest)
+		writeProblem(w, http.StatusBadRequest, BadRequest, "Username is required")
 		return
 	}
 
 	// Check if user exists
-	accounts, err := searchItem(s.DB.Store, reflect.TypeOf(Account{}), map[string]interface{}{
-		"username": username,
-	})
+	accounts, err := findAccountsByUsername(r.Context(), s.DB, username)
 
 	if err != nil {
-		s.Logger.Error("Error searching for user", zap.Error(err))
-		http.Error(w, "Error searching for user", http.StatusInternalServerError)
+		s.log(r).Error("Error searching for user", zap.Error(err))
+		writeProblem(w, http.StatusInternalServerError, Internal, "Error searching for user")
 		return
 	}
 
 	if len(accounts) == 0 {
-		http.Error(w, "User not found", http.StatusNotFound)
+		writeProblem(w, http.StatusNotFound, NotFound, "User not found")
 		return
 	}
 
 	// Generate a new challenge
 	challenge, err := auth.GenerateChallenge()
 	if err != nil {
-		s.Logger.Error("Error generating challenge", zap.Error(err))
-		http.Error(w, "Error generating challenge", http.StatusInternalServerError)
+		s.log(r).Error("Error generating challenge", zap.Error(err))
+		writeProblem(w, http.StatusInternalServerError, Internal, "Error generating challenge")
 		return
 	}
 
 	// Store the challenge
-	challengeStore.Add(username, challenge)
+	if !s.storeChallenge(w, r, loginKey(username), challenge) {
+		return
+	}
 
 	// Return the challenge to the client
 	response := map[string]string{
@@ -69,33 +108,76 @@ func (s *SectorAPI) GetChallenge(w http.ResponseWriter, r *http.Request, params
 	json.NewEncoder(w).Encode(response)
 }
 
+// GetRegistrationChallenge handler for the registration challenge endpoint
+func (s *SectorAPI) GetRegistrationChallenge(w http.ResponseWriter, r *http.Request) {
+	var registration RegistrationChallengeRequest
+	if err := json.NewDecoder(r.Body).Decode(&registration); err != nil {
+		writeProblem(w, http.StatusBadRequest, BadRequest, "Invalid request body")
+		return
+	}
+	if registration.Username == "" {
+		writeProblem(w, http.StatusBadRequest, BadRequest, "Username is required")
+		return
+	}
+	if _, err := auth.ParsePublicKey(registration.Pubkey); err != nil {
+		s.log(r).Debug("Refused public key", zap.Error(err))
+		writeProblem(w, http.StatusBadRequest, UnsupportedKey, "Unsupported public key, use an Ed25519, ECDSA P-256 or P-384, or RSA key")
+		return
+	}
+
+	// Check the username is free now, it is checked again when the account is created
+	accounts, err := findAccountsByUsername(r.Context(), s.DB, registration.Username)
+	if err != nil {
+		s.log(r).Error("Error searching for user", zap.Error(err))
+		writeProblem(w, http.StatusInternalServerError, Internal, "Error searching for user")
+		return
+	}
+	if len(accounts) > 0 {
+		writeProblem(w, http.StatusConflict, UsernameTaken, "Username is taken")
+		return
+	}
+
+	challenge, err := auth.GenerateChallenge()
+	if err != nil {
+		s.log(r).Error("Error generating challenge", zap.Error(err))
+		writeProblem(w, http.StatusInternalServerError, Internal, "Error generating challenge")
+		return
+	}
+	if !s.storeChallenge(w, r, registrationKey(registration.Username, registration.Pubkey), challenge) {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{
+		"challenge": challenge,
+	})
+}
+
 // Login handler for the login endpoint
 func (s *SectorAPI) Login(w http.ResponseWriter, r *http.Request) {
 	var loginReq LoginRequest
 	if err := json.NewDecoder(r.Body).Decode(&loginReq); err != nil {
-		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		writeProblem(w, http.StatusBadRequest, BadRequest, "Invalid request body")
 		return
 	}
 
-	// Retrieve the stored challenge
-	challenge, found := challengeStore.Get(loginReq.Username)
-	if !found {
-		http.Error(w, "No active challenge found, please request a new one", http.StatusBadRequest)
+	// Retrieve the stored challenges, any of which may have been signed
+	challenges := s.Challenges.Get(loginKey(loginReq.Username))
+	if len(challenges) == 0 {
+		writeProblem(w, http.StatusBadRequest, NoChallenge, "No active challenge found, please request a new one")
 		return
 	}
 
 	// Find the user
-	accounts, err := searchItem(s.DB.Store, reflect.TypeOf(Account{}), map[string]interface{}{
-		"username": loginReq.Username,
-	})
+	accounts, err := findAccountsByUsername(r.Context(), s.DB, loginReq.Username)
 
 	if err != nil || len(accounts) == 0 {
-		http.Error(w, "User not found", http.StatusNotFound)
+		writeProblem(w, http.StatusNotFound, NotFound, "User not found")
 		return
 	}
 
 	if len(accounts) > 1 {
-		http.Error(w, "Multiple users found", http.StatusInternalServerError)
+		writeProblem(w, http.StatusConflict, Conflict, "Multiple users found")
 		return
 	}
 
@@ -103,83 +185,101 @@ func (s *SectorAPI) Login(w http.ResponseWriter, r *http.Request) {
 	var account Account
 	err = MapToStruct(accounts[0].(map[string]interface{}), &account)
 	if err != nil {
-		http.Error(w, "Error parsing account data", http.StatusInternalServerError)
+		writeProblem(w, http.StatusInternalServerError, Internal, "Error parsing account data")
 		return
 	}
 
-	// Check if the account has a pubkey - use a string field from the map directly
-	accountMap := accounts[0].(map[string]interface{})
-	pubkey, ok := accountMap["pubkey"].(string)
-	if !ok {
-		http.Error(w, "User has no public key", http.StatusUnauthorized)
+	// Check if the account has any device keys
+	devices := accountDevices(account)
+	if len(devices) == 0 {
+		writeProblem(w, http.StatusUnauthorized, Unauthorized, "User has no public key")
 		return
 	}
 
-	// Verify the signature
-	if ok := verifySignature(challenge, loginReq.Signature, pubkey); !ok {
-		http.Error(w, "Invalid signature", http.StatusUnauthorized)
+	// Verify the signature, which can be made by any of the account's devices
+	challenge, device, err := answeredChallenge(devices, challenges, loginReq.Signature)
+	if err != nil {
+		if errors.Is(err, auth.ErrUnsupportedKey) {
+			writeProblem(w, http.StatusBadRequest, UnsupportedKey, "Unsupported public key, use an Ed25519, ECDSA P-256 or P-384, or RSA key")
+		} else {
+			s.log(r).Debug("Refused signature", zap.Error(err))
+			writeProblem(w, http.StatusUnauthorized, InvalidSignature, "Invalid signature")
+		}
 		return
 	}
 
 	// Remove the challenge
-	challengeStore.Remove(loginReq.Username)
+	s.Challenges.Remove(loginKey(loginReq.Username), challenge)
 
-	// Generate JWT token
-	token, err := auth.GenerateToken(account.Id.String(), account.Username)
-	if err != nil {
-		s.Logger.Error("Error generating token", zap.Error(err))
-		http.Error(w, "Error generating token", http.StatusInternalServerError)
+	// Record when the device was last used
+	now := time.Now()
+	devices[device].LastUsedAt = &now
+	if err := saveDevices(r.Context(), s.DB, account.Id, devices); err != nil {
+		s.log(r).Error("Error saving device keys", zap.Error(err))
+		writeProblem(w, http.StatusInternalServerError, Internal, "Error saving device keys")
 		return
 	}
 
-	// Return token to client
-	response := map[string]string{
-		"token": token,
+	// Start a new session for the user
+	tokens, err := s.Auth.GenerateTokenPair(account.Id.String(), account.Username, devices[device].Id.String())
+	if err != nil {
+		s.log(r).Error("Error generating token", zap.Error(err))
+		writeProblem(w, http.StatusInternalServerError, Internal, "Error generating token")
+		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(response)
+	writeTokens(w, tokens)
 }
 
-// verifySignature verifies that the signature is valid for the given challenge and public key
-func verifySignature(challenge, signatureBase64, publicKeyPEM string) bool {
-	// Decode the challenge
-	challengeBytes, err := base64.StdEncoding.DecodeString(challenge)
-	if err != nil {
-		fmt.Println("Error decoding challenge:", err)
-		return false
+// RefreshToken handler for the refresh endpoint
+func (s *SectorAPI) RefreshToken(w http.ResponseWriter, r *http.Request) {
+	var refreshReq RefreshTokenJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&refreshReq); err != nil {
+		writeProblem(w, http.StatusBadRequest, BadRequest, "Invalid request body")
+		return
 	}
 
-	// Decode the signature
-	signatureBytes, err := base64.StdEncoding.DecodeString(signatureBase64)
+	tokens, err := s.Auth.RefreshTokens(refreshReq.RefreshToken)
 	if err != nil {
-		fmt.Println("Error decoding signature:", err)
-		return false
+		s.log(r).Debug("Refused refresh token", zap.Error(err))
+		writeProblem(w, http.StatusUnauthorized, Unauthorized, "Invalid refresh token")
+		return
 	}
 
-	// Parse the public key
-	block, _ := pem.Decode([]byte(publicKeyPEM))
-	if block == nil {
-		fmt.Println("Error decoding PEM block")
-		return false
-	}
+	writeTokens(w, tokens)
+}
 
-	pubKey, err := x509.ParsePKIXPublicKey(block.Bytes)
-	if err != nil {
-		fmt.Println("Error parsing public key:", err)
-		return false
+// Logout handler for the logout endpoint
+func (s *SectorAPI) Logout(w http.ResponseWriter, r *http.Request) {
+	claims, ok := r.Context().Value(middleware.ContextKeyUser).(*auth.Claims)
+	if !ok {
+		writeProblem(w, http.StatusUnauthorized, Unauthorized, "Not logged in")
+		return
 	}
 
-	rsaPublicKey, ok := pubKey.(*rsa.PublicKey)
-	if !ok {
-		fmt.Println("Not an RSA public key")
-		return false
+	if err := s.Auth.RevokeSession(claims); err != nil {
+		s.log(r).Error("Error revoking session", zap.Error(err))
+		writeProblem(w, http.StatusInternalServerError, Internal, "Error ending session")
+		return
 	}
+	s.Gateway.EndSession(claims.SessionID)
+	w.WriteHeader(http.StatusNoContent)
+}
 
-	// Create hash of the challenge
-	hashed := sha256.Sum256(challengeBytes)
+// GetJWKS handler for the JSON Web Key Set endpoint, so that other nodes can verify the tokens we issue
+func (s *SectorAPI) GetJWKS(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(s.Auth.PublicKeys())
+}
 
-	// Verify the signature
-	err = rsa.VerifyPKCS1v15(rsaPublicKey, crypto.SHA256, hashed[:], signatureBytes)
-	return err == nil
+// writeTokens returns a pair of tokens to the client
+func writeTokens(w http.ResponseWriter, tokens *auth.TokenPair) {
+	response := TokenPair{
+		Token:        tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		ExpiresAt:    tokens.ExpiresAt,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
 }
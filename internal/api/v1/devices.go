@@ -0,0 +1,259 @@
+package v1
+
+import (
+	"Sector/internal/auth"
+	"Sector/internal/database"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/oapi-codegen/runtime/types"
+	"go.uber.org/zap"
+)
+
+// The name given to the key an account is created with.
+const defaultDeviceName = "Default"
+
+/**
+ * Get the device keys of an account. Accounts written before they had devices only have the key they were created
+ * with, which is given as a device whose id is derived from the account's so that it stays the same.
+ */
+func accountDevices(account Account) []DeviceKey {
+	if account.Devices != nil {
+		return *account.Devices
+	}
+	if account.Pubkey == "" {
+		return []DeviceKey{}
+	}
+
+	device := DeviceKey{
+		Id:     uuid.NewSHA1(account.Id, []byte("pubkey")),
+		Name:   defaultDeviceName,
+		Pubkey: account.Pubkey,
+	}
+	if account.CreatedAt != nil {
+		device.AddedAt = *account.CreatedAt
+	}
+	return []DeviceKey{device}
+}
+
+// The key the challenges to add a device key to an account are stored under
+func deviceKey(account types.UUID, pubkey string) string {
+	hashed := sha256.Sum256([]byte(pubkey))
+	return "device:" + account.String() + ":" + base64.StdEncoding.EncodeToString(hashed[:])
+}
+
+// Get an account from the database.
+func getAccount(ctx context.Context, db *database.Database, id types.UUID) (Account, error) {
+	var account Account
+	item, err := getItem(ctx, db, reflect.TypeOf(Account{}), id)
+	if err != nil {
+		return account, err
+	}
+	err = MapToStruct(item.(map[string]interface{}), &account)
+	return account, err
+}
+
+// Save the device keys of an account.
+func saveDevices(ctx context.Context, db *database.Database, account types.UUID, devices []DeviceKey) error {
+	_, err := updateItem(ctx, db, reflect.TypeOf(Account{}), account, map[string]interface{}{
+		"devices": devices,
+	})
+	return err
+}
+
+/**
+ * Find which of the devices made a signature of the challenge, in the same way as a login. If none of the devices
+ * have a key that can be used, the error is auth.ErrUnsupportedKey.
+ */
+func signingDevice(devices []DeviceKey, challenge, signature string) (int, error) {
+	err := auth.ErrUnsupportedKey
+	for i, device := range devices {
+		verifyErr := auth.VerifySignature(challenge, signature, device.Pubkey)
+		if verifyErr == nil {
+			return i, nil
+		}
+		if !errors.Is(verifyErr, auth.ErrUnsupportedKey) {
+			err = verifyErr
+		}
+	}
+	return -1, err
+}
+
+// ListDeviceKeys implements ServerInterface.
+func (s *SectorAPI) ListDeviceKeys(w http.ResponseWriter, r *http.Request, id types.UUID) {
+	caller, err := callerOf(r)
+	if s.denied(w, r, err) {
+		return
+	}
+	if s.denied(w, r, authorizeAccount(caller, id)) {
+		return
+	}
+
+	account, err := getAccount(r.Context(), s.DB, id)
+	if err != nil {
+		s.fail(w, r, err, "Could not get within database.")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(accountDevices(account))
+}
+
+// GetDeviceChallenge implements ServerInterface.
+func (s *SectorAPI) GetDeviceChallenge(w http.ResponseWriter, r *http.Request, id types.UUID) {
+	caller, err := callerOf(r)
+	if s.denied(w, r, err) {
+		return
+	}
+	if s.denied(w, r, authorizeAccount(caller, id)) {
+		return
+	}
+
+	var request DeviceChallengeRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		s.log(r).Debug("Could not parse request body", zap.Error(err))
+		writeProblem(w, http.StatusBadRequest, BadRequest, "Could not parse request body.")
+		return
+	}
+	if _, err := auth.ParsePublicKey(request.Pubkey); err != nil {
+		s.log(r).Debug("Unsupported public key", zap.Error(err))
+		writeProblem(w, http.StatusBadRequest, UnsupportedKey, "Unsupported public key, use an Ed25519, ECDSA P-256 or P-384, or RSA key.")
+		return
+	}
+
+	challenge, err := auth.GenerateChallenge()
+	if err != nil {
+		s.log(r).Error("Error generating challenge", zap.Error(err))
+		writeProblem(w, http.StatusInternalServerError, Internal, "Error generating challenge.")
+		return
+	}
+	if !s.storeChallenge(w, r, deviceKey(id, request.Pubkey), challenge) {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{
+		"challenge": challenge,
+	})
+}
+
+// AddDeviceKey implements ServerInterface.
+func (s *SectorAPI) AddDeviceKey(w http.ResponseWriter, r *http.Request, id types.UUID) {
+	caller, err := callerOf(r)
+	if s.denied(w, r, err) {
+		return
+	}
+	if s.denied(w, r, authorizeAccount(caller, id)) {
+		return
+	}
+
+	var newKey NewDeviceKey
+	if err := json.NewDecoder(r.Body).Decode(&newKey); err != nil {
+		s.log(r).Debug("Could not parse request body", zap.Error(err))
+		writeProblem(w, http.StatusBadRequest, BadRequest, "Could not parse request body.")
+		return
+	}
+	if _, err := auth.ParsePublicKey(newKey.Pubkey); err != nil {
+		s.log(r).Debug("Unsupported public key", zap.Error(err))
+		writeProblem(w, http.StatusBadRequest, UnsupportedKey, "Unsupported public key, use an Ed25519, ECDSA P-256 or P-384, or RSA key.")
+		return
+	}
+
+	account, err := getAccount(r.Context(), s.DB, id)
+	if err != nil {
+		s.fail(w, r, err, "Could not get within database.")
+		return
+	}
+	devices := accountDevices(account)
+	if slices.ContainsFunc(devices, func(device DeviceKey) bool { return device.Pubkey == newKey.Pubkey }) {
+		writeProblem(w, http.StatusConflict, Conflict, "Key has already been added.")
+		return
+	}
+
+	/**
+	 * A token alone is not enough to add a key. One of the existing keys has to vouch for it by signing the challenge
+	 * issued for it, and the new key has to sign the same challenge to prove it is held. The challenge is only used up
+	 * once the key is known to be stored, so that a client can retry anything else with it.
+	 */
+	key := deviceKey(id, newKey.Pubkey)
+	challenges := s.Challenges.Get(key)
+	if len(challenges) == 0 {
+		writeProblem(w, http.StatusBadRequest, NoChallenge, "No active challenge found, please request a new one.")
+		return
+	}
+	challenge, _, err := answeredChallenge(devices, challenges, newKey.Signature)
+	if err != nil {
+		s.log(r).Debug("The challenge must be signed by an existing key", zap.Error(err))
+		writeProblem(w, http.StatusForbidden, InvalidSignature, "The challenge must be signed by an existing key.")
+		return
+	}
+	if err := auth.VerifySignature(challenge, newKey.KeySignature, newKey.Pubkey); err != nil {
+		s.log(r).Debug("The challenge must be signed by the new key", zap.Error(err))
+		writeProblem(w, http.StatusForbidden, InvalidSignature, "The challenge must be signed by the new key.")
+		return
+	}
+	s.Challenges.Remove(key, challenge)
+
+	device := DeviceKey{
+		Id:      uuid.New(),
+		Name:    newKey.Name,
+		Pubkey:  newKey.Pubkey,
+		AddedAt: time.Now(),
+	}
+	if err := saveDevices(r.Context(), s.DB, id, append(devices, device)); err != nil {
+		s.fail(w, r, err, "Could not update within database.")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(device)
+}
+
+// RevokeDeviceKey implements ServerInterface.
+func (s *SectorAPI) RevokeDeviceKey(w http.ResponseWriter, r *http.Request, id types.UUID, keyId types.UUID) {
+	caller, err := callerOf(r)
+	if s.denied(w, r, err) {
+		return
+	}
+	if s.denied(w, r, authorizeAccount(caller, id)) {
+		return
+	}
+
+	account, err := getAccount(r.Context(), s.DB, id)
+	if err != nil {
+		s.fail(w, r, err, "Could not get within database.")
+		return
+	}
+	devices := accountDevices(account)
+
+	remaining := slices.DeleteFunc(slices.Clone(devices), func(device DeviceKey) bool { return device.Id == keyId })
+	if len(remaining) == len(devices) {
+		writeProblem(w, http.StatusNotFound, NotFound, "Key not found.")
+		return
+	}
+	if len(remaining) == 0 {
+		writeProblem(w, http.StatusBadRequest, BadRequest, "Cannot revoke the last key of an account.")
+		return
+	}
+
+	if err := saveDevices(r.Context(), s.DB, id, remaining); err != nil {
+		s.fail(w, r, err, "Could not update within database.")
+		return
+	}
+
+	// Sign out every session the key was used to sign in to
+	if err := s.Auth.RevokeDevice(keyId.String()); err != nil {
+		s.fail(w, r, err, "Could not end the sessions of the key.")
+		return
+	}
+	s.Gateway.EndDevice(keyId.String())
+	w.WriteHeader(http.StatusNoContent)
+}
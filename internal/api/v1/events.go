@@ -0,0 +1,332 @@
+package v1
+
+import (
+	"Sector/internal/auth"
+	"Sector/internal/database"
+	"Sector/internal/middleware"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/oapi-codegen/runtime/types"
+	"go.uber.org/zap"
+)
+
+// The number of recent events kept so that a client can resume its stream with Last-Event-ID.
+const eventHistorySize = 1024
+
+// The number of events a subscriber can fall behind by before it is dropped.
+const eventBufferSize = 64
+
+// How often a comment is sent on an idle event stream, so that proxies do not close it.
+const eventKeepAlive = 30 * time.Second
+
+// Turns the changes made to the database into events, and hands them out to every subscriber.
+type EventBroker struct {
+	mu          sync.Mutex
+	db          *database.Database
+	lastId      int64
+	history     []publishedEvent // The most recent events, oldest first
+	subscribers map[*EventSubscription]struct{}
+}
+
+// An event along with the members of the group it happened in, who are the only ones that may see it.
+type publishedEvent struct {
+	Event
+	members []string
+}
+
+// Limits the events a subscription receives to the given groups and channels.
+type EventFilter struct {
+	Viewer   types.UUID // The account receiving the events, only events in groups it is a member of are received
+	All      bool       // Receive every event the viewer may see, whatever group or channel it is in
+	Groups   []types.UUID
+	Channels []types.UUID
+}
+
+// A single subscriber's feed of events. The feed is closed if the subscriber falls too far behind,
+// in which case it should resubscribe from the id of the last event it received.
+type EventSubscription struct {
+	Events  <-chan Event
+	events  chan Event
+	filter  EventFilter
+	dropped bool // Set before the feed is closed for falling behind
+}
+
+// Create an event broker that is told about every change made to the database.
+func NewEventBroker(db *database.Database) *EventBroker {
+	broker := &EventBroker{
+		db:          db,
+		subscribers: make(map[*EventSubscription]struct{}),
+	}
+	db.OnChange(broker.publish)
+	return broker
+}
+
+/**
+ * Subscribe to the events matching a filter. If lastId is given, the events after it that are still remembered
+ * are returned, and must be handled before the events of the subscription.
+ */
+func (b *EventBroker) Subscribe(filter EventFilter, lastId *int64) (*EventSubscription, []Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	events := make(chan Event, eventBufferSize)
+	sub := &EventSubscription{Events: events, events: events, filter: filter}
+	b.subscribers[sub] = struct{}{}
+
+	missed := make([]Event, 0)
+	if lastId != nil {
+		for _, event := range b.history {
+			if event.Id > *lastId && filter.matches(event) {
+				missed = append(missed, event.Event)
+			}
+		}
+	}
+	return sub, missed
+}
+
+// Whether the feed was closed because the subscriber fell too far behind, rather than because it unsubscribed.
+// Only known once the feed has been closed.
+func (s *EventSubscription) Dropped() bool {
+	return s.dropped
+}
+
+// Change the events a subscription receives from now on.
+func (b *EventBroker) SetFilter(sub *EventSubscription, filter EventFilter) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	sub.filter = filter
+}
+
+// Stop a subscription, closing its feed if it has not been closed already.
+func (b *EventBroker) Unsubscribe(sub *EventSubscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.subscribers[sub]; ok {
+		delete(b.subscribers, sub)
+		close(sub.events)
+	}
+}
+
+func (b *EventBroker) publish(change database.Change) {
+	event, ok := b.toEvent(change)
+	if !ok {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.lastId++
+	event.Id = b.lastId
+	b.history = append(b.history, event)
+	if len(b.history) > eventHistorySize {
+		b.history = b.history[len(b.history)-eventHistorySize:]
+	}
+
+	for sub := range b.subscribers {
+		if !sub.filter.matches(event) {
+			continue
+		}
+		select {
+		case sub.events <- event.Event:
+		default:
+			delete(b.subscribers, sub)
+			sub.dropped = true
+			close(sub.events)
+		}
+	}
+}
+
+/**
+ * Convert a change to a document into the event describing it, working out the group and channel it happened in
+ * and who is a member of that group.
+ */
+func (b *EventBroker) toEvent(change database.Change) (publishedEvent, bool) {
+	itemType, ok := storeItemType(change.Store)
+	if !ok {
+		return publishedEvent{}, false
+	}
+	id, err := uuid.Parse(change.Id)
+	if err != nil {
+		return publishedEvent{}, false
+	}
+
+	event := publishedEvent{Event: Event{
+		Type: EventType(itemType + "." + string(change.Kind)),
+		Item: id,
+		Data: map[string]interface{}{"id": change.Id},
+	}}
+	for key, value := range change.Document {
+		if key != TypeKey {
+			event.Data[key] = value
+		}
+	}
+
+	var group, channel string
+	switch change.Store {
+	case database.GroupStore:
+		// Use the members from the change itself, the group may already be gone
+		group = change.Id
+		event.members = change.Fields["members"]
+	case database.ChannelStore:
+		channel = change.Id
+		group = firstValue(change.Fields["group"])
+	case database.MessageStore:
+		channel = firstValue(change.Fields["channel"])
+		group, _ = groupOfChannel(b.db, channel)
+	}
+	if group != "" && event.members == nil {
+		fields, _ := b.db.Index(database.GroupStore).Values(group)
+		event.members = fields["members"]
+	}
+	if parsed, err := uuid.Parse(group); err == nil {
+		event.Group = &parsed
+	}
+	if parsed, err := uuid.Parse(channel); err == nil {
+		event.Channel = &parsed
+	}
+	return event, true
+}
+
+func (f EventFilter) matches(event publishedEvent) bool {
+	if event.Group != nil && !slices.Contains(event.members, f.Viewer.String()) {
+		return false
+	}
+	if f.All {
+		return true
+	}
+	if event.Group != nil && slices.Contains(f.Groups, *event.Group) {
+		return true
+	}
+	if event.Channel != nil && slices.Contains(f.Channels, *event.Channel) {
+		return true
+	}
+	return false
+}
+
+// Check that the viewer of a filter is a member of every group it asks for, and of the groups of every channel.
+func authorizeFilter(db *database.Database, filter EventFilter) error {
+	for _, group := range filter.Groups {
+		if !isMember(db, filter.Viewer, group.String()) {
+			return ErrForbidden
+		}
+	}
+	for _, channel := range filter.Channels {
+		group, ok := groupOfChannel(db, channel.String())
+		if !ok || !isMember(db, filter.Viewer, group) {
+			return ErrForbidden
+		}
+	}
+	return nil
+}
+
+// Get the item type of the documents kept in the named store.
+func storeItemType(name string) (string, bool) {
+	for t, store := range itemStores {
+		if store == name {
+			return itemTypes[t], true
+		}
+	}
+	return "", false
+}
+
+func firstValue(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// StreamEvents implements ServerInterface.
+func (s *SectorAPI) StreamEvents(w http.ResponseWriter, r *http.Request, params StreamEventsParams) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeProblem(w, http.StatusInternalServerError, Internal, "Streaming is not supported.")
+		return
+	}
+
+	var lastId *int64
+	if params.LastEventID != nil {
+		id, err := strconv.ParseInt(*params.LastEventID, 10, 64)
+		if err != nil {
+			s.log(r).Debug("Could not parse Last-Event-ID", zap.Error(err))
+			writeProblem(w, http.StatusBadRequest, BadRequest, "Could not parse Last-Event-ID.")
+			return
+		}
+		lastId = &id
+	}
+
+	caller, err := callerOf(r)
+	if s.denied(w, r, err) {
+		return
+	}
+
+	// The token only authenticates the stream until it expires, the client has to reconnect with a new one
+	expired := make(<-chan time.Time)
+	if claims, ok := r.Context().Value(middleware.ContextKeyUser).(*auth.Claims); ok && claims.ExpiresAt != nil {
+		expiry := time.NewTimer(time.Until(claims.ExpiresAt.Time))
+		defer expiry.Stop()
+		expired = expiry.C
+	}
+
+	filter := EventFilter{Viewer: caller, All: params.Group == nil && params.Channel == nil}
+	if params.Group != nil {
+		filter.Groups = *params.Group
+	}
+	if params.Channel != nil {
+		filter.Channels = *params.Channel
+	}
+	if s.denied(w, r, authorizeFilter(s.DB, filter)) {
+		return
+	}
+
+	sub, missed := s.Events.Subscribe(filter, lastId)
+	defer s.Events.Unsubscribe(sub)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	for _, event := range missed {
+		writeEvent(w, event)
+	}
+	flusher.Flush()
+
+	keepAlive := time.NewTicker(eventKeepAlive)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-expired:
+			fmt.Fprint(w, ": token expired\n\n")
+			flusher.Flush()
+			return
+		case event, ok := <-sub.Events:
+			if !ok {
+				// Fell too far behind, the client will reconnect with the id of the last event it got
+				return
+			}
+			writeEvent(w, event)
+			flusher.Flush()
+		case <-keepAlive.C:
+			fmt.Fprint(w, ": keep-alive\n\n")
+			flusher.Flush()
+		}
+	}
+}
+
+// Write a single event in the server-sent events format.
+func writeEvent(w http.ResponseWriter, event Event) {
+	data, _ := json.Marshal(event)
+	fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.Id, event.Type, data)
+}
@@ -0,0 +1,462 @@
+package v1
+
+import (
+	"Sector/internal/auth"
+	"Sector/internal/database"
+	"Sector/internal/logger"
+	"Sector/internal/middleware"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/oapi-codegen/runtime/types"
+	"go.uber.org/zap"
+)
+
+// The types of the frames sent over a gateway connection.
+const (
+	// Sent by the client
+	FrameAuthenticate = "authenticate" // The JWT to use, when it could not be given in the Authorization header
+	FrameSubscribe    = "subscribe"    // Start receiving events for some groups and channels
+	FrameUnsubscribe  = "unsubscribe"  // Stop receiving events for some groups and channels
+	FrameSendMessage  = "send_message" // Post a message to a channel
+	FrameTyping       = "typing"       // Tell the subscribers of a channel that the user is typing, also sent by the server
+
+	// Sent by the server
+	FrameReady = "ready" // The connection is authenticated and ready to use
+	FrameEvent = "event" // An event from the event stream
+	FrameAck   = "ack"   // A frame from the client was handled
+	FrameError = "error" // A frame from the client could not be handled
+)
+
+const (
+	gatewayAuthTimeout  = 10 * time.Second // How long a client has to authenticate after connecting
+	gatewayWriteTimeout = 10 * time.Second // How long writing a single frame may take
+	gatewayPongTimeout  = 60 * time.Second // How long a client may go without answering a ping
+	gatewayPingInterval = 30 * time.Second // How often clients are pinged, must be less than the pong timeout
+	gatewaySendBuffer   = 64               // The number of frames that can be queued for a client before it is dropped
+	gatewayMaxFrameSize = 64 * 1024        // The largest frame a client may send
+)
+
+// A frame sent by the client. Which fields are used depends on the type of the frame.
+type GatewayRequest struct {
+	Type     string       `json:"type"`
+	Nonce    string       `json:"nonce,omitempty"` // Echoed back in the ack or error for this frame
+	Token    string       `json:"token,omitempty"`
+	Groups   []types.UUID `json:"groups,omitempty"`
+	Channels []types.UUID `json:"channels,omitempty"`
+	Channel  *types.UUID  `json:"channel,omitempty"`
+	Id       *types.UUID  `json:"id,omitempty"`
+	Body     string       `json:"body,omitempty"`
+}
+
+// A frame sent by the server. Which fields are set depends on the type of the frame.
+type GatewayResponse struct {
+	Type     string       `json:"type"`
+	Nonce    string       `json:"nonce,omitempty"`
+	Error    string       `json:"error,omitempty"`
+	User     *types.UUID  `json:"user,omitempty"`
+	Group    *types.UUID  `json:"group,omitempty"`
+	Channel  *types.UUID  `json:"channel,omitempty"`
+	Groups   []types.UUID `json:"groups,omitempty"`
+	Channels []types.UUID `json:"channels,omitempty"`
+	Event    *Event       `json:"event,omitempty"`
+	Message  *Message     `json:"message,omitempty"`
+}
+
+/**
+ * The WebSocket gateway, which lets a client subscribe to the events of groups and channels, post messages
+ * and send typing indicators over a single connection rather than polling the REST API.
+ */
+type Gateway struct {
+	// Messages sent over the gateway are taken from the same allowance as writes to the API, so that they cannot be
+	// used to get around its rate limit. Set before the gateway serves any connections, not limited if nil.
+	Writes *middleware.RateLimiter
+
+	logger   *zap.Logger
+	db       *database.Database
+	events   *EventBroker
+	tokens   *auth.Service // Validates the tokens clients authenticate with
+	upgrader websocket.Upgrader
+
+	mu    sync.Mutex
+	conns map[*gatewayConn]struct{}
+}
+
+// A single client connected to the gateway.
+type gatewayConn struct {
+	gateway *Gateway
+	ctx     context.Context // The request the connection was opened by, cancelled once it closes
+	cancel  context.CancelFunc
+	logger  *zap.Logger // Notes the request the connection was opened by, and the user
+	expiry  *time.Timer // Closes the connection once the token it was authenticated with expires
+	ws      *websocket.Conn
+	user    types.UUID
+	session string // The login session of the token the connection was authenticated with
+	device  string // The device key that signed in to the session
+	sub     *EventSubscription
+	send    chan GatewayResponse
+	done    chan struct{}
+	once    sync.Once
+
+	mu     sync.Mutex
+	filter EventFilter
+}
+
+// Create a gateway that sends the events of the given broker to its clients, authenticated by the given service.
+func NewGateway(logger *zap.Logger, db *database.Database, events *EventBroker, tokens *auth.Service) *Gateway {
+	return &Gateway{
+		logger: logger,
+		db:     db,
+		events: events,
+		tokens: tokens,
+		upgrader: websocket.Upgrader{
+			// Connections are authenticated with a bearer token rather than a cookie, so a page on another
+			// origin cannot act as the user without already having their token.
+			CheckOrigin: func(r *http.Request) bool { return true },
+		},
+		conns: make(map[*gatewayConn]struct{}),
+	}
+}
+
+// Upgrade a request to a gateway connection, and serve it until either side closes it.
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	log := logger.FromContext(r.Context(), g.logger)
+
+	// Authenticate before upgrading if we can, so a bad token gets a proper status code
+	var claims *auth.Claims
+	if tokenStr, err := auth.ExtractTokenFromRequest(r); err == nil {
+		claims, err = g.tokens.ValidateToken(tokenStr)
+		if err != nil {
+			log.Debug("Refused token", zap.Error(err))
+			writeProblem(w, http.StatusUnauthorized, Unauthorized, "Invalid token.")
+			return
+		}
+	}
+
+	ws, err := g.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Debug("Could not upgrade to a WebSocket", zap.Error(err))
+		return
+	}
+	ws.SetReadLimit(gatewayMaxFrameSize)
+
+	// Browsers cannot set headers on a WebSocket, so they authenticate with their first frame instead
+	if claims == nil {
+		claims, err = g.authenticate(ws)
+		if err != nil {
+			log.Debug("Refused authentication frame", zap.Error(err))
+			ws.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "unauthorized"), time.Now().Add(gatewayWriteTimeout))
+			ws.Close()
+			return
+		}
+	}
+
+	user, err := uuid.Parse(claims.UserID)
+	if err != nil {
+		log.Debug("Refused token", zap.Error(err))
+		ws.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "unauthorized"), time.Now().Add(gatewayWriteTimeout))
+		ws.Close()
+		return
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	sub, _ := g.events.Subscribe(EventFilter{Viewer: user}, nil)
+	conn := &gatewayConn{
+		gateway: g,
+		ctx:     ctx,
+		cancel:  cancel,
+		logger:  log.With(zap.String("user", user.String())),
+		ws:      ws,
+		user:    user,
+		session: claims.SessionID,
+		device:  claims.DeviceID,
+		sub:     sub,
+		send:    make(chan GatewayResponse, gatewaySendBuffer),
+		done:    make(chan struct{}),
+	}
+
+	// The token only authenticates the connection until it expires, the client has to reconnect with a new one
+	if claims.ExpiresAt != nil {
+		conn.expiry = time.AfterFunc(time.Until(claims.ExpiresAt.Time), func() {
+			conn.ws.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "token expired"), time.Now().Add(gatewayWriteTimeout))
+			conn.close()
+		})
+	}
+
+	g.mu.Lock()
+	g.conns[conn] = struct{}{}
+	g.mu.Unlock()
+
+	conn.queue(GatewayResponse{Type: FrameReady, User: &user})
+	go conn.writeLoop()
+	conn.readLoop()
+}
+
+// Wait for the authenticate frame of a client that did not send its token in the Authorization header.
+func (g *Gateway) authenticate(ws *websocket.Conn) (*auth.Claims, error) {
+	ws.SetReadDeadline(time.Now().Add(gatewayAuthTimeout))
+	defer ws.SetReadDeadline(time.Time{})
+
+	var request GatewayRequest
+	if err := ws.ReadJSON(&request); err != nil {
+		return nil, err
+	}
+	if request.Type != FrameAuthenticate {
+		return nil, fmt.Errorf("%s", "expected an authenticate frame, got: "+request.Type)
+	}
+	return g.tokens.ValidateToken(request.Token)
+}
+
+// Close every connection authenticated with a token of the given session, since the session has ended.
+func (g *Gateway) EndSession(session string) {
+	g.closeWhere(func(conn *gatewayConn) bool { return conn.session == session }, websocket.ClosePolicyViolation, "logged out")
+}
+
+// Close every connection authenticated with a token from a session the given device key signed in to.
+func (g *Gateway) EndDevice(device string) {
+	g.closeWhere(func(conn *gatewayConn) bool { return conn.device == device }, websocket.ClosePolicyViolation, "logged out")
+}
+
+// Close every connection, since the server is shutting down.
+func (g *Gateway) Close() {
+	g.closeWhere(func(conn *gatewayConn) bool { return true }, websocket.CloseGoingAway, "shutting down")
+}
+
+func (g *Gateway) closeWhere(matches func(conn *gatewayConn) bool, code int, reason string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for conn := range g.conns {
+		if matches(conn) {
+			conn.ws.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(gatewayWriteTimeout))
+			conn.close()
+		}
+	}
+}
+
+// Send a typing indicator to every connection subscribed to the channel, other than the one typing.
+func (g *Gateway) broadcastTyping(from *gatewayConn, group, channel types.UUID) {
+	typing := GatewayResponse{Type: FrameTyping, User: &from.user, Group: &group, Channel: &channel}
+	fields, _ := g.db.Index(database.GroupStore).Values(group.String())
+	target := publishedEvent{Event: Event{Group: &group, Channel: &channel}, members: fields["members"]}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for conn := range g.conns {
+		if conn != from && conn.currentFilter().matches(target) {
+			conn.queue(typing)
+		}
+	}
+}
+
+func (c *gatewayConn) currentFilter() EventFilter {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return EventFilter{Viewer: c.user, Groups: slices.Clone(c.filter.Groups), Channels: slices.Clone(c.filter.Channels)}
+}
+
+// Queue a frame to be written to the client, dropping the client if it is too far behind to take it.
+func (c *gatewayConn) queue(response GatewayResponse) {
+	select {
+	case c.send <- response:
+	case <-c.done:
+	default:
+		c.close()
+	}
+}
+
+func (c *gatewayConn) close() {
+	c.once.Do(func() {
+		close(c.done)
+		c.cancel()
+		c.ws.Close()
+	})
+}
+
+func (c *gatewayConn) writeLoop() {
+	ping := time.NewTicker(gatewayPingInterval)
+	defer ping.Stop()
+	defer c.close()
+
+	write := func(response GatewayResponse) error {
+		c.ws.SetWriteDeadline(time.Now().Add(gatewayWriteTimeout))
+		return c.ws.WriteJSON(response)
+	}
+
+	for {
+		select {
+		case <-c.done:
+			return
+		case response := <-c.send:
+			if err := write(response); err != nil {
+				return
+			}
+		case event, ok := <-c.sub.Events:
+			if !ok {
+				// Either the connection is closing, or it fell too far behind to keep up with the events
+				if c.sub.Dropped() {
+					c.ws.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "too slow"), time.Now().Add(gatewayWriteTimeout))
+				}
+				return
+			}
+			if err := write(GatewayResponse{Type: FrameEvent, Event: &event}); err != nil {
+				return
+			}
+		case <-ping.C:
+			if err := c.ws.WriteControl(websocket.PingMessage, nil, time.Now().Add(gatewayWriteTimeout)); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (c *gatewayConn) readLoop() {
+	defer func() {
+		c.gateway.mu.Lock()
+		delete(c.gateway.conns, c)
+		c.gateway.mu.Unlock()
+
+		c.close()
+		c.gateway.events.Unsubscribe(c.sub)
+		if c.expiry != nil {
+			c.expiry.Stop()
+		}
+	}()
+
+	c.ws.SetReadDeadline(time.Now().Add(gatewayPongTimeout))
+	c.ws.SetPongHandler(func(string) error {
+		return c.ws.SetReadDeadline(time.Now().Add(gatewayPongTimeout))
+	})
+
+	for {
+		var request GatewayRequest
+		if err := c.ws.ReadJSON(&request); err != nil {
+			if _, ok := err.(*websocket.CloseError); !ok {
+				c.logger.Debug("Could not read frame", zap.Error(err))
+			}
+			return
+		}
+		c.handle(request)
+	}
+}
+
+// Handle a single frame from the client.
+func (c *gatewayConn) handle(request GatewayRequest) {
+	fail := func(reason string) {
+		c.queue(GatewayResponse{Type: FrameError, Nonce: request.Nonce, Error: reason})
+	}
+
+	switch request.Type {
+	case FrameSubscribe, FrameUnsubscribe:
+		if request.Type == FrameSubscribe && authorizeFilter(c.gateway.db, EventFilter{Viewer: c.user, Groups: request.Groups, Channels: request.Channels}) != nil {
+			fail("Not a member of every group subscribed to.")
+			return
+		}
+
+		c.mu.Lock()
+		if request.Type == FrameSubscribe {
+			c.filter.Groups = addIds(c.filter.Groups, request.Groups)
+			c.filter.Channels = addIds(c.filter.Channels, request.Channels)
+		} else {
+			c.filter.Groups = removeIds(c.filter.Groups, request.Groups)
+			c.filter.Channels = removeIds(c.filter.Channels, request.Channels)
+		}
+		c.mu.Unlock()
+
+		filter := c.currentFilter()
+		c.gateway.events.SetFilter(c.sub, filter)
+		c.queue(GatewayResponse{Type: FrameAck, Nonce: request.Nonce, Groups: filter.Groups, Channels: filter.Channels})
+
+	case FrameSendMessage:
+		if c.gateway.Writes != nil {
+			if allowed, _ := c.gateway.Writes.Allow(middleware.UserKey(c.user.String())); !allowed {
+				fail("Too many requests, please try again later.")
+				return
+			}
+		}
+		if request.Channel == nil || request.Body == "" {
+			fail("A message needs a channel and a body.")
+			return
+		}
+		group, ok := groupOfChannel(c.gateway.db, request.Channel.String())
+		if !ok {
+			fail("Channel not found.")
+			return
+		}
+		if !isMember(c.gateway.db, c.user, group) {
+			fail("Not a member of the channel's group.")
+			return
+		}
+
+		now := time.Now()
+		message := Message{
+			Id:        uuid.New(),
+			Author:    c.user,
+			Body:      request.Body,
+			Channel:   *request.Channel,
+			CreatedAt: &now,
+		}
+		if request.Id != nil {
+			message.Id = *request.Id
+		}
+		if _, err := addItem(c.ctx, c.gateway.db, message); errors.Is(err, ErrDeleting) {
+			fail("Channel is being deleted.")
+			return
+		} else if err != nil {
+			c.logger.Error("Could not add message to database", zap.Error(err))
+			fail("Could not add message to database.")
+			return
+		}
+		c.queue(GatewayResponse{Type: FrameAck, Nonce: request.Nonce, Message: &message})
+
+	case FrameTyping:
+		if request.Channel == nil {
+			fail("Typing needs a channel.")
+			return
+		}
+		group, ok := groupOfChannel(c.gateway.db, request.Channel.String())
+		if !ok {
+			fail("Channel not found.")
+			return
+		}
+		if !isMember(c.gateway.db, c.user, group) {
+			fail("Not a member of the channel's group.")
+			return
+		}
+		// The index is built from replicated documents, so the group may not be an id at all
+		groupId, err := uuid.Parse(group)
+		if err != nil {
+			c.logger.Warn("Channel has an invalid group", zap.String("channel", request.Channel.String()), zap.Error(err))
+			fail("Channel's group is invalid.")
+			return
+		}
+		c.gateway.broadcastTyping(c, groupId, *request.Channel)
+
+	default:
+		fail("Unknown frame type.")
+	}
+}
+
+// Add the ids that are not already in the list to it.
+func addIds(ids, add []types.UUID) []types.UUID {
+	for _, id := range add {
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// Remove the given ids from the list.
+func removeIds(ids, remove []types.UUID) []types.UUID {
+	return slices.DeleteFunc(ids, func(id types.UUID) bool {
+		return slices.Contains(remove, id)
+	})
+}
@@ -0,0 +1,112 @@
+package v1
+
+import (
+	"Sector/internal/database"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// GetHealth implements ServerInterface.
+func (s *SectorAPI) GetHealth(w http.ResponseWriter, r *http.Request) {
+	s.GetLiveness(w, r)
+}
+
+// GetLiveness implements ServerInterface.
+func (s *SectorAPI) GetLiveness(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(Liveness{Status: Ok})
+}
+
+// GetReadiness implements ServerInterface.
+func (s *SectorAPI) GetReadiness(w http.ResponseWriter, r *http.Request) {
+	readiness := readinessOf(s.DB.Health(r.Context()), s.MinPeers, time.Now())
+
+	status := http.StatusOK
+	if !readiness.Ready {
+		status = http.StatusServiceUnavailable
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(readiness)
+}
+
+/**
+ * Decide whether the node is ready from the health of its database, noting every reason it is not:
+ *
+ * => Stores - each has to be loaded and indexed, and have reported that it is ready
+ * => IPFS - the node has to be online, and its repo readable
+ * => Peers - at least the minimum number have to be connected
+ *
+ * Replication is only reported, as a node can be up to date without its peers having written anything new.
+ */
+func readinessOf(health database.Health, minPeers int, now time.Time) Readiness {
+	readiness := Readiness{
+		Problems: make([]string, 0),
+		Stores:   make([]StoreStatus, 0, len(health.Stores)),
+		Peers:    health.Peers,
+		MinPeers: minPeers,
+		Ipfs: IPFSStatus{
+			Online: health.Online,
+			Repo:   health.RepoPath,
+		},
+	}
+
+	for _, store := range health.Stores {
+		readiness.Stores = append(readiness.Stores, StoreStatus{
+			Name:      store.Name,
+			Address:   store.Address,
+			Loaded:    store.Loaded,
+			Ready:     store.Ready,
+			Documents: store.Documents,
+		})
+		if !store.Loaded {
+			readiness.Problems = append(readiness.Problems, fmt.Sprintf("store '%s' is not loaded", store.Name))
+		} else if !store.Ready {
+			readiness.Problems = append(readiness.Problems, fmt.Sprintf("store '%s' is not ready", store.Name))
+		}
+	}
+
+	if !health.Online {
+		readiness.Problems = append(readiness.Problems, "IPFS node is offline")
+	}
+	if health.RepoErr != nil {
+		repoErr := health.RepoErr.Error()
+		readiness.Ipfs.RepoError = &repoErr
+		readiness.Problems = append(readiness.Problems, "IPFS repo cannot be read: "+repoErr)
+	} else {
+		size := int64(health.RepoSize)
+		readiness.Ipfs.RepoSize = &size
+	}
+
+	if health.Peers < minPeers {
+		readiness.Problems = append(readiness.Problems, fmt.Sprintf("%d peers connected, %d needed", health.Peers, minPeers))
+	}
+
+	if !health.LastReplication.IsZero() {
+		since := now.Sub(health.LastReplication).Seconds()
+		readiness.LastReplication = &health.LastReplication
+		readiness.SinceLastReplication = &since
+	}
+
+	readiness.Ready = len(readiness.Problems) == 0
+	return readiness
+}
+
+/**
+ * Answer requests with 503 until the API has connected to the database, apart from the health checks, which report
+ * how loading the stores is getting on, and the metrics of the node.
+ */
+func (s *SectorAPI) WhileConnecting(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !s.Connected() && !strings.HasPrefix(r.URL.Path, "/v1/api/health") && r.URL.Path != "/metrics" {
+			w.Header().Set("Retry-After", "5")
+			writeProblem(w, http.StatusServiceUnavailable, Unavailable, "Still loading the database, please try again later.")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,216 @@
+package v1
+
+import (
+	"Sector/internal/database"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"berty.tech/go-orbit-db/iface"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// CheckIntegrity implements ServerInterface.
+func (s *SectorAPI) CheckIntegrity(w http.ResponseWriter, r *http.Request) {
+	s.integrity(w, r, false)
+}
+
+// RepairIntegrity implements ServerInterface.
+func (s *SectorAPI) RepairIntegrity(w http.ResponseWriter, r *http.Request) {
+	s.integrity(w, r, true)
+}
+
+func (s *SectorAPI) integrity(w http.ResponseWriter, r *http.Request, repair bool) {
+	caller, ok := s.adminOf(w, r)
+	if !ok {
+		return
+	}
+
+	report, err := ScanIntegrity(r.Context(), s.DB, repair)
+	if err != nil {
+		s.fail(w, r, err, "Could not check the integrity of the database.")
+		return
+	}
+	if repair {
+		s.log(r).Info("Repaired database integrity", zap.String("admin", caller.String()), zap.Int("issues", len(report.Issues)))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(report)
+}
+
+/**
+ * Check that the items in the database only refer to items that exist, repairing the ones that do not if asked to.
+ * References are only checked when an item is added, so they can still break when a peer replicates a deletion, or
+ * a write, that we had not seen the other side of:
+ *
+ * => Groups - every member must be an account, missing members are removed from the group. If that removes its
+ *    last owner, ownership is transferred to a member that is left, see removeMembers, and if no one is left the
+ *    group is quarantined, since no one could administer it
+ * => Channels - the group must exist, channels without one are quarantined
+ * => Messages - the channel must exist, messages without one are quarantined
+ *
+ * Groups are checked before channels, and channels before messages, so what is left in an item quarantined in the
+ * same run is found too.
+ */
+func ScanIntegrity(ctx context.Context, db *database.Database, repair bool) (IntegrityReport, error) {
+	report := IntegrityReport{
+		CheckedAt: time.Now(),
+		Issues:    make([]IntegrityIssue, 0),
+	}
+
+	// Groups listing accounts that are gone
+	for _, id := range db.Index(database.GroupStore).IDs() {
+		report.Scanned++
+		fields, _ := db.Index(database.GroupStore).Values(id)
+		missing := slices.DeleteFunc(fields["members"], func(member string) bool {
+			return db.Index(database.AccountStore).Has(member)
+		})
+		if len(missing) == 0 {
+			continue
+		}
+
+		group, err := getGroupDocument(ctx, db, id)
+		if err != nil {
+			return report, err
+		}
+		left := slices.DeleteFunc(database.IndexValues(group["members"]), func(member string) bool {
+			return slices.Contains(missing, member)
+		})
+		ownerLeft := slices.ContainsFunc(left, func(member string) bool {
+			return roleIn(group, member) == Owner
+		})
+
+		repaired := false
+		if repair {
+			if len(left) == 0 {
+				err = db.Quarantine(ctx, database.GroupStore, id, "every member is gone")
+			} else {
+				err = removeMembers(ctx, db, group, missing)
+			}
+			if err != nil {
+				return report, err
+			}
+			repaired = true
+		}
+		for _, member := range missing {
+			how := RemoveReference
+			if roleIn(group, member) == Owner && !ownerLeft {
+				how = TransferOwnership
+				if len(left) == 0 {
+					how = Quarantine
+				}
+			}
+			report.Issues = append(report.Issues, newIntegrityIssue(MissingMember, database.GroupStore, id, member, how, repaired))
+		}
+	}
+
+	// Channels in groups that are gone, then messages in channels that are gone
+	checks := []struct {
+		store, field, parent string
+		kind                 IntegrityIssueKind
+	}{
+		{database.ChannelStore, "group", database.GroupStore, MissingGroup},
+		{database.MessageStore, "channel", database.ChannelStore, MissingChannel},
+	}
+	for _, check := range checks {
+		for _, id := range db.Index(check.store).IDs() {
+			report.Scanned++
+			fields, _ := db.Index(check.store).Values(id)
+			parent := firstValue(fields[check.field])
+			if db.Index(check.parent).Has(parent) {
+				continue
+			}
+
+			repaired := false
+			if repair {
+				reason := fmt.Sprintf("%s '%s' is gone", check.field, parent)
+				if err := db.Quarantine(ctx, check.store, id, reason); err != nil {
+					return report, err
+				}
+				repaired = true
+			}
+			report.Issues = append(report.Issues, newIntegrityIssue(check.kind, check.store, id, parent, Quarantine, repaired))
+		}
+	}
+
+	return report, nil
+}
+
+func newIntegrityIssue(kind IntegrityIssueKind, store, item, reference string, repair IntegrityIssueRepair, repaired bool) IntegrityIssue {
+	// Ids that do not parse are reported as the nil id, rather than leaving the item out of the report
+	itemId, _ := uuid.Parse(item)
+	referenceId, _ := uuid.Parse(reference)
+	return IntegrityIssue{
+		Kind:      kind,
+		Store:     store,
+		Item:      itemId,
+		Reference: referenceId,
+		Repair:    repair,
+		Repaired:  repaired,
+	}
+}
+
+// Get the document of a group
+func getGroupDocument(ctx context.Context, db *database.Database, groupId string) (map[string]interface{}, error) {
+	matches, err := db.Store(database.GroupStore).Get(ctx, groupId, &iface.DocumentStoreGetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) != 1 {
+		return nil, fmt.Errorf("cannot get group '%s': found %d documents", groupId, len(matches))
+	}
+	return matches[0].(map[string]interface{}), nil
+}
+
+// Get the role a member has in the document of a group, see roleOf
+func roleIn(group map[string]interface{}, member string) GroupRole {
+	if roles, ok := group["roles"].(map[string]interface{}); ok {
+		if role, ok := roles[member].(string); ok {
+			return GroupRole(role)
+		}
+	}
+	return Member
+}
+
+/**
+ * Take the given accounts out of the members of a group, along with any roles they had in it. If that leaves the
+ * group without an owner, the member with the highest role left becomes its owner, picking whoever has been a member
+ * longest, the first listed, between members with the same role.
+ */
+func removeMembers(ctx context.Context, db *database.Database, group map[string]interface{}, accounts []string) error {
+	roles, ok := group["roles"].(map[string]interface{})
+	if !ok {
+		roles = make(map[string]interface{})
+	}
+	for _, account := range accounts {
+		delete(roles, account)
+	}
+	group["roles"] = roles
+
+	members := make([]interface{}, 0)
+	successor := ""
+	hasOwner := false
+	for _, member := range database.IndexValues(group["members"]) {
+		if slices.Contains(accounts, member) {
+			continue
+		}
+		members = append(members, member)
+		hasOwner = hasOwner || roleIn(group, member) == Owner
+		if successor == "" || roleIn(group, member).Outranks(roleIn(group, successor)) {
+			successor = member
+		}
+	}
+	group["members"] = members
+	if !hasOwner && successor != "" {
+		roles[successor] = string(Owner)
+	}
+
+	_, err := db.Put(ctx, database.GroupStore, group)
+	return err
+}
@@ -0,0 +1,448 @@
+package v1
+
+import (
+	"Sector/internal/database"
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+	"time"
+
+	"berty.tech/go-orbit-db/iface"
+	"github.com/google/uuid"
+	"github.com/oapi-codegen/runtime/types"
+	"go.uber.org/zap"
+)
+
+// How many items a job deletes between each save of its progress
+const jobProgressInterval = 100
+
+// How often the jobs that finished before the retention period are looked for and dropped
+const jobPruneInterval = time.Hour
+
+/**
+ * Jobs runs the deletions of groups and channels, which take too long to finish within a request. Each job is
+ * recorded in the jobs store before anything is deleted, and its progress saved as it goes, so that one cut short
+ * by a restart is picked up again by Resume.
+ *
+ * A job deletes messages first, then channels, and the item itself last, so whatever point it stops at nothing is
+ * left behind that belongs to an item that is gone. Nothing can be added to an item while a job is deleting it, see
+ * beingDeleted, and it looks for anything added just before it started before deleting the item itself.
+ *
+ * A job deletes all of the item or none of it. Each document is copied into the jobs store before it is deleted, and
+ * if the job fails everything it deleted is put back from those copies, see restore. The copies are dropped once the
+ * job is done, and finished jobs are dropped once they are older than the retention period, see Prune.
+ */
+type Jobs struct {
+	logger    *zap.Logger
+	db        *database.Database
+	retention time.Duration // How long finished jobs are kept for
+
+	mu      sync.Mutex
+	running map[types.UUID]bool // The jobs being run, keyed by id
+	pruning bool                // Whether finished jobs are being pruned in the background
+	ctx     context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+// Create a runner for the jobs kept in the database, which keeps finished jobs for the retention period. Nothing is
+// run until a job is started or resumed, the database does not have to be connected until then.
+func NewJobs(logger *zap.Logger, db *database.Database, retention time.Duration) *Jobs {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Jobs{
+		logger:    logger,
+		db:        db,
+		retention: retention,
+		running:   make(map[types.UUID]bool),
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+}
+
+/**
+ * Record a job to delete a group or channel, along with everything in it, and start running it. If the item is
+ * already being deleted, the job doing so is given instead of starting another.
+ */
+func (j *Jobs) StartDeletion(t reflect.Type, item, caller types.UUID) (Job, error) {
+	kind, ok := itemTypes[t]
+	if !ok || (t != reflect.TypeOf(Group{}) && t != reflect.TypeOf(Channel{})) {
+		return Job{}, fmt.Errorf("cannot start a deletion job for item type '%v'", t)
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	for _, id := range j.db.Index(database.JobStore).Lookup("item", item.String()) {
+		// The jobs store is replicated, so a peer may have written an id that is not one
+		jobId, err := uuid.Parse(id)
+		if err != nil {
+			continue
+		}
+		job, err := j.Get(jobId)
+		if err == nil && (job.Status == Pending || job.Status == Running) {
+			return job, nil
+		}
+	}
+
+	now := time.Now()
+	job := Job{
+		Id:          uuid.New(),
+		Kind:        kind,
+		Item:        item,
+		Status:      Pending,
+		RequestedBy: caller,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := j.save(job); err != nil {
+		return Job{}, err
+	}
+	j.run(job)
+	return job, nil
+}
+
+// Whether any of the items is being deleted by a job that has not finished, nothing can be added to them if so
+func beingDeleted(db *database.Database, items ...string) bool {
+	index := db.Index(database.JobStore)
+	unfinished := index.Lookup("status", string(Pending), string(Running))
+	for _, id := range index.Lookup("item", items...) {
+		if slices.Contains(unfinished, id) {
+			return true
+		}
+	}
+	return false
+}
+
+// Get a job by its id
+func (j *Jobs) Get(id types.UUID) (Job, error) {
+	var job Job
+	matches, err := j.db.Store(database.JobStore).Get(context.Background(), id.String(), &iface.DocumentStoreGetOptions{})
+	if err != nil {
+		return job, err
+	}
+	if len(matches) == 0 {
+		return job, ErrNotFound
+	}
+	if len(matches) > 1 {
+		return job, ErrTooMany
+	}
+	return job, MapToStruct(matches[0].(map[string]interface{}), &job)
+}
+
+// Start every job of this node that has not finished, such as those cut short when it last stopped, and start
+// pruning the ones that have
+func (j *Jobs) Resume() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	ids := j.db.Index(database.JobStore).Lookup("status", string(Pending), string(Running))
+	for _, id := range ids {
+		matches, err := j.db.Store(database.JobStore).Get(context.Background(), id, &iface.DocumentStoreGetOptions{})
+		if err != nil {
+			return err
+		}
+		for _, match := range matches {
+			doc := match.(map[string]interface{})
+			if doc["node"] != j.db.GetOwnID() {
+				continue
+			}
+
+			var job Job
+			if err := MapToStruct(doc, &job); err != nil {
+				return err
+			}
+			j.logger.Info("Resuming job", zap.String("job", job.Id.String()), zap.Int("deleted", job.Deleted))
+			j.run(job)
+		}
+	}
+
+	if !j.pruning {
+		j.pruning = true
+		j.wg.Add(1)
+		go j.pruner()
+	}
+	return nil
+}
+
+// Drop the jobs of this node that finished before the retention period, along with any copies they kept
+func (j *Jobs) Prune() error {
+	cutoff := time.Now().Add(-j.retention)
+	for _, id := range j.db.Index(database.JobStore).Lookup("status", string(Done), string(Failed)) {
+		matches, err := j.db.Store(database.JobStore).Get(j.ctx, id, &iface.DocumentStoreGetOptions{})
+		if err != nil {
+			return err
+		}
+		for _, match := range matches {
+			doc := match.(map[string]interface{})
+			if doc["node"] != j.db.GetOwnID() {
+				continue
+			}
+
+			var job Job
+			if err := MapToStruct(doc, &job); err != nil || job.UpdatedAt.After(cutoff) {
+				continue
+			}
+			if err := j.dropCopies(j.ctx, id); err != nil {
+				return err
+			}
+			if _, err := j.db.Delete(j.ctx, database.JobStore, id); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Stop the jobs that are running and wait for them, they are left to be resumed the next time
+func (j *Jobs) Close() {
+	j.cancel()
+	j.wg.Wait()
+}
+
+// Prune finished jobs every so often, until the jobs are closed
+func (j *Jobs) pruner() {
+	defer j.wg.Done()
+	ticker := time.NewTicker(jobPruneInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := j.Prune(); err != nil && j.ctx.Err() == nil {
+			j.logger.Error("Could not prune jobs", zap.Error(err))
+		}
+		select {
+		case <-ticker.C:
+		case <-j.ctx.Done():
+			return
+		}
+	}
+}
+
+// Run a job in the background, unless it already is. The caller must hold the lock.
+func (j *Jobs) run(job Job) {
+	if j.running[job.Id] {
+		return
+	}
+	j.running[job.Id] = true
+	j.wg.Add(1)
+
+	go func() {
+		defer j.wg.Done()
+		defer func() {
+			j.mu.Lock()
+			delete(j.running, job.Id)
+			j.mu.Unlock()
+		}()
+
+		err := j.delete(&job)
+		if j.ctx.Err() != nil {
+			// Stopped rather than failed, it carries on when resumed
+			return
+		}
+		if err != nil {
+			j.logger.Error("Job failed, restoring what it deleted", zap.String("job", job.Id.String()), zap.Error(err))
+			message := err.Error()
+			if err := j.restore(&job); err != nil {
+				j.logger.Error("Could not restore what the job deleted", zap.String("job", job.Id.String()), zap.Error(err))
+				message += ", and could not restore what it deleted: " + err.Error()
+			}
+			job.Status = Failed
+			job.Error = &message
+		} else {
+			job.Status = Done
+			if err := j.dropCopies(j.ctx, job.Id.String()); err != nil {
+				j.logger.Warn("Could not drop the copies of what the job deleted", zap.String("job", job.Id.String()), zap.Error(err))
+			}
+		}
+		if err := j.save(job); err != nil {
+			j.logger.Error("Could not save job", zap.String("job", job.Id.String()), zap.Error(err))
+		}
+	}()
+}
+
+// The documents of one store that a job deletes
+type deletionStep struct {
+	store string
+	ids   []string
+}
+
+/**
+ * Delete the item of a job and everything in it, saving its progress as it goes. What is in the item is looked for
+ * again once it has been deleted, so that anything added while the job was starting is deleted too, and the item
+ * itself is only deleted once nothing is left in it.
+ */
+func (j *Jobs) delete(job *Job) error {
+	job.Status = Running
+	if err := j.save(*job); err != nil {
+		return err
+	}
+
+	item := deletionStep{database.ChannelStore, []string{job.Item.String()}}
+	if job.Kind == itemTypes[reflect.TypeOf(Group{})] {
+		item.store = database.GroupStore
+	}
+	for {
+		contents := j.contents(job)
+		present := j.db.Index(item.store).Has(item.ids[0])
+		remaining := 0
+		for _, step := range contents {
+			remaining += len(step.ids)
+		}
+		if present {
+			remaining++
+		}
+		job.Total = job.Deleted + remaining
+
+		if len(contents) == 0 {
+			// Nothing is left in the item, so it can go, unless it already has
+			if !present {
+				return nil
+			}
+			return j.deleteStep(job, item)
+		}
+		for _, step := range contents {
+			if err := j.deleteStep(job, step); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Work out what is left to delete within the item of a job, anything already deleted is no longer indexed
+func (j *Jobs) contents(job *Job) []deletionStep {
+	item := job.Item.String()
+	channels := []string{item}
+	if job.Kind == itemTypes[reflect.TypeOf(Group{})] {
+		channels = j.db.Index(database.ChannelStore).Lookup("group", item)
+	}
+	steps := []deletionStep{{database.MessageStore, j.db.Index(database.MessageStore).Lookup("channel", channels...)}}
+	if job.Kind == itemTypes[reflect.TypeOf(Group{})] {
+		steps = append(steps, deletionStep{database.ChannelStore, channels})
+	}
+
+	var left []deletionStep
+	for _, step := range steps {
+		step.ids = slices.DeleteFunc(step.ids, func(id string) bool { return !j.db.Index(step.store).Has(id) })
+		if len(step.ids) > 0 {
+			left = append(left, step)
+		}
+	}
+	return left
+}
+
+// Delete the documents of one step of a job, copying each one first so that it can be restored
+func (j *Jobs) deleteStep(job *Job, step deletionStep) error {
+	for _, id := range step.ids {
+		if err := j.ctx.Err(); err != nil {
+			return err
+		}
+		if err := j.keepCopy(job, step.store, id); err != nil {
+			return fmt.Errorf("cannot copy '%s' from %s: %w", id, step.store, err)
+		}
+		if _, err := j.db.Delete(j.ctx, step.store, id); err != nil {
+			return fmt.Errorf("cannot delete '%s' from %s: %w", id, step.store, err)
+		}
+
+		job.Deleted++
+		if job.Deleted%jobProgressInterval == 0 {
+			if err := j.save(*job); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// A document that a job deleted, copied into the jobs store until the job is done
+type deletedCopy struct {
+	id       string // The id of the copy in the jobs store
+	store    string // The store the document was deleted from
+	document map[string]interface{}
+}
+
+// The order stores are restored in, so that nothing is put back before what it belongs to
+var restoreOrder = []string{database.GroupStore, database.ChannelStore, database.MessageStore}
+
+// Copy a document into the jobs store before the job deletes it, unless it is already gone
+func (j *Jobs) keepCopy(job *Job, store, id string) error {
+	matches, err := j.db.Store(store).Get(j.ctx, id, &iface.DocumentStoreGetOptions{})
+	if err != nil || len(matches) == 0 {
+		return err
+	}
+
+	_, err = j.db.Put(j.ctx, database.JobStore, map[string]interface{}{
+		"id":       job.Id.String() + "/" + id,
+		"job":      job.Id.String(),
+		"store":    store,
+		"document": matches[0],
+	})
+	return err
+}
+
+// Get the copies of what a job deleted
+func (j *Jobs) copies(ctx context.Context, job string) ([]deletedCopy, error) {
+	var copies []deletedCopy
+	for _, id := range j.db.Index(database.JobStore).Lookup("job", job) {
+		matches, err := j.db.Store(database.JobStore).Get(ctx, id, &iface.DocumentStoreGetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, match := range matches {
+			doc := match.(map[string]interface{})
+			store, _ := doc["store"].(string)
+			document, ok := doc["document"].(map[string]interface{})
+			if !ok || !slices.Contains(restoreOrder, store) {
+				continue
+			}
+			copies = append(copies, deletedCopy{id: id, store: store, document: document})
+		}
+	}
+	return copies, nil
+}
+
+/**
+ * Put back everything a failed job deleted from the copies it kept, dropping each copy once it has been. The item
+ * goes back first, then its channels, then their messages. It carries on even if the jobs are being closed, so that
+ * the item is not left half restored.
+ */
+func (j *Jobs) restore(job *Job) error {
+	ctx := context.WithoutCancel(j.ctx)
+	copies, err := j.copies(ctx, job.Id.String())
+	if err != nil {
+		return err
+	}
+	slices.SortStableFunc(copies, func(a, b deletedCopy) int {
+		return slices.Index(restoreOrder, a.store) - slices.Index(restoreOrder, b.store)
+	})
+
+	for _, kept := range copies {
+		if _, err := j.db.Put(ctx, kept.store, kept.document); err != nil {
+			return fmt.Errorf("cannot restore '%v' to %s: %w", kept.document["id"], kept.store, err)
+		}
+		if _, err := j.db.Delete(ctx, database.JobStore, kept.id); err != nil {
+			return err
+		}
+		job.Restored++
+	}
+	return nil
+}
+
+// Drop the copies a job kept of what it deleted
+func (j *Jobs) dropCopies(ctx context.Context, job string) error {
+	for _, id := range j.db.Index(database.JobStore).Lookup("job", job) {
+		if _, err := j.db.Delete(ctx, database.JobStore, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Write a job to the jobs store, tagged with the node running it
+func (j *Jobs) save(job Job) error {
+	job.UpdatedAt = time.Now()
+	doc := StructToMap(job)
+	doc["node"] = j.db.GetOwnID()
+	_, err := j.db.Put(context.Background(), database.JobStore, doc)
+	return err
+}
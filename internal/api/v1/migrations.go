@@ -0,0 +1,220 @@
+package v1
+
+import (
+	"Sector/internal/database"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+
+	"github.com/oapi-codegen/runtime/types"
+	"go.uber.org/zap"
+)
+
+// The file, in the directory of the database, that records the legacy store has been migrated and dropped
+const LegacyMigratedFile = "legacy_store_migrated"
+
+/**
+ * Bring the documents in an existing database up to date with what the API expects. Every migration
+ * here must be safe to run more than once, since this is run every time the database is opened.
+ */
+func MigrateDatabase(db *database.Database) error {
+	if err := migrateLegacyStore(db); err != nil {
+		return fmt.Errorf("cannot migrate legacy store: %v", err)
+	}
+	if err := tagUntypedItems(db); err != nil {
+		return fmt.Errorf("cannot tag items with their type: %v", err)
+	}
+	if err := assignGroupOwners(db); err != nil {
+		return fmt.Errorf("cannot assign owners to groups: %v", err)
+	}
+	if err := addDefaultDevices(db); err != nil {
+		return fmt.Errorf("cannot add device keys to accounts: %v", err)
+	}
+	return nil
+}
+
+/**
+ * Move every document out of the legacy shared store and into the store for its type, then drop the
+ * legacy store. Legacy documents have no type tag, so their type has to be guessed from their fields.
+ *
+ * This is only done once, the legacy store is not opened again once LegacyMigratedFile has been written.
+ */
+func migrateLegacyStore(db *database.Database) error {
+	migrated := filepath.Join(db.LocalPath, LegacyMigratedFile)
+	if _, err := os.Stat(migrated); err == nil {
+		return nil
+	}
+
+	legacy, err := db.OpenStore(context.Background(), database.LegacyStore)
+	if err != nil {
+		return err
+	}
+
+	err = legacy.Load(context.Background(), -1)
+	if err != nil {
+		legacy.Close()
+		return err
+	}
+
+	docs, err := legacy.Query(context.Background(), func(doc interface{}) (bool, error) {
+		return true, nil
+	})
+	if err != nil {
+		legacy.Close()
+		return err
+	}
+
+	if len(docs) > 0 {
+		db.Logger.Info("Migrating legacy store ...", zap.Int("documents", len(docs)))
+	}
+	for _, doc := range docs {
+		entry, ok := doc.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		detected, err := DetectAndUnmarshal(entry)
+		if err != nil {
+			db.Logger.Warn("Skipping legacy document of unknown type", zap.Any("id", entry["id"]))
+			continue
+		}
+
+		t := reflect.TypeOf(detected).Elem()
+		name, _, err := storeFor(db, t)
+		if err != nil {
+			legacy.Close()
+			return err
+		}
+
+		entry[TypeKey] = itemTypes[t]
+		_, err = db.Put(context.Background(), name, entry)
+		if errors.Is(err, database.ErrConflict) {
+			// Usernames did not used to be unique, so the later of two accounts that share one is renamed
+			username := fmt.Sprintf("%v-%.8s", entry["username"], entry["id"])
+			db.Logger.Warn("Renaming legacy account with a taken username", zap.Any("id", entry["id"]), zap.String("username", username))
+			entry["username"] = username
+			_, err = db.Put(context.Background(), name, entry)
+		}
+		if err != nil {
+			legacy.Close()
+			return err
+		}
+	}
+
+	if err := legacy.Drop(); err != nil {
+		return err
+	}
+	return os.WriteFile(migrated, nil, 0644)
+}
+
+/**
+ * Tag every document in the per-type stores that does not yet record its type.
+ */
+func tagUntypedItems(db *database.Database) error {
+	for t := range itemTypes {
+		name, store, err := storeFor(db, t)
+		if err != nil {
+			return err
+		}
+
+		untyped, err := store.Query(context.Background(), func(doc interface{}) (bool, error) {
+			entry, ok := doc.(map[string]interface{})
+			return ok && entry[TypeKey] == nil, nil
+		})
+		if err != nil {
+			return err
+		}
+		if len(untyped) == 0 {
+			continue
+		}
+
+		db.Logger.Info("Tagging untyped documents ...", zap.String("type", itemTypes[t]), zap.Int("documents", len(untyped)))
+		for _, doc := range untyped {
+			entry := doc.(map[string]interface{})
+			entry[TypeKey] = itemTypes[t]
+		}
+		_, err = db.PutAll(context.Background(), name, untyped)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/**
+ * Make the first member the owner of every group that does not have one, since groups created before roles
+ * existed have no owner to manage them.
+ */
+func assignGroupOwners(db *database.Database) error {
+	name, store, err := storeFor(db, reflect.TypeOf(Group{}))
+	if err != nil {
+		return err
+	}
+
+	ownerless, err := store.Query(context.Background(), func(doc interface{}) (bool, error) {
+		var group Group
+		entry, ok := doc.(map[string]interface{})
+		if !ok || MapToStruct(entry, &group) != nil || len(group.Members) == 0 {
+			return false, nil
+		}
+		return !slices.ContainsFunc(group.Members, func(member types.UUID) bool {
+			return roleOf(group, member) == Owner
+		}), nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(ownerless) == 0 {
+		return nil
+	}
+
+	db.Logger.Info("Assigning owners to groups ...", zap.Int("documents", len(ownerless)))
+	for _, doc := range ownerless {
+		entry := doc.(map[string]interface{})
+		roles, ok := entry["roles"].(map[string]interface{})
+		if !ok {
+			roles = make(map[string]interface{})
+		}
+		roles[entry["members"].([]interface{})[0].(string)] = string(Owner)
+		entry["roles"] = roles
+	}
+	_, err = db.PutAll(context.Background(), name, ownerless)
+	return err
+}
+
+/**
+ * Give every account written before accounts had device keys a device for the key it was created with.
+ */
+func addDefaultDevices(db *database.Database) error {
+	name, store, err := storeFor(db, reflect.TypeOf(Account{}))
+	if err != nil {
+		return err
+	}
+
+	deviceless, err := store.Query(context.Background(), func(doc interface{}) (bool, error) {
+		entry, ok := doc.(map[string]interface{})
+		return ok && entry["devices"] == nil, nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(deviceless) == 0 {
+		return nil
+	}
+
+	db.Logger.Info("Adding device keys to accounts ...", zap.Int("documents", len(deviceless)))
+	for _, doc := range deviceless {
+		var account Account
+		entry := doc.(map[string]interface{})
+		if err := MapToStruct(entry, &account); err != nil {
+			return err
+		}
+		entry["devices"] = accountDevices(account)
+	}
+	_, err = db.PutAll(context.Background(), name, deviceless)
+	return err
+}
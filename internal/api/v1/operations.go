@@ -1,6 +1,9 @@
 package v1
 
 import (
+	"Sector/internal/database"
+	"Sector/internal/metrics"
+	"Sector/internal/tracing"
 	"context"
 	"encoding/json"
 	"errors"
@@ -14,19 +17,66 @@ import (
 	"github.com/google/uuid"
 	"github.com/lithammer/fuzzysearch/fuzzy"
 	"github.com/oapi-codegen/runtime/types"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 var ErrNotFound = errors.New("item for id not found")
 var ErrTooMany = errors.New("too many items for id found")
+var ErrExists = errors.New("item for id already exists")
+var ErrDeleting = errors.New("item is being deleted")
+
+// The key that every stored document records its item type under.
+const TypeKey = "type"
+
+// The value of the type key for each type of item.
+var itemTypes = map[reflect.Type]string{
+	reflect.TypeOf(Account{}): "account",
+	reflect.TypeOf(Group{}):   "group",
+	reflect.TypeOf(Channel{}): "channel",
+	reflect.TypeOf(Message{}): "message",
+}
+
+// The name of the store that holds each type of item.
+var itemStores = map[reflect.Type]string{
+	reflect.TypeOf(Account{}): database.AccountStore,
+	reflect.TypeOf(Group{}):   database.GroupStore,
+	reflect.TypeOf(Channel{}): database.ChannelStore,
+	reflect.TypeOf(Message{}): database.MessageStore,
+}
+
+/**
+ * Get the name of, and the document store that holds items of the given type
+ */
+func storeFor(db *database.Database, t reflect.Type) (string, orbitdb.DocumentStore, error) {
+	name, ok := itemStores[t]
+	if !ok {
+		return "", nil, fmt.Errorf("no store for item type '%v'", t)
+	}
+	store := db.Store(name)
+	if store == nil {
+		return "", nil, fmt.Errorf("store '%s' is not open", name)
+	}
+	return name, store, nil
+}
 
 /**
  * Add a new item into the database
  */
-func addItem(store orbitdb.DocumentStore, obj interface{}) (interface{}, error) {
+func addItem(ctx context.Context, db *database.Database, obj interface{}) (interface{}, error) {
+	name, _, err := storeFor(db, reflect.TypeOf(obj))
+	if err != nil {
+		return nil, fmt.Errorf("cannot add unknown item '%v' type to database", obj)
+	}
+	ctx, span := tracing.Start(ctx, "addItem", attribute.String("store", name))
+	defer span.End()
+
 	// Check if item with this ID already exists in the database...
-	_, err := getItem(store, uuid.Must(uuid.Parse(StructToMap(obj)["id"].(string))))
-	if err == nil || err != ErrNotFound {
-		return nil, fmt.Errorf("cannot add item to database")
+	_, err = getItem(ctx, db, reflect.TypeOf(obj), uuid.Must(uuid.Parse(StructToMap(obj)["id"].(string))))
+	if err == nil || errors.Is(err, ErrTooMany) {
+		return nil, ErrExists
+	}
+	if err != ErrNotFound {
+		return nil, fmt.Errorf("cannot add item to database: %w", err)
 	}
 
 	/*
@@ -43,41 +93,48 @@ func addItem(store orbitdb.DocumentStore, obj interface{}) (interface{}, error)
 	case Group:
 		// Check dependencies when adding a group object
 	case Channel:
-		group, err := searchItem(store, reflect.TypeOf(Group{}), map[string]interface{}{
+		group, err := searchItem(ctx, db, reflect.TypeOf(Group{}), map[string]interface{}{
 			"id": []string{item.Group.String()}, // We search within groups by ID, from the item's group
 		})
 		if err != nil {
-			return nil, fmt.Errorf("%s", "cannot find group associated with channel"+err.Error())
+			return nil, fmt.Errorf("cannot find group associated with channel: %w", err)
 		}
 		if len(group) != 1 {
-			return nil, fmt.Errorf("%s", "cannot find group associated with channel"+err.Error())
+			return nil, fmt.Errorf("cannot find group associated with channel: %w", ErrNotFound)
+		}
+		if beingDeleted(db, item.Group.String()) {
+			return nil, ErrDeleting
 		}
 	case Message:
-		channel, err := searchItem(store, reflect.TypeOf(Channel{}), map[string]interface{}{
+		channel, err := searchItem(ctx, db, reflect.TypeOf(Channel{}), map[string]interface{}{
 			"id": []string{item.Channel.String()}, // We search within channels by ID, from the item's channel
 		})
 		if err != nil {
-			return nil, fmt.Errorf("%s", "cannot find channel associated with message"+err.Error())
+			return nil, fmt.Errorf("cannot find channel associated with message: %w", err)
 		}
 		if len(channel) != 1 {
-			return nil, fmt.Errorf("%s", "cannot find channel associated with message"+err.Error())
+			return nil, fmt.Errorf("cannot find channel associated with message: %w", ErrNotFound)
+		}
+		group, _ := groupOfChannel(db, item.Channel.String())
+		if beingDeleted(db, item.Channel.String(), group) {
+			return nil, ErrDeleting
 		}
 
-		author, err := searchItem(store, reflect.TypeOf(Account{}), map[string]interface{}{
+		author, err := searchItem(ctx, db, reflect.TypeOf(Account{}), map[string]interface{}{
 			"id": []string{item.Author.String()},
 		})
 		if err != nil {
-			return nil, fmt.Errorf("%s", "cannot find author associated with message"+err.Error())
+			return nil, fmt.Errorf("cannot find author associated with message: %w", err)
 		}
 		if len(author) != 1 {
-			return nil, fmt.Errorf("%s", "cannot find author associated with message"+err.Error())
+			return nil, fmt.Errorf("cannot find author associated with message: %w", ErrNotFound)
 		}
 	default:
 		return nil, fmt.Errorf("cannot add unknown item '%v' type to database", item)
 	}
 
-	// Adds the item to the database
-	op, err := store.Put(context.Background(), StructToMap(obj))
+	// Adds the item to the database, even if the request is cancelled now, so that it is not left half written
+	op, err := db.Put(context.WithoutCancel(ctx), name, ToDocument(obj))
 	if err != nil {
 		return nil, err
 	}
@@ -93,10 +150,17 @@ func addItem(store orbitdb.DocumentStore, obj interface{}) (interface{}, error)
 }
 
 /**
- * Get an item from the database
+ * Get an item of the given type from the database
  */
-func getItem(store orbitdb.DocumentStore, id types.UUID) (interface{}, error) {
-	matches, err := store.Get(context.Background(), id.String(), &iface.DocumentStoreGetOptions{})
+func getItem(ctx context.Context, db *database.Database, t reflect.Type, id types.UUID) (interface{}, error) {
+	name, store, err := storeFor(db, t)
+	if err != nil {
+		return nil, err
+	}
+	ctx, span := tracing.Start(ctx, "getItem", attribute.String("store", name), attribute.String("id", id.String()))
+	defer span.End()
+
+	matches, err := store.Get(ctx, id.String(), &iface.DocumentStoreGetOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -106,15 +170,25 @@ func getItem(store orbitdb.DocumentStore, id types.UUID) (interface{}, error) {
 	if len(matches) > 1 {
 		return nil, ErrTooMany
 	}
+	if !isType(matches[0], t) {
+		return nil, ErrNotFound
+	}
 	return matches[0], nil
 }
 
 /**
- * Update an item in the database
+ * Update an item of the given type in the database
  */
-func updateItem(store orbitdb.DocumentStore, id types.UUID, obj interface{}) (interface{}, error) {
+func updateItem(ctx context.Context, db *database.Database, t reflect.Type, id types.UUID, obj interface{}) (interface{}, error) {
+	name, _, err := storeFor(db, t)
+	if err != nil {
+		return nil, err
+	}
+	ctx, span := tracing.Start(ctx, "updateItem", attribute.String("store", name), attribute.String("id", id.String()))
+	defer span.End()
+
 	// Get the item to update from the DB
-	dbItem, err := getItem(store, id)
+	dbItem, err := getItem(ctx, db, t, id)
 	if err != nil {
 		return nil, err
 	}
@@ -125,40 +199,47 @@ func updateItem(store orbitdb.DocumentStore, id types.UUID, obj interface{}) (in
 	for key, value := range updatesToApply {
 		updatedItem[key] = value
 	}
+	updatedItem[TypeKey] = itemTypes[t]
 
 	/*
 		Based on the type of item we are updating, we have to perform other actions to keep consistency of data...
 
 		=> Account - none
-		=> Group - have to check that all members exist
+		=> Group - have to check that all members exist, whenever an update sets them
 		=> Channel - none
 		=> Message - none
 	*/
 	switch item := obj.(type) {
-	case AccountUpdate:
-	case GroupUpdate:
-	case ChannelUpdate:
-	case MessageUpdate:
+	case AccountUpdate, GroupUpdate, ChannelUpdate, MessageUpdate:
+	case map[string]interface{}:
+		// Internal updates of fields the API does not let clients set directly, such as members or device keys
 	default:
-		// THE BELOW IS BAD, BUT FOR NOW WORKING... TODO: FIX THIS SO THAT WE HAVE SOME FORM OF 'INTERNAL UPDATE
-		members, ok := updatedItem["members"].([]interface{})
-		if ok {
-			found_members, err := searchItem(store, reflect.TypeOf(Account{}), map[string]interface{}{
-				"id": members,
-			})
-			if err != nil {
-				return nil, fmt.Errorf("%s", "cannot find author associated with message"+err.Error())
-			}
-			if len(found_members) != len(members) {
-				return nil, fmt.Errorf("%s", "cannot find author associated with message"+err.Error())
-			}
-		} else {
-			return nil, fmt.Errorf("cannot add unknown item '%v' type to database", item)
+		return nil, fmt.Errorf("cannot add unknown item '%v' type to database", item)
+	}
+	if members, ok := updatesToApply["members"].([]interface{}); ok && t == reflect.TypeOf(Group{}) {
+		// A member listed more than once is only kept, and looked up, once
+		seen := make(map[string]bool, len(members))
+		members = slices.DeleteFunc(members, func(member interface{}) bool {
+			key := fmt.Sprint(member)
+			duplicate := seen[key]
+			seen[key] = true
+			return duplicate
+		})
+		updatedItem["members"] = members
+
+		found_members, err := searchItem(ctx, db, reflect.TypeOf(Account{}), map[string]interface{}{
+			"id": members,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%s", "cannot find members of group: "+err.Error())
+		}
+		if len(found_members) != len(members) {
+			return nil, fmt.Errorf("%s", "cannot find members of group")
 		}
 	}
 
-	// Updates the item to the database
-	op, err := store.Put(context.Background(), updatedItem)
+	// Updates the item to the database, even if the request is cancelled now
+	op, err := db.Put(context.WithoutCancel(ctx), name, updatedItem)
 	if err != nil {
 		return nil, err
 	}
@@ -174,32 +255,40 @@ func updateItem(store orbitdb.DocumentStore, id types.UUID, obj interface{}) (in
 }
 
 /**
- * Remove an item in the database
+ * Remove an item of the given type in the database
  */
-func removeItem(store orbitdb.DocumentStore, id types.UUID) error {
-	// Get the item to delete from the DB
-	dbItem, err := getItem(store, id)
+func removeItem(ctx context.Context, db *database.Database, t reflect.Type, id types.UUID) error {
+	name, _, err := storeFor(db, t)
 	if err != nil {
 		return fmt.Errorf("%s", "cannot delete item from database"+err.Error())
 	}
+	ctx, span := tracing.Start(ctx, "removeItem", attribute.String("store", name), attribute.String("id", id.String()))
+	defer span.End()
 
-	entry, err := DetectAndUnmarshal(dbItem.(map[string]interface{}))
+	// Get the item to delete from the DB
+	dbItem, err := getItem(ctx, db, t, id)
 	if err != nil {
-		return fmt.Errorf("%s", "cannot determine type of item to delete from database"+err.Error())
+		return fmt.Errorf("cannot delete item from database: %w", err)
+	}
+
+	entry := reflect.New(t).Interface()
+	err = MapToStruct(dbItem.(map[string]interface{}), entry)
+	if err != nil {
+		return fmt.Errorf("%s", "cannot parse item to delete from database"+err.Error())
 	}
 
 	/*
 		Based on the type of item we are deleting, we have to perform other actions to keep consistency of data...
 
 		=> Account - have to remove the reference to the account ID from all groups the user was a member of
-		=> Group - have to delete all channels in the group, and all messages in those channels
-		=> Channel - have to delete all messages in the channel
+		=> Group - deleted by a job, along with its channels and their messages
+		=> Channel - deleted by a job, along with its messages
 		=> Message - no other actions to perform
 	*/
 	switch item := entry.(type) {
 	case *Account:
 		// When deleting an account, update groups so that there are no references to the account within the group members list
-		groups, err := searchItem(store, reflect.TypeOf(Group{}), map[string]interface{}{
+		groups, err := searchItem(ctx, db, reflect.TypeOf(Group{}), map[string]interface{}{
 			"members": []string{item.Id.String()},
 		})
 		if err != nil {
@@ -215,7 +304,7 @@ func removeItem(store orbitdb.DocumentStore, id types.UUID) error {
 			}
 
 			// Update the group members (TODO: this could probably be done in a batch update)
-			_, err = updateItem(store, group.Id, map[string]interface{}{
+			_, err = updateItem(ctx, db, reflect.TypeOf(Group{}), group.Id, map[string]interface{}{
 				"members": slices.DeleteFunc(group.Members, func(x types.UUID) bool {
 					return x.String() == item.Id.String()
 				}),
@@ -225,78 +314,9 @@ func removeItem(store orbitdb.DocumentStore, id types.UUID) error {
 			}
 		}
 
-	case *Group:
-		// Get all the channels associated with the group using a search in the DB.
-		channels, err := searchItem(store, reflect.TypeOf(Channel{}), map[string]interface{}{
-			"group": []string{item.Id.String()},
-		})
-		if err != nil {
-			return fmt.Errorf("%s", "cannot find channels associated with group"+err.Error())
-		}
-
-		// Loop over every channel, convert to expected struct type, extract Id
-		channelIds := make([]string, 0)
-		for _, c := range channels {
-			var channel Channel
-			err := MapToStruct(c.(map[string]interface{}), &channel)
-			if err != nil {
-				return fmt.Errorf("%s", "error deleting channel associated with group"+err.Error())
-			}
-
-			// Extract ids...
-			channelIds = append(channelIds, channel.Id.String())
-
-			// Delete all the items found above from the DB (TODO: find better way to drop all the items at once rather than individual deletion, if this is possible)
-			_, err = store.Delete(context.Background(), channel.Id.String())
-			if err != nil {
-				return fmt.Errorf("%s", "error deleting messages associated with channel associated with group"+err.Error())
-			}
-		}
-
-		// Get all the messages associated with the channels associated with the group using a search in the DB.
-		messages, err := searchItem(store, reflect.TypeOf(Message{}), map[string]interface{}{
-			"channel": channelIds,
-		})
-		if err != nil {
-			return fmt.Errorf("%s", "cannot find messages associated with channels of group"+err.Error())
-		}
-
-		// Delete all the items found above from the DB (TODO: find better way to drop all the items at once rather than individual deletion, if this is possible)
-		for _, m := range messages {
-			var message Message
-			err := MapToStruct(m.(map[string]interface{}), &message)
-			if err != nil {
-				return fmt.Errorf("%s", "error deleting messages associated with channel associated with group: "+err.Error())
-			}
-
-			_, err = store.Delete(context.Background(), message.Id.String())
-			if err != nil {
-				return fmt.Errorf("%s", "error deleting messages associated with channel associated with group: "+err.Error())
-			}
-		}
-
-	case *Channel:
-		// When deleting a channel, recursively delete all related messages
-		messages, err := searchItem(store, reflect.TypeOf(Message{}), map[string]interface{}{
-			"channel": []string{item.Id.String()},
-		})
-		if err != nil {
-			return fmt.Errorf("%s", "cannot find messages associated with channel: "+err.Error())
-		}
-
-		// Delete all the items found above from the DB (TODO: find better way to drop all the items at once rather than individual deletion, if this is possible)
-		for _, m := range messages {
-			var message Message
-			err := MapToStruct(m.(map[string]interface{}), &message)
-			if err != nil {
-				return fmt.Errorf("%s", "error deleting messages associated with channel: "+err.Error())
-			}
-
-			_, err = store.Delete(context.Background(), message.Id.String())
-			if err != nil {
-				return fmt.Errorf("%s", "error deleting messages associated with channel: "+err.Error())
-			}
-		}
+	case *Group, *Channel:
+		// Deleting everything in a group or channel can take a while, so it is done by a job, see Jobs
+		return fmt.Errorf("cannot delete %s directly, start a deletion job for it", itemTypes[t])
 
 	case *Message:
 		// When deleting a message, nothing special is needed
@@ -304,8 +324,8 @@ func removeItem(store orbitdb.DocumentStore, id types.UUID) error {
 		return fmt.Errorf("cannot determine type of item to delete: %v", item)
 	}
 
-	// Now delete the item itself
-	_, err = store.Delete(context.Background(), id.String())
+	// Now delete the item itself, even if the request is cancelled now
+	_, err = db.Delete(context.WithoutCancel(ctx), name, id.String())
 	if err != nil {
 		return fmt.Errorf("%s", "error deleting item: "+err.Error())
 	}
@@ -318,7 +338,22 @@ func removeItem(store orbitdb.DocumentStore, id types.UUID) error {
  * IF a field is null (on object or filter), the filter for it is skipped!
  */
 
-func searchItem(store orbitdb.DocumentStore, t reflect.Type, filter map[string]interface{}) ([]interface{}, error) {
+func searchItem(ctx context.Context, db *database.Database, t reflect.Type, filter map[string]interface{}) ([]interface{}, error) {
+	name, store, err := storeFor(db, t)
+	if err != nil {
+		return nil, err
+	}
+
+	// Record how long the search takes and how many documents it has to check
+	ctx, span := tracing.Start(ctx, "searchItem", attribute.String("store", name))
+	defer span.End()
+	start := time.Now()
+	scanned := 0
+	defer func() {
+		metrics.ObserveSearch(name, time.Since(start), scanned)
+		span.SetAttributes(attribute.Int("scanned", scanned))
+	}()
+
 	containsBehavior := func(entryValue, filterValue interface{}) bool {
 		// Use reflection to check if filterValue is a slice
 		v := reflect.ValueOf(filterValue)
@@ -335,15 +370,14 @@ func searchItem(store orbitdb.DocumentStore, t reflect.Type, filter map[string]i
 
 	containsAllBehavior := func(entryValue, filterValue interface{}) bool {
 		if entrySlice, ok := entryValue.([]interface{}); ok {
-			if filterSlice, ok := filterValue.([]interface{}); ok {
-				// Check if filterSlice is subset of entrySlice (IE all filter elements present in entry)
-				for _, subElem := range filterSlice {
-					if !slices.Contains(entrySlice, subElem) {
-						return false
-					}
+			entryValues := database.IndexValues(entrySlice)
+			// Check if filterSlice is subset of entrySlice (IE all filter elements present in entry)
+			for _, subElem := range database.IndexValues(filterValue) {
+				if !slices.Contains(entryValues, subElem) {
+					return false
 				}
-				return true
 			}
+			return true
 		}
 		return false
 	}
@@ -396,19 +430,17 @@ func searchItem(store orbitdb.DocumentStore, t reflect.Type, filter map[string]i
 	}
 
 	// Standard search behavior for non-date filters
-	result, err := store.Query(context.Background(), func(doc interface{}) (bool, error) {
-		entry, ok := doc.(map[string]interface{})
-		if !ok {
-			return false, nil
+	matches := func(doc interface{}) (bool, error) {
+		// Stop checking documents once the caller has gone
+		if err := ctx.Err(); err != nil {
+			return false, err
 		}
-
-		// Ensure expected type
-		detected, err := DetectAndUnmarshal(entry)
-		if err != nil || reflect.TypeOf(detected).Elem().Name() != t.Name() {
+		scanned++
+		entry, ok := doc.(map[string]interface{})
+		if !ok || !isType(entry, t) {
 			return false, nil
 		}
 
-
 		// Apply filters and discard 'entry' if not a match
 		for key, value := range filter {
 			entryKey := key
@@ -430,12 +462,141 @@ func searchItem(store orbitdb.DocumentStore, t reflect.Type, filter map[string]i
 		}
 
 		return true, nil
-	})
+	}
+
+	// Only check the documents the index says could match, unless no filter can be answered from the index
+	candidates, ok := indexCandidates(db.Index(name), filter)
+	span.SetAttributes(attribute.Bool("indexed", ok))
+	if !ok {
+		return store.Query(ctx, matches)
+	}
+
+	result := make([]interface{}, 0, len(candidates))
+	for _, id := range candidates {
+		docs, err := store.Get(ctx, id, &iface.DocumentStoreGetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, doc := range docs {
+			ok, err := matches(doc)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				result = append(result, doc)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+/**
+ * Use the index of a store to find the ids of the documents that could satisfy a filter. Returns false
+ * if no part of the filter can be answered from the index, meaning every document has to be checked.
+ *
+ * When the filter has a date range the ids are returned oldest first.
+ */
+func indexCandidates(idx *database.Index, filter map[string]interface{}) ([]string, bool) {
+	var candidates map[string]struct{}
+	narrow := func(ids []string) {
+		next := make(map[string]struct{}, len(ids))
+		for _, id := range ids {
+			if _, ok := candidates[id]; candidates == nil || ok {
+				next[id] = struct{}{}
+			}
+		}
+		candidates = next
+	}
+
+	var from, until *time.Time
+	for key, value := range filter {
+		if value == nil {
+			continue
+		}
+
+		switch key {
+		case "id":
+			narrow(database.IndexValues(value))
+		case "group", "author", "channel":
+			narrow(idx.Lookup(key, database.IndexValues(value)...))
+		case "members":
+			for _, member := range database.IndexValues(value) {
+				narrow(idx.Lookup(key, member))
+			}
+		case "from", "until":
+			parsed, err := time.Parse(time.RFC3339, fmt.Sprint(value))
+			if err != nil {
+				continue
+			}
+			if key == "from" {
+				from = &parsed
+			} else {
+				until = &parsed
+			}
+		}
+	}
+
+	var ordered []string
+	if from != nil || until != nil {
+		ordered = idx.Range(from, until)
+		narrow(ordered)
+	}
+	if candidates == nil {
+		return nil, false
+	}
+
+	ids := make([]string, 0, len(candidates))
+	if ordered != nil {
+		for _, id := range ordered {
+			if _, ok := candidates[id]; ok {
+				ids = append(ids, id)
+			}
+		}
+	} else {
+		for id := range candidates {
+			ids = append(ids, id)
+		}
+	}
+	return ids, true
+}
+
+/**
+ * Find the accounts with the given username, ignoring case, using the account index rather than a search. Usernames
+ * are unique, but accounts written before they were may still share one
+ */
+func findAccountsByUsername(ctx context.Context, db *database.Database, username string) ([]interface{}, error) {
+	_, store, err := storeFor(db, reflect.TypeOf(Account{}))
 	if err != nil {
 		return nil, err
 	}
 
-	return result, nil
+	accounts := make([]interface{}, 0)
+	for _, id := range db.Index(database.AccountStore).LookupFold("username", username) {
+		docs, err := store.Get(ctx, id, &iface.DocumentStoreGetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, doc := range docs {
+			if isType(doc, reflect.TypeOf(Account{})) {
+				accounts = append(accounts, doc)
+			}
+		}
+	}
+	return accounts, nil
+}
+
+/**
+ * Make sure every one of the given accounts exists, so that groups never list members that don't. The account index
+ * holds every account in the store, so there is no need to read the documents
+ */
+func requireAccounts(db *database.Database, accounts []types.UUID) error {
+	for _, account := range accounts {
+		if !db.Index(database.AccountStore).Has(account.String()) {
+			return fmt.Errorf("cannot find account %s: %w", account, ErrNotFound)
+		}
+	}
+	return nil
 }
 
 //#region Helpers
@@ -452,6 +613,25 @@ func StructToMap(obj interface{}) map[string]interface{} {
 	return result
 }
 
+// Whenever we want to write an item into the database use this, it tags the item with its type.
+func ToDocument(obj interface{}) map[string]interface{} {
+	doc := StructToMap(obj)
+	if name, ok := itemTypes[reflect.TypeOf(obj)]; ok {
+		doc[TypeKey] = name
+	}
+	return doc
+}
+
+// Check if a document from the database is tagged as an item of the given type.
+func isType(doc interface{}, t reflect.Type) bool {
+	entry, ok := doc.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	name, ok := itemTypes[t]
+	return ok && entry[TypeKey] == name
+}
+
 // Whenever we want to convert something in the database to a struct use this.
 func MapToStruct(data map[string]interface{}, obj interface{}) error {
 	// Marshal map to JSON
@@ -468,7 +648,8 @@ func MapToStruct(data map[string]interface{}, obj interface{}) error {
 	return nil
 }
 
-// Function to determine struct type
+// Function to guess the struct type of a document from its fields. Documents are tagged with their type
+// now, so this is only needed to classify documents written before the tag existed.
 func DetectAndUnmarshal(data map[string]interface{}) (interface{}, error) {
 	jsonData, err := json.Marshal(data)
 	if err != nil {
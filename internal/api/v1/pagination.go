@@ -0,0 +1,114 @@
+package v1
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// The number of items in a page of search results when the search does not give a limit.
+const DefaultPageLimit = 100
+
+// A page of search results as it is written to the response.
+type searchPage struct {
+	Items      []interface{} `json:"items"`
+	NextCursor *string       `json:"next_cursor,omitempty"`
+}
+
+// The position of the last item of a page. Clients only ever see it encoded as an opaque string.
+type pageCursor struct {
+	CreatedAt time.Time `json:"t"`
+	Id        string    `json:"id"`
+}
+
+/**
+ * Sort search results by creation time (ties are broken by id, so the order is stable between requests)
+ * and cut out the page of results following the cursor.
+ *
+ * Returns the page along with the cursor of the page after it, which is nil when there are no more results.
+ */
+func paginate(items []interface{}, limit *int, cursor *string, order *SortOrder) (searchPage, error) {
+	descending := order != nil && *order == CreatedAtDesc
+	before := func(a, b pageCursor) bool {
+		if !a.CreatedAt.Equal(b.CreatedAt) {
+			return a.CreatedAt.Before(b.CreatedAt) != descending
+		}
+		return (a.Id < b.Id) != descending
+	}
+
+	keys := make([]pageCursor, len(items))
+	for i, item := range items {
+		keys[i] = cursorOf(item)
+	}
+	sorted := make([]int, len(items))
+	for i := range sorted {
+		sorted[i] = i
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return before(keys[sorted[i]], keys[sorted[j]])
+	})
+
+	start := 0
+	if cursor != nil {
+		after, err := decodeCursor(*cursor)
+		if err != nil {
+			return searchPage{}, err
+		}
+		start = sort.Search(len(sorted), func(i int) bool {
+			return before(after, keys[sorted[i]])
+		})
+	}
+
+	size := DefaultPageLimit
+	if limit != nil && *limit > 0 {
+		size = *limit
+	}
+	end := min(start+size, len(sorted))
+
+	page := searchPage{Items: make([]interface{}, 0, end-start)}
+	for _, i := range sorted[start:end] {
+		page.Items = append(page.Items, items[i])
+	}
+	if end < len(sorted) {
+		next := encodeCursor(keys[sorted[end-1]])
+		page.NextCursor = &next
+	}
+	return page, nil
+}
+
+// Get the sort position of a stored document. Documents without a creation time sort first.
+func cursorOf(item interface{}) pageCursor {
+	var key pageCursor
+	doc, ok := item.(map[string]interface{})
+	if !ok {
+		return key
+	}
+	if id, ok := doc["id"].(string); ok {
+		key.Id = id
+	}
+	if createdAt, ok := doc["created_at"].(string); ok {
+		if at, err := time.Parse(time.RFC3339, createdAt); err == nil {
+			key.CreatedAt = at
+		}
+	}
+	return key
+}
+
+func encodeCursor(key pageCursor) string {
+	data, _ := json.Marshal(key)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(cursor string) (pageCursor, error) {
+	var key pageCursor
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return key, fmt.Errorf("%s", "cannot decode cursor: "+err.Error())
+	}
+	if err := json.Unmarshal(data, &key); err != nil {
+		return key, fmt.Errorf("%s", "cannot decode cursor: "+err.Error())
+	}
+	return key, nil
+}
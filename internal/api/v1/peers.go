@@ -0,0 +1,161 @@
+package v1
+
+import (
+	"Sector/internal/database"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"go.uber.org/zap"
+)
+
+// GetNode implements ServerInterface.
+func (s *SectorAPI) GetNode(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.adminOf(w, r); !ok {
+		return
+	}
+
+	node := s.DB.Self()
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(NodeInfo{
+		Id:              node.ID,
+		ListenAddresses: node.ListenAddresses,
+		Addresses:       node.Addresses,
+	})
+}
+
+// ListPeers implements ServerInterface.
+func (s *SectorAPI) ListPeers(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.adminOf(w, r); !ok {
+		return
+	}
+
+	peers, err := s.DB.Peers(r.Context())
+	if err != nil {
+		s.fail(w, r, err, "Could not list peers.")
+		return
+	}
+
+	result := make([]Peer, 0, len(peers))
+	for _, peer := range peers {
+		entry := Peer{
+			Id:        peer.ID,
+			Address:   peer.Address,
+			Direction: PeerDirection(peer.Direction),
+			Protocols: peer.Protocols,
+			Preferred: peer.Preferred,
+		}
+		if peer.Latency > 0 {
+			latency := float64(peer.Latency.Microseconds()) / 1000
+			entry.LatencyMs = &latency
+		}
+		result = append(result, entry)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(result)
+}
+
+// ConnectPeer implements ServerInterface.
+func (s *SectorAPI) ConnectPeer(w http.ResponseWriter, r *http.Request) {
+	caller, ok := s.adminOf(w, r)
+	if !ok {
+		return
+	}
+
+	var body PeerAddress
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		s.log(r).Debug("Could not parse request body", zap.Error(err))
+		writeProblem(w, http.StatusBadRequest, BadRequest, "Could not parse request body.")
+		return
+	}
+
+	err := s.DB.ConnectPeer(r.Context(), body.Address)
+	if errors.Is(err, database.ErrInvalidAddress) {
+		writeProblem(w, http.StatusBadRequest, BadRequest, "The address must be a multiaddr ending in the id of the peer.")
+		return
+	}
+	if err != nil {
+		s.log(r).Warn("Could not connect to peer", zap.String("address", body.Address), zap.Error(err))
+		writeProblem(w, http.StatusBadGateway, PeerUnreachable, "Could not connect to the peer.")
+		return
+	}
+
+	s.log(r).Info("Connected to peer", zap.String("admin", caller.String()), zap.String("address", body.Address))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DisconnectPeer implements ServerInterface.
+func (s *SectorAPI) DisconnectPeer(w http.ResponseWriter, r *http.Request) {
+	caller, ok := s.adminOf(w, r)
+	if !ok {
+		return
+	}
+
+	var body PeerAddress
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		s.log(r).Debug("Could not parse request body", zap.Error(err))
+		writeProblem(w, http.StatusBadRequest, BadRequest, "Could not parse request body.")
+		return
+	}
+
+	err := s.DB.DisconnectPeer(r.Context(), body.Address)
+	switch {
+	case errors.Is(err, database.ErrInvalidAddress):
+		writeProblem(w, http.StatusBadRequest, BadRequest, "The address must be a multiaddr ending in the id of the peer.")
+		return
+	case errors.Is(err, database.ErrNotConnected):
+		writeProblem(w, http.StatusNotFound, NotFound, "Not connected to the peer.")
+		return
+	case err != nil:
+		s.fail(w, r, err, "Could not disconnect from the peer.")
+		return
+	}
+
+	s.log(r).Info("Disconnected from peer", zap.String("admin", caller.String()), zap.String("address", body.Address))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetPreferredPeers implements ServerInterface.
+func (s *SectorAPI) GetPreferredPeers(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.adminOf(w, r); !ok {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(PreferredPeers{Addresses: s.DB.Preferred.Addresses()})
+}
+
+// SetPreferredPeers implements ServerInterface.
+func (s *SectorAPI) SetPreferredPeers(w http.ResponseWriter, r *http.Request) {
+	caller, ok := s.adminOf(w, r)
+	if !ok {
+		return
+	}
+
+	var body PreferredPeers
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		s.log(r).Debug("Could not parse request body", zap.Error(err))
+		writeProblem(w, http.StatusBadRequest, BadRequest, "Could not parse request body.")
+		return
+	}
+
+	err := s.DB.Preferred.Set(body.Addresses)
+	if errors.Is(err, database.ErrInvalidAddress) {
+		writeProblem(w, http.StatusBadRequest, BadRequest, "Every address must be a multiaddr ending in the id of the peer.")
+		return
+	}
+	if err != nil {
+		s.fail(w, r, err, "Could not save the preferred peers.")
+		return
+	}
+	s.log(r).Info("Set preferred peers", zap.String("admin", caller.String()), zap.Strings("addresses", body.Addresses))
+	s.DB.ConnectToPreferredPeers()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(PreferredPeers{Addresses: s.DB.Preferred.Addresses()})
+}
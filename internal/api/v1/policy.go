@@ -0,0 +1,189 @@
+package v1
+
+import (
+	"Sector/internal/auth"
+	"Sector/internal/database"
+	"Sector/internal/middleware"
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+var ErrForbidden = errors.New("not allowed to access item")
+
+/**
+ * The authorization rules of the API:
+ *
+ * => Accounts - anyone can read them, only the owner can update or delete one
+ * => Groups - anyone can read them, who can rename or delete one, or change who is a member, depends on their role
+ * => Channels - only members of the group can read them, who can create, update or delete one depends on their role
+ * => Messages - only members of the group can read or post them, only the author can edit or delete one, and who
+ *    can pin one depends on their role
+ * => Admin - only the accounts listed in ADMIN_ACCOUNTS can use the admin endpoints
+ *
+ * What each role in a group can do is given by the permission matrix, see groupPermissions.
+ */
+
+// Get the id of the account the request was authenticated as.
+func callerOf(r *http.Request) (types.UUID, error) {
+	claims, ok := r.Context().Value(middleware.ContextKeyUser).(*auth.Claims)
+	if !ok {
+		return types.UUID{}, ErrForbidden
+	}
+	id, err := uuid.Parse(claims.UserID)
+	if err != nil {
+		return types.UUID{}, ErrForbidden
+	}
+	return id, nil
+}
+
+/**
+ * Write the response for a request that failed a policy check, returning true if it did fail.
+ */
+func (s *SectorAPI) denied(w http.ResponseWriter, r *http.Request, err error) bool {
+	if err == nil {
+		return false
+	}
+
+	s.fail(w, r, err, "Could not get within database.")
+	return true
+}
+
+// Get the caller of a request that has to be from one of the admins, writing the response if it is not.
+func (s *SectorAPI) adminOf(w http.ResponseWriter, r *http.Request) (types.UUID, bool) {
+	caller, err := callerOf(r)
+	if err == nil {
+		err = authorizeAdmin(s.Admins, caller)
+	}
+	return caller, !s.denied(w, r, err)
+}
+
+// Check that the caller is one of the admins of this node.
+func authorizeAdmin(admins []types.UUID, caller types.UUID) error {
+	if !slices.Contains(admins, caller) {
+		return ErrForbidden
+	}
+	return nil
+}
+
+// Check that the caller is the owner of the account.
+func authorizeAccount(caller, accountId types.UUID) error {
+	if caller != accountId {
+		return ErrForbidden
+	}
+	return nil
+}
+
+// Get a group, checking that the caller is one of its members.
+func authorizeGroup(ctx context.Context, db *database.Database, caller, groupId types.UUID) (Group, error) {
+	var group Group
+	item, err := getItem(ctx, db, reflect.TypeOf(Group{}), groupId)
+	if err != nil {
+		return group, err
+	}
+	if err := MapToStruct(item.(map[string]interface{}), &group); err != nil {
+		return group, err
+	}
+	if !slices.Contains(group.Members, caller) {
+		return group, ErrForbidden
+	}
+	return group, nil
+}
+
+// Get a channel, checking that it is in the group and that the caller is a member of the group.
+func authorizeChannel(ctx context.Context, db *database.Database, caller, groupId, channelId types.UUID) (Channel, error) {
+	var channel Channel
+	if _, err := authorizeGroup(ctx, db, caller, groupId); err != nil {
+		return channel, err
+	}
+
+	item, err := getItem(ctx, db, reflect.TypeOf(Channel{}), channelId)
+	if err != nil {
+		return channel, err
+	}
+	if err := MapToStruct(item.(map[string]interface{}), &channel); err != nil {
+		return channel, err
+	}
+	if channel.Group != groupId {
+		return channel, ErrNotFound
+	}
+	return channel, nil
+}
+
+// Get a message, checking that it is in the channel and that the caller is a member of the channel's group.
+func authorizeMessage(ctx context.Context, db *database.Database, caller, groupId, channelId, messageId types.UUID) (Message, error) {
+	var message Message
+	if _, err := authorizeChannel(ctx, db, caller, groupId, channelId); err != nil {
+		return message, err
+	}
+
+	item, err := getItem(ctx, db, reflect.TypeOf(Message{}), messageId)
+	if err != nil {
+		return message, err
+	}
+	if err := MapToStruct(item.(map[string]interface{}), &message); err != nil {
+		return message, err
+	}
+	if message.Channel != channelId {
+		return message, ErrNotFound
+	}
+	return message, nil
+}
+
+// Get a message the caller wants to change, checking that they are its author.
+func authorizeAuthor(ctx context.Context, db *database.Database, caller, groupId, channelId, messageId types.UUID) (Message, error) {
+	message, err := authorizeMessage(ctx, db, caller, groupId, channelId, messageId)
+	if err != nil {
+		return message, err
+	}
+	if message.Author != caller {
+		return message, ErrForbidden
+	}
+	return message, nil
+}
+
+// Check if the account is a member of the group, using the group index.
+func isMember(db *database.Database, account types.UUID, group string) bool {
+	fields, ok := db.Index(database.GroupStore).Values(group)
+	return ok && slices.Contains(fields["members"], account.String())
+}
+
+// Get the group a channel is in, using the channel index.
+func groupOfChannel(db *database.Database, channel string) (string, bool) {
+	fields, ok := db.Index(database.ChannelStore).Values(channel)
+	if !ok || len(fields["group"]) == 0 {
+		return "", false
+	}
+	return fields["group"][0], true
+}
+
+/**
+ * Drop the channels or messages of groups the caller is not a member of from search results.
+ */
+func visibleItems(db *database.Database, caller types.UUID, t reflect.Type, items []interface{}) []interface{} {
+	visible := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		doc, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var group string
+		switch t {
+		case reflect.TypeOf(Channel{}):
+			group, _ = doc["group"].(string)
+		case reflect.TypeOf(Message{}):
+			channel, _ := doc["channel"].(string)
+			group, _ = groupOfChannel(db, channel)
+		}
+		if isMember(db, caller, group) {
+			visible = append(visible, item)
+		}
+	}
+	return visible
+}
@@ -0,0 +1,101 @@
+package v1
+
+import (
+	"Sector/internal/database"
+	"Sector/internal/logger"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// The content type of problem details, see RFC 7807
+const problemContentType = "application/problem+json"
+
+/**
+ * The errors of the API are written as problem details, with a code for programs to act on alongside the detail for
+ * people to read:
+ *
+ * => ErrNotFound - 404 not_found
+ * => ErrTooMany, ErrExists and database.ErrConflict - 409 conflict
+ * => ErrForbidden - 403 forbidden
+ * => Requests that fail validation against the schema - 422 validation_failed
+ * => Anything else - 500 internal
+ */
+
+// Write an error response as problem details.
+func writeProblem(w http.ResponseWriter, status int, code ErrorCode, detail string) {
+	problem := Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Code:   code,
+	}
+	if detail != "" {
+		problem.Detail = &detail
+	}
+
+	w.Header().Set("Content-Type", problemContentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(problem)
+}
+
+// The logger of the request, which notes its id, see middleware.RequestLogger.
+func (s *SectorAPI) log(r *http.Request) *zap.Logger {
+	return logger.FromContext(r.Context(), s.Logger)
+}
+
+/**
+ * Write the response for a request that failed with the given error, picking the status and code that fit it. The
+ * detail describes errors that are not otherwise known, they are logged rather than shown to the client. Errors the
+ * client caused are logged at debug level, anything else at error level.
+ */
+func (s *SectorAPI) fail(w http.ResponseWriter, r *http.Request, err error, detail string) {
+	level := zapcore.DebugLevel
+	switch {
+	case errors.Is(err, ErrForbidden):
+		writeProblem(w, http.StatusForbidden, Forbidden, "Not allowed.")
+	case errors.Is(err, ErrNotFound):
+		writeProblem(w, http.StatusNotFound, NotFound, "Item not found.")
+	case errors.Is(err, ErrTooMany):
+		writeProblem(w, http.StatusConflict, Conflict, "More than one item has this id.")
+	case errors.Is(err, ErrExists):
+		writeProblem(w, http.StatusConflict, Conflict, "An item with this id already exists.")
+	case errors.Is(err, ErrDeleting):
+		writeProblem(w, http.StatusConflict, Conflict, "Being deleted, nothing can be added to it.")
+	case errors.Is(err, database.ErrConflict):
+		writeProblem(w, http.StatusConflict, Conflict, "Conflicts with an existing item.")
+	default:
+		level = zapcore.ErrorLevel
+		writeProblem(w, http.StatusInternalServerError, Internal, detail)
+	}
+	s.log(r).Log(level, detail, zap.Error(err))
+}
+
+// WriteError writes an error from outside the handlers, such as from the request validator or rate limiter, as
+// problem details. Requests that fail validation are reported as unprocessable rather than bad.
+func WriteError(w http.ResponseWriter, message string, statusCode int) {
+	switch statusCode {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		writeProblem(w, http.StatusUnprocessableEntity, ValidationFailed, message)
+	case http.StatusUnauthorized:
+		writeProblem(w, statusCode, Unauthorized, message)
+	case http.StatusForbidden:
+		writeProblem(w, statusCode, Forbidden, message)
+	case http.StatusNotFound:
+		writeProblem(w, statusCode, NotFound, message)
+	case http.StatusTooManyRequests:
+		writeProblem(w, statusCode, RateLimited, message)
+	default:
+		writeProblem(w, statusCode, Internal, message)
+	}
+}
+
+// WriteParameterError writes the response for a request whose parameters could not be parsed, for use as the
+// ErrorHandlerFunc of the generated server.
+func WriteParameterError(w http.ResponseWriter, r *http.Request, err error) {
+	writeProblem(w, http.StatusBadRequest, BadRequest, err.Error())
+}
@@ -0,0 +1,224 @@
+package v1
+
+import (
+	"Sector/internal/database"
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"slices"
+
+	"github.com/oapi-codegen/runtime/types"
+	"go.uber.org/zap"
+)
+
+// Something a member of a group may be allowed to do within it.
+type GroupPermission string
+
+const (
+	UpdateGroup    GroupPermission = "update_group"
+	DeleteGroup    GroupPermission = "delete_group"
+	ManageChannels GroupPermission = "manage_channels"
+	AddMembers     GroupPermission = "add_members"
+	RemoveMembers  GroupPermission = "remove_members"
+	PinMessages    GroupPermission = "pin_messages"
+	ManageRoles    GroupPermission = "manage_roles"
+)
+
+/**
+ * The permission matrix of groups, giving the least role that has each permission. Every role has the
+ * permissions of the roles below it:
+ *
+ * => Owner - delete the group and transfer ownership
+ * => Admin - rename the group, manage its channels and assign roles below their own
+ * => Moderator - add and remove members below their own role, pin messages
+ * => Member - read and post messages
+ */
+var groupPermissions = map[GroupPermission]GroupRole{
+	UpdateGroup:    Admin,
+	DeleteGroup:    Owner,
+	ManageChannels: Admin,
+	AddMembers:     Moderator,
+	RemoveMembers:  Moderator,
+	PinMessages:    Moderator,
+	ManageRoles:    Admin,
+}
+
+// How much authority each role has, higher outranks lower.
+var roleRanks = map[GroupRole]int{
+	Member:    1,
+	Moderator: 2,
+	Admin:     3,
+	Owner:     4,
+}
+
+// Get the role an account has in a group, or an empty role if it is not a member.
+func roleOf(group Group, account types.UUID) GroupRole {
+	if !slices.Contains(group.Members, account) {
+		return ""
+	}
+	if group.Roles != nil {
+		if role, ok := (*group.Roles)[account.String()]; ok {
+			return role
+		}
+	}
+	return Member
+}
+
+// Check if a role has a permission within its group.
+func (role GroupRole) Can(permission GroupPermission) bool {
+	return roleRanks[role] >= roleRanks[groupPermissions[permission]]
+}
+
+// Check if a role has more authority than another.
+func (role GroupRole) Outranks(other GroupRole) bool {
+	return roleRanks[role] > roleRanks[other]
+}
+
+// Get a group, checking that the caller is a member of it whose role has the permission.
+func authorizeGroupPermission(ctx context.Context, db *database.Database, caller, groupId types.UUID, permission GroupPermission) (Group, error) {
+	group, err := authorizeGroup(ctx, db, caller, groupId)
+	if err != nil {
+		return group, err
+	}
+	if !roleOf(group, caller).Can(permission) {
+		return group, ErrForbidden
+	}
+	return group, nil
+}
+
+/**
+ * Save the members and roles of a group. Roles of accounts that are no longer members are dropped, and plain
+ * members are not listed since that is the role of anyone who is not.
+ */
+func updateMembership(ctx context.Context, db *database.Database, group Group) (interface{}, error) {
+	roles := make(map[string]GroupRole)
+	if group.Roles != nil {
+		for _, member := range group.Members {
+			if role, ok := (*group.Roles)[member.String()]; ok && role != Member {
+				roles[member.String()] = role
+			}
+		}
+	}
+
+	return updateItem(ctx, db, reflect.TypeOf(Group{}), group.Id, map[string]interface{}{
+		"members": group.Members,
+		"roles":   roles,
+	})
+}
+
+// Give a member of a group a role.
+func setRole(group *Group, member types.UUID, role GroupRole) {
+	if group.Roles == nil {
+		group.Roles = &map[string]GroupRole{}
+	}
+	(*group.Roles)[member.String()] = role
+}
+
+// AssignGroupRole implements ServerInterface.
+func (s *SectorAPI) AssignGroupRole(w http.ResponseWriter, r *http.Request, groupId types.UUID, memberId types.UUID) {
+	caller, err := callerOf(r)
+	if s.denied(w, r, err) {
+		return
+	}
+	group, err := authorizeGroupPermission(r.Context(), s.DB, caller, groupId, ManageRoles)
+	if s.denied(w, r, err) {
+		return
+	}
+
+	var assignment GroupRoleAssignment
+	if err := json.NewDecoder(r.Body).Decode(&assignment); err != nil {
+		s.log(r).Debug("Could not parse request body", zap.Error(err))
+		writeProblem(w, http.StatusBadRequest, BadRequest, "Could not parse request body.")
+		return
+	}
+	if _, ok := roleRanks[assignment.Role]; !ok || assignment.Role == Owner {
+		writeProblem(w, http.StatusBadRequest, BadRequest, "Role must be admin, moderator or member, ownership can only be transferred.")
+		return
+	}
+
+	current := roleOf(group, memberId)
+	if current == "" {
+		writeProblem(w, http.StatusBadRequest, BadRequest, "Not a member of this group.")
+		return
+	}
+
+	// Roles can only be handed out, or taken away, by someone who outranks them
+	role := roleOf(group, caller)
+	if !role.Outranks(current) || !role.Outranks(assignment.Role) {
+		s.denied(w, r, ErrForbidden)
+		return
+	}
+
+	setRole(&group, memberId, assignment.Role)
+	newItem, err := updateMembership(r.Context(), s.DB, group)
+	if err != nil {
+		s.fail(w, r, err, "Could not update within database.")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(newItem)
+}
+
+// RevokeGroupRole implements ServerInterface.
+func (s *SectorAPI) RevokeGroupRole(w http.ResponseWriter, r *http.Request, groupId types.UUID, memberId types.UUID) {
+	caller, err := callerOf(r)
+	if s.denied(w, r, err) {
+		return
+	}
+	group, err := authorizeGroupPermission(r.Context(), s.DB, caller, groupId, ManageRoles)
+	if s.denied(w, r, err) {
+		return
+	}
+
+	current := roleOf(group, memberId)
+	if current == "" {
+		writeProblem(w, http.StatusBadRequest, BadRequest, "Not a member of this group.")
+		return
+	}
+	if !roleOf(group, caller).Outranks(current) {
+		s.denied(w, r, ErrForbidden)
+		return
+	}
+
+	setRole(&group, memberId, Member)
+	if _, err := updateMembership(r.Context(), s.DB, group); err != nil {
+		s.fail(w, r, err, "Could not update within database.")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// TransferGroupOwnership implements ServerInterface.
+func (s *SectorAPI) TransferGroupOwnership(w http.ResponseWriter, r *http.Request, groupId types.UUID, memberId types.UUID) {
+	caller, err := callerOf(r)
+	if s.denied(w, r, err) {
+		return
+	}
+	group, err := authorizeGroup(r.Context(), s.DB, caller, groupId)
+	if s.denied(w, r, err) {
+		return
+	}
+	if roleOf(group, caller) != Owner {
+		s.denied(w, r, ErrForbidden)
+		return
+	}
+
+	if roleOf(group, memberId) == "" {
+		writeProblem(w, http.StatusBadRequest, BadRequest, "Not a member of this group.")
+		return
+	}
+
+	// The previous owner stays on as an admin, there is only ever one owner
+	setRole(&group, caller, Admin)
+	setRole(&group, memberId, Owner)
+	newItem, err := updateMembership(r.Context(), s.DB, group)
+	if err != nil {
+		s.fail(w, r, err, "Could not update within database.")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(newItem)
+}
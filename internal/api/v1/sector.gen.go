@@ -27,23 +27,119 @@ const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for ErrorCode.
+const (
+	BadRequest       ErrorCode = "bad_request"
+	Conflict         ErrorCode = "conflict"
+	Forbidden        ErrorCode = "forbidden"
+	Internal         ErrorCode = "internal"
+	InvalidSignature ErrorCode = "invalid_signature"
+	NoChallenge      ErrorCode = "no_challenge"
+	NotFound         ErrorCode = "not_found"
+	PeerUnreachable  ErrorCode = "peer_unreachable"
+	RateLimited      ErrorCode = "rate_limited"
+	Unauthorized     ErrorCode = "unauthorized"
+	Unavailable      ErrorCode = "unavailable"
+	UnsupportedKey   ErrorCode = "unsupported_key"
+	UsernameTaken    ErrorCode = "username_taken"
+	ValidationFailed ErrorCode = "validation_failed"
+)
+
+// Defines values for EventType.
+const (
+	AccountCreated EventType = "account.created"
+	AccountDeleted EventType = "account.deleted"
+	AccountUpdated EventType = "account.updated"
+	ChannelCreated EventType = "channel.created"
+	ChannelDeleted EventType = "channel.deleted"
+	ChannelUpdated EventType = "channel.updated"
+	GroupCreated   EventType = "group.created"
+	GroupDeleted   EventType = "group.deleted"
+	GroupUpdated   EventType = "group.updated"
+	MessageCreated EventType = "message.created"
+	MessageDeleted EventType = "message.deleted"
+	MessageUpdated EventType = "message.updated"
+)
+
+// Defines values for GroupRole.
+const (
+	Admin     GroupRole = "admin"
+	Member    GroupRole = "member"
+	Moderator GroupRole = "moderator"
+	Owner     GroupRole = "owner"
+)
+
+// Defines values for IntegrityIssueRepair.
+const (
+	Quarantine        IntegrityIssueRepair = "quarantine"
+	RemoveReference   IntegrityIssueRepair = "remove_reference"
+	TransferOwnership IntegrityIssueRepair = "transfer_ownership"
+)
+
+// Defines values for IntegrityIssueKind.
+const (
+	MissingChannel IntegrityIssueKind = "missing_channel"
+	MissingGroup   IntegrityIssueKind = "missing_group"
+	MissingMember  IntegrityIssueKind = "missing_member"
+)
+
+// Defines values for JobStatus.
+const (
+	Done    JobStatus = "done"
+	Failed  JobStatus = "failed"
+	Pending JobStatus = "pending"
+	Running JobStatus = "running"
+)
+
+// Defines values for LivenessStatus.
+const (
+	Ok LivenessStatus = "ok"
+)
+
+// Defines values for PeerDirection.
+const (
+	Inbound  PeerDirection = "inbound"
+	Outbound PeerDirection = "outbound"
+	Unknown  PeerDirection = "unknown"
+)
+
+// Defines values for SortOrder.
+const (
+	CreatedAtAsc  SortOrder = "created_at_asc"
+	CreatedAtDesc SortOrder = "created_at_desc"
+)
+
 // Account User Account Details.
 type Account struct {
-	CreatedAt  *time.Time         `json:"created_at,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Devices The keys of the devices that can log in to the account.
+	Devices    *[]DeviceKey       `json:"devices,omitempty"`
 	Id         openapi_types.UUID `json:"id"`
 	ProfilePic string             `json:"profile_pic"`
-	Pubkey     string             `json:"pubkey"`
-	Username   string             `json:"username"`
+
+	// Pubkey PEM encoded PKIX public key the account was created with, either Ed25519, ECDSA P-256 or P-384, or RSA. It becomes the account's first device key.
+	Pubkey   string `json:"pubkey"`
+	Username string `json:"username"`
 }
 
 // AccountFilter An object that is posted to the backend to query for accounts based on filter criteria.
 type AccountFilter struct {
+	// Cursor The next_cursor of a previous page, to get the accounts following it.
+	Cursor *string `json:"cursor,omitempty"`
+
 	// From Get accounts created from this date.
 	From *time.Time `json:"from,omitempty"`
 
 	// Id Get accounts that have an id within this list of ids.
 	Id *[]openapi_types.UUID `json:"id,omitempty"`
 
+	// Limit The most accounts to return in one page.
+	Limit *int `json:"limit,omitempty"`
+
+	// Sort The order to return search results in, by creation time.
+	Sort *SortOrder `json:"sort,omitempty"`
+
 	// Until Get accounts created from this date.
 	Until *time.Time `json:"until,omitempty"`
 
@@ -51,6 +147,14 @@ type AccountFilter struct {
 	Username *string `json:"username,omitempty"`
 }
 
+// AccountPage A page of accounts matching a search.
+type AccountPage struct {
+	Items []Account `json:"items"`
+
+	// NextCursor Pass as the cursor of the same search to get the next page. Absent on the last page.
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
 // AccountUpdate User Account Update Details.
 type AccountUpdate struct {
 	ProfilePic *string `json:"profile_pic,omitempty"`
@@ -68,11 +172,27 @@ type Channel struct {
 
 // ChannelFilter An object that is posted to the backend to query for channels based on filter criteria.
 type ChannelFilter struct {
-	From  *time.Time            `json:"from,omitempty"`
-	Group *[]openapi_types.UUID `json:"group,omitempty"`
-	Id    *[]openapi_types.UUID `json:"id,omitempty"`
-	Name  *string               `json:"name,omitempty"`
-	Until *time.Time            `json:"until,omitempty"`
+	// Cursor The next_cursor of a previous page, to get the channels following it.
+	Cursor *string               `json:"cursor,omitempty"`
+	From   *time.Time            `json:"from,omitempty"`
+	Group  *[]openapi_types.UUID `json:"group,omitempty"`
+	Id     *[]openapi_types.UUID `json:"id,omitempty"`
+
+	// Limit The most channels to return in one page.
+	Limit *int    `json:"limit,omitempty"`
+	Name  *string `json:"name,omitempty"`
+
+	// Sort The order to return search results in, by creation time.
+	Sort  *SortOrder `json:"sort,omitempty"`
+	Until *time.Time `json:"until,omitempty"`
+}
+
+// ChannelPage A page of channels matching a search.
+type ChannelPage struct {
+	Items []Channel `json:"items"`
+
+	// NextCursor Pass as the cursor of the same search to get the next page. Absent on the last page.
+	NextCursor *string `json:"next_cursor,omitempty"`
 }
 
 // ChannelUpdate Channel Update Details.
@@ -81,6 +201,52 @@ type ChannelUpdate struct {
 	Name        *string `json:"name,omitempty"`
 }
 
+// DeviceChallengeRequest The key about to be added to an account.
+type DeviceChallengeRequest struct {
+	// Pubkey PEM encoded PKIX public key, either Ed25519, ECDSA P-256 or P-384, or RSA.
+	Pubkey string `json:"pubkey"`
+}
+
+// DeviceKey The public key of one of the devices of an account.
+type DeviceKey struct {
+	AddedAt time.Time          `json:"added_at"`
+	Id      openapi_types.UUID `json:"id"`
+
+	// LastUsedAt When the key was last used to log in.
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	Name       string     `json:"name"`
+
+	// Pubkey PEM encoded PKIX public key, either Ed25519, ECDSA P-256 or P-384, or RSA.
+	Pubkey string `json:"pubkey"`
+}
+
+// ErrorCode What went wrong, for programs to act on.
+type ErrorCode string
+
+// Event A change made to an item.
+type Event struct {
+	// Channel The channel the item is in, if any.
+	Channel *openapi_types.UUID `json:"channel,omitempty"`
+
+	// Data The item after the change. Only its id is given when it was deleted.
+	Data map[string]interface{} `json:"data"`
+
+	// Group The group the item is in, if any.
+	Group *openapi_types.UUID `json:"group,omitempty"`
+
+	// Id The position of the event in the stream, pass as Last-Event-ID to resume after it.
+	Id int64 `json:"id"`
+
+	// Item The id of the item that changed.
+	Item openapi_types.UUID `json:"item"`
+
+	// Type The kind of item that changed and how it changed.
+	Type EventType `json:"type"`
+}
+
+// EventType The kind of item that changed and how it changed.
+type EventType string
+
 // Group A group chat/server of users.
 type Group struct {
 	CreatedAt   *time.Time           `json:"created_at,omitempty"`
@@ -88,15 +254,43 @@ type Group struct {
 	Id          openapi_types.UUID   `json:"id"`
 	Members     []openapi_types.UUID `json:"members"`
 	Name        string               `json:"name"`
+
+	// Roles The roles of members, by account ID. Members that are not listed have the member role.
+	Roles *map[string]GroupRole `json:"roles,omitempty"`
 }
 
 // GroupFilter An object that is posted to the backend to query for groups based on filter criteria.
 type GroupFilter struct {
-	From    *time.Time            `json:"from,omitempty"`
-	Id      *[]openapi_types.UUID `json:"id,omitempty"`
+	// Cursor The next_cursor of a previous page, to get the groups following it.
+	Cursor *string               `json:"cursor,omitempty"`
+	From   *time.Time            `json:"from,omitempty"`
+	Id     *[]openapi_types.UUID `json:"id,omitempty"`
+
+	// Limit The most groups to return in one page.
+	Limit   *int                  `json:"limit,omitempty"`
 	Members *[]openapi_types.UUID `json:"members,omitempty"`
 	Name    *string               `json:"name,omitempty"`
-	Until   *time.Time            `json:"until,omitempty"`
+
+	// Sort The order to return search results in, by creation time.
+	Sort  *SortOrder `json:"sort,omitempty"`
+	Until *time.Time `json:"until,omitempty"`
+}
+
+// GroupPage A page of groups matching a search.
+type GroupPage struct {
+	Items []Group `json:"items"`
+
+	// NextCursor Pass as the cursor of the same search to get the next page. Absent on the last page.
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// GroupRole The role of a member within a group, which decides what they are allowed to do in it.
+type GroupRole string
+
+// GroupRoleAssignment The role to give to a member of a group.
+type GroupRoleAssignment struct {
+	// Role The role of a member within a group, which decides what they are allowed to do in it.
+	Role GroupRole `json:"role"`
 }
 
 // GroupUpdate Group Update Details.
@@ -105,6 +299,99 @@ type GroupUpdate struct {
 	Name        *string `json:"name,omitempty"`
 }
 
+// IPFSStatus The state of the IPFS node the database runs on.
+type IPFSStatus struct {
+	// Online Whether the node has networking.
+	Online bool `json:"online"`
+
+	// Repo The path of the repo, empty for a node kept in memory.
+	Repo string `json:"repo"`
+
+	// RepoError Why the repo could not be read, if it could not.
+	RepoError *string `json:"repo_error,omitempty"`
+
+	// RepoSize The bytes stored in the repo.
+	RepoSize *int64 `json:"repo_size,omitempty"`
+}
+
+// IntegrityIssue An item that refers to something that is gone.
+type IntegrityIssue struct {
+	// Item The id of the item.
+	Item openapi_types.UUID `json:"item"`
+
+	// Kind What an item refers to that is gone.
+	Kind IntegrityIssueKind `json:"kind"`
+
+	// Reference The id of what it refers to.
+	Reference openapi_types.UUID `json:"reference"`
+
+	// Repair How the item is repaired, either by removing the reference or quarantining the item. A group whose last owner is removed has its ownership transferred to the highest ranked member left, or is quarantined if no one is left.
+	Repair IntegrityIssueRepair `json:"repair"`
+
+	// Repaired Whether the item was repaired.
+	Repaired bool `json:"repaired"`
+
+	// Store The store the item is in.
+	Store string `json:"store"`
+}
+
+// IntegrityIssueRepair How the item is repaired, either by removing the reference or quarantining the item. A group whose last owner is removed has its ownership transferred to the highest ranked member left, or is quarantined if no one is left.
+type IntegrityIssueRepair string
+
+// IntegrityIssueKind What an item refers to that is gone.
+type IntegrityIssueKind string
+
+// IntegrityReport The items found to refer to something that is gone.
+type IntegrityReport struct {
+	CheckedAt time.Time        `json:"checked_at"`
+	Issues    []IntegrityIssue `json:"issues"`
+
+	// Scanned How many items were checked.
+	Scanned int `json:"scanned"`
+}
+
+// Job A deletion carried on in the background. Messages are deleted first, then channels, and the item itself last, so a job that stops part way never leaves anything behind that belongs to an item that is gone. Nothing can be added to the item while it is being deleted. If the job fails, everything it deleted is restored. Finished jobs are kept for the job retention period of the node.
+type Job struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Deleted How many of them have been deleted so far.
+	Deleted int `json:"deleted"`
+
+	// Error Why the job failed, if it did.
+	Error *string            `json:"error,omitempty"`
+	Id    openapi_types.UUID `json:"id"`
+
+	// Item The id of the item being deleted.
+	Item openapi_types.UUID `json:"item"`
+
+	// Kind What kind of item is being deleted, group or channel.
+	Kind string `json:"kind"`
+
+	// RequestedBy The account that started the job.
+	RequestedBy openapi_types.UUID `json:"requested_by"`
+
+	// Restored How many of the deleted items were put back after the job failed.
+	Restored int `json:"restored"`
+
+	// Status Where a job is up to. A job that fails puts back everything it deleted, and can be started again.
+	Status JobStatus `json:"status"`
+
+	// Total How many items there are to delete, once the job has counted them.
+	Total     int       `json:"total"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// JobStatus Where a job is up to. A job that fails puts back everything it deleted, and can be started again.
+type JobStatus string
+
+// Liveness That the node is serving requests.
+type Liveness struct {
+	Status LivenessStatus `json:"status"`
+}
+
+// LivenessStatus defines model for Liveness.Status.
+type LivenessStatus string
+
 // Message A message that is sent in a group.
 type Message struct {
 	Author    openapi_types.UUID `json:"author"`
@@ -120,10 +407,27 @@ type MessageFilter struct {
 	Author  *[]openapi_types.UUID `json:"author,omitempty"`
 	Body    *string               `json:"body,omitempty"`
 	Channel *[]openapi_types.UUID `json:"channel,omitempty"`
-	From    *time.Time            `json:"from,omitempty"`
-	Id      *[]openapi_types.UUID `json:"id,omitempty"`
-	Pinned  *bool                 `json:"pinned,omitempty"`
-	Until   *time.Time            `json:"until,omitempty"`
+
+	// Cursor The next_cursor of a previous page, to get the messages following it.
+	Cursor *string               `json:"cursor,omitempty"`
+	From   *time.Time            `json:"from,omitempty"`
+	Id     *[]openapi_types.UUID `json:"id,omitempty"`
+
+	// Limit The most messages to return in one page.
+	Limit  *int  `json:"limit,omitempty"`
+	Pinned *bool `json:"pinned,omitempty"`
+
+	// Sort The order to return search results in, by creation time.
+	Sort  *SortOrder `json:"sort,omitempty"`
+	Until *time.Time `json:"until,omitempty"`
+}
+
+// MessagePage A page of messages matching a search.
+type MessagePage struct {
+	Items []Message `json:"items"`
+
+	// NextCursor Pass as the cursor of the same search to get the next page. Absent on the last page.
+	NextCursor *string `json:"next_cursor,omitempty"`
 }
 
 // MessageUpdate Message Update Details.
@@ -132,19 +436,188 @@ type MessageUpdate struct {
 	Pinned *bool   `json:"pinned,omitempty"`
 }
 
+// NewAccount Details of an account to create, with proof that the creator holds the private half of its key.
+type NewAccount struct {
+	Id         openapi_types.UUID `json:"id"`
+	ProfilePic string             `json:"profile_pic"`
+
+	// Pubkey PEM encoded PKIX public key, either Ed25519, ECDSA P-256 or P-384, or RSA.
+	Pubkey string `json:"pubkey"`
+
+	// Signature Base64 signature of the challenge issued for the username and key, made in the same way as a login.
+	Signature string `json:"signature"`
+
+	// Username Must not be taken by another account, ignoring case.
+	Username string `json:"username"`
+}
+
+// NewDeviceKey A device key to add to an account.
+type NewDeviceKey struct {
+	// KeySignature Base64 signature of the same challenge made with the new key, proving it is held.
+	KeySignature string `json:"key_signature"`
+	Name         string `json:"name"`
+
+	// Pubkey PEM encoded PKIX public key, either Ed25519, ECDSA P-256 or P-384, or RSA.
+	Pubkey string `json:"pubkey"`
+
+	// Signature Base64 signature of the challenge issued for the key, made with one of the account's existing keys in the same way as a login challenge.
+	Signature string `json:"signature"`
+}
+
+// NodeInfo The id of this node and the addresses other peers can reach it on.
+type NodeInfo struct {
+	// Addresses The multiaddrs other peers can dial the node on, ending in its id.
+	Addresses []string `json:"addresses"`
+
+	// Id The peer id of this node.
+	Id string `json:"id"`
+
+	// ListenAddresses The multiaddrs the node listens on.
+	ListenAddresses []string `json:"listen_addresses"`
+}
+
+// Peer A peer this node is connected to.
+type Peer struct {
+	// Address The multiaddr the connection is on.
+	Address string `json:"address"`
+
+	// Direction Whether the peer dialed this node, or this node dialed the peer.
+	Direction PeerDirection `json:"direction"`
+	Id        string        `json:"id"`
+
+	// LatencyMs The last known round trip time in milliseconds, left out if it is not known yet.
+	LatencyMs *float64 `json:"latency_ms,omitempty"`
+
+	// Preferred Whether the peer is one of the preferred peers.
+	Preferred bool `json:"preferred"`
+
+	// Protocols The protocols the peer supports.
+	Protocols []string `json:"protocols"`
+}
+
+// PeerDirection Whether the peer dialed this node, or this node dialed the peer.
+type PeerDirection string
+
+// PeerAddress The multiaddr of a peer, ending in its id, such as /ip4/10.0.0.2/tcp/4001/p2p/12D3KooW...
+type PeerAddress struct {
+	Address string `json:"address"`
+}
+
+// PreferredPeers The peers this node dials whenever it starts, by multiaddr ending in their id.
+type PreferredPeers struct {
+	Addresses []string `json:"addresses"`
+}
+
+// Problem Why a request failed, as problem details (RFC 7807).
+type Problem struct {
+	// Code What went wrong, for programs to act on.
+	Code ErrorCode `json:"code"`
+
+	// Detail What went wrong with this request, for people rather than programs.
+	Detail *string `json:"detail,omitempty"`
+
+	// Status The status code of the response.
+	Status int `json:"status"`
+
+	// Title The status text of the status code.
+	Title string `json:"title"`
+
+	// Type Identifies the kind of problem, always about:blank as the code identifies it instead.
+	Type string `json:"type"`
+}
+
+// Readiness Whether the node is ready to serve requests, and the state of what it depends on.
+type Readiness struct {
+	// Ipfs The state of the IPFS node the database runs on.
+	Ipfs IPFSStatus `json:"ipfs"`
+
+	// LastReplication When entries were last replicated from a peer, left out if none have been since the node started.
+	LastReplication *time.Time `json:"last_replication,omitempty"`
+
+	// MinPeers How many peers have to be connected for the node to be ready.
+	MinPeers int `json:"min_peers"`
+
+	// Peers How many peers the IPFS node is connected to.
+	Peers int `json:"peers"`
+
+	// Problems Why the node is not ready, empty when it is.
+	Problems []string `json:"problems"`
+	Ready    bool     `json:"ready"`
+
+	// SinceLastReplication The seconds since entries were last replicated from a peer.
+	SinceLastReplication *float64      `json:"since_last_replication,omitempty"`
+	Stores               []StoreStatus `json:"stores"`
+}
+
+// RegistrationChallengeRequest The username and key of an account that is about to be created.
+type RegistrationChallengeRequest struct {
+	// Pubkey PEM encoded PKIX public key, either Ed25519, ECDSA P-256 or P-384, or RSA.
+	Pubkey   string `json:"pubkey"`
+	Username string `json:"username"`
+}
+
+// SortOrder The order to return search results in, by creation time.
+type SortOrder string
+
+// StoreStatus The state of one of the stores of the database.
+type StoreStatus struct {
+	// Address The OrbitDB address of the store.
+	Address   string `json:"address"`
+	Documents int    `json:"documents"`
+
+	// Loaded Whether its entries have been loaded and indexed.
+	Loaded bool   `json:"loaded"`
+	Name   string `json:"name"`
+
+	// Ready Whether the store has reported that it is ready.
+	Ready bool `json:"ready"`
+}
+
+// TokenPair A short-lived access token, and the refresh token to get the next one with.
+type TokenPair struct {
+	// ExpiresAt When the access token expires.
+	ExpiresAt time.Time `json:"expires_at"`
+
+	// RefreshToken The token to get new tokens with once the access token expires.
+	RefreshToken string `json:"refresh_token"`
+
+	// Token The access token to authenticate requests with.
+	Token string `json:"token"`
+}
+
 // GetChallengeParams defines parameters for GetChallenge.
 type GetChallengeParams struct {
 	Username string `form:"username" json:"username"`
 }
 
+// StreamEventsParams defines parameters for StreamEvents.
+type StreamEventsParams struct {
+	// Group Only stream changes to these groups, and the channels and messages within them.
+	Group *[]openapi_types.UUID `form:"group,omitempty" json:"group,omitempty"`
+
+	// Channel Only stream changes to these channels, and the messages within them.
+	Channel *[]openapi_types.UUID `form:"channel,omitempty" json:"channel,omitempty"`
+
+	// LastEventID The id of the last event received, to resume a stream after it.
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
 // LoginJSONBody defines parameters for Login.
 type LoginJSONBody struct {
 	Signature *string `json:"signature,omitempty"`
 	Username  *string `json:"username,omitempty"`
 }
 
+// RefreshTokenJSONBody defines parameters for RefreshToken.
+type RefreshTokenJSONBody struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
 // PutAccountJSONRequestBody defines body for PutAccount for application/json ContentType.
-type PutAccountJSONRequestBody = Account
+type PutAccountJSONRequestBody = NewAccount
+
+// GetRegistrationChallengeJSONRequestBody defines body for GetRegistrationChallenge for application/json ContentType.
+type GetRegistrationChallengeJSONRequestBody = RegistrationChallengeRequest
 
 // SearchAccountsJSONRequestBody defines body for SearchAccounts for application/json ContentType.
 type SearchAccountsJSONRequestBody = AccountFilter
@@ -152,6 +625,21 @@ type SearchAccountsJSONRequestBody = AccountFilter
 // UpdateAccountByIDJSONRequestBody defines body for UpdateAccountByID for application/json ContentType.
 type UpdateAccountByIDJSONRequestBody = AccountUpdate
 
+// AddDeviceKeyJSONRequestBody defines body for AddDeviceKey for application/json ContentType.
+type AddDeviceKeyJSONRequestBody = NewDeviceKey
+
+// GetDeviceChallengeJSONRequestBody defines body for GetDeviceChallenge for application/json ContentType.
+type GetDeviceChallengeJSONRequestBody = DeviceChallengeRequest
+
+// ConnectPeerJSONRequestBody defines body for ConnectPeer for application/json ContentType.
+type ConnectPeerJSONRequestBody = PeerAddress
+
+// DisconnectPeerJSONRequestBody defines body for DisconnectPeer for application/json ContentType.
+type DisconnectPeerJSONRequestBody = PeerAddress
+
+// SetPreferredPeersJSONRequestBody defines body for SetPreferredPeers for application/json ContentType.
+type SetPreferredPeersJSONRequestBody = PreferredPeers
+
 // SearchChannelsJSONRequestBody defines body for SearchChannels for application/json ContentType.
 type SearchChannelsJSONRequestBody = ChannelFilter
 
@@ -176,12 +664,18 @@ type PutMessageJSONRequestBody = Message
 // UpdateMessageByIDJSONRequestBody defines body for UpdateMessageByID for application/json ContentType.
 type UpdateMessageByIDJSONRequestBody = MessageUpdate
 
+// AssignGroupRoleJSONRequestBody defines body for AssignGroupRole for application/json ContentType.
+type AssignGroupRoleJSONRequestBody = GroupRoleAssignment
+
 // LoginJSONRequestBody defines body for Login for application/json ContentType.
 type LoginJSONRequestBody LoginJSONBody
 
 // SearchMessagesJSONRequestBody defines body for SearchMessages for application/json ContentType.
 type SearchMessagesJSONRequestBody = MessageFilter
 
+// RefreshTokenJSONRequestBody defines body for RefreshToken for application/json ContentType.
+type RefreshTokenJSONRequestBody RefreshTokenJSONBody
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -263,6 +757,11 @@ type ClientInterface interface {
 
 	PutAccount(ctx context.Context, body PutAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetRegistrationChallengeWithBody request with any body
+	GetRegistrationChallengeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	GetRegistrationChallenge(ctx context.Context, body GetRegistrationChallengeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// SearchAccountsWithBody request with any body
 	SearchAccountsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -279,6 +778,52 @@ type ClientInterface interface {
 
 	UpdateAccountByID(ctx context.Context, id openapi_types.UUID, body UpdateAccountByIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListDeviceKeys request
+	ListDeviceKeys(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddDeviceKeyWithBody request with any body
+	AddDeviceKeyWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddDeviceKey(ctx context.Context, id openapi_types.UUID, body AddDeviceKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDeviceChallengeWithBody request with any body
+	GetDeviceChallengeWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	GetDeviceChallenge(ctx context.Context, id openapi_types.UUID, body GetDeviceChallengeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevokeDeviceKey request
+	RevokeDeviceKey(ctx context.Context, id openapi_types.UUID, keyId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CheckIntegrity request
+	CheckIntegrity(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RepairIntegrity request
+	RepairIntegrity(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetNode request
+	GetNode(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListPeers request
+	ListPeers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConnectPeerWithBody request with any body
+	ConnectPeerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ConnectPeer(ctx context.Context, body ConnectPeerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DisconnectPeerWithBody request with any body
+	DisconnectPeerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DisconnectPeer(ctx context.Context, body DisconnectPeerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPreferredPeers request
+	GetPreferredPeers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetPreferredPeersWithBody request with any body
+	SetPreferredPeersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetPreferredPeers(ctx context.Context, body SetPreferredPeersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetChallenge request
 	GetChallenge(ctx context.Context, params *GetChallengeParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -287,6 +832,9 @@ type ClientInterface interface {
 
 	SearchChannels(ctx context.Context, body SearchChannelsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// StreamEvents request
+	StreamEvents(ctx context.Context, params *StreamEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PutGroupWithBody request with any body
 	PutGroupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -346,18 +894,46 @@ type ClientInterface interface {
 	// AddGroupMember request
 	AddGroupMember(ctx context.Context, groupId openapi_types.UUID, memberId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// RevokeGroupRole request
+	RevokeGroupRole(ctx context.Context, groupId openapi_types.UUID, memberId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AssignGroupRoleWithBody request with any body
+	AssignGroupRoleWithBody(ctx context.Context, groupId openapi_types.UUID, memberId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AssignGroupRole(ctx context.Context, groupId openapi_types.UUID, memberId openapi_types.UUID, body AssignGroupRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TransferGroupOwnership request
+	TransferGroupOwnership(ctx context.Context, groupId openapi_types.UUID, memberId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetHealth request
 	GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetLiveness request
+	GetLiveness(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetReadiness request
+	GetReadiness(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetJobByID request
+	GetJobByID(ctx context.Context, jobId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// LoginWithBody request with any body
 	LoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	Login(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// Logout request
+	Logout(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// SearchMessagesWithBody request with any body
 	SearchMessagesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	SearchMessages(ctx context.Context, body SearchMessagesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RefreshTokenWithBody request with any body
+	RefreshTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RefreshToken(ctx context.Context, body RefreshTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) GetRoot(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -396,6 +972,30 @@ func (c *Client) PutAccount(ctx context.Context, body PutAccountJSONRequestBody,
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetRegistrationChallengeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRegistrationChallengeRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRegistrationChallenge(ctx context.Context, body GetRegistrationChallengeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRegistrationChallengeRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) SearchAccountsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewSearchAccountsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -468,8 +1068,8 @@ func (c *Client) UpdateAccountByID(ctx context.Context, id openapi_types.UUID, b
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetChallenge(ctx context.Context, params *GetChallengeParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetChallengeRequest(c.Server, params)
+func (c *Client) ListDeviceKeys(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDeviceKeysRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -480,8 +1080,8 @@ func (c *Client) GetChallenge(ctx context.Context, params *GetChallengeParams, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) SearchChannelsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSearchChannelsRequestWithBody(c.Server, contentType, body)
+func (c *Client) AddDeviceKeyWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddDeviceKeyRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -492,8 +1092,8 @@ func (c *Client) SearchChannelsWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) SearchChannels(ctx context.Context, body SearchChannelsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSearchChannelsRequest(c.Server, body)
+func (c *Client) AddDeviceKey(ctx context.Context, id openapi_types.UUID, body AddDeviceKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddDeviceKeyRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -504,8 +1104,8 @@ func (c *Client) SearchChannels(ctx context.Context, body SearchChannelsJSONRequ
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutGroupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutGroupRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetDeviceChallengeWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDeviceChallengeRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -516,8 +1116,8 @@ func (c *Client) PutGroupWithBody(ctx context.Context, contentType string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutGroup(ctx context.Context, body PutGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutGroupRequest(c.Server, body)
+func (c *Client) GetDeviceChallenge(ctx context.Context, id openapi_types.UUID, body GetDeviceChallengeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDeviceChallengeRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -528,8 +1128,8 @@ func (c *Client) PutGroup(ctx context.Context, body PutGroupJSONRequestBody, req
 	return c.Client.Do(req)
 }
 
-func (c *Client) SearchGroupsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSearchGroupsRequestWithBody(c.Server, contentType, body)
+func (c *Client) RevokeDeviceKey(ctx context.Context, id openapi_types.UUID, keyId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeDeviceKeyRequest(c.Server, id, keyId)
 	if err != nil {
 		return nil, err
 	}
@@ -540,8 +1140,8 @@ func (c *Client) SearchGroupsWithBody(ctx context.Context, contentType string, b
 	return c.Client.Do(req)
 }
 
-func (c *Client) SearchGroups(ctx context.Context, body SearchGroupsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSearchGroupsRequest(c.Server, body)
+func (c *Client) CheckIntegrity(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCheckIntegrityRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -552,8 +1152,8 @@ func (c *Client) SearchGroups(ctx context.Context, body SearchGroupsJSONRequestB
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteGroupByID(ctx context.Context, groupId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteGroupByIDRequest(c.Server, groupId)
+func (c *Client) RepairIntegrity(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRepairIntegrityRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -564,8 +1164,8 @@ func (c *Client) DeleteGroupByID(ctx context.Context, groupId openapi_types.UUID
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetGroupByID(ctx context.Context, groupId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetGroupByIDRequest(c.Server, groupId)
+func (c *Client) GetNode(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetNodeRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -576,8 +1176,8 @@ func (c *Client) GetGroupByID(ctx context.Context, groupId openapi_types.UUID, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateGroupByIDWithBody(ctx context.Context, groupId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateGroupByIDRequestWithBody(c.Server, groupId, contentType, body)
+func (c *Client) ListPeers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPeersRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -588,8 +1188,8 @@ func (c *Client) UpdateGroupByIDWithBody(ctx context.Context, groupId openapi_ty
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateGroupByID(ctx context.Context, groupId openapi_types.UUID, body UpdateGroupByIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateGroupByIDRequest(c.Server, groupId, body)
+func (c *Client) ConnectPeerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConnectPeerRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -600,8 +1200,8 @@ func (c *Client) UpdateGroupByID(ctx context.Context, groupId openapi_types.UUID
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutChannelWithBody(ctx context.Context, groupId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutChannelRequestWithBody(c.Server, groupId, contentType, body)
+func (c *Client) ConnectPeer(ctx context.Context, body ConnectPeerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConnectPeerRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -612,8 +1212,8 @@ func (c *Client) PutChannelWithBody(ctx context.Context, groupId openapi_types.U
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutChannel(ctx context.Context, groupId openapi_types.UUID, body PutChannelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutChannelRequest(c.Server, groupId, body)
+func (c *Client) DisconnectPeerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDisconnectPeerRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -624,8 +1224,8 @@ func (c *Client) PutChannel(ctx context.Context, groupId openapi_types.UUID, bod
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteChannelByID(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteChannelByIDRequest(c.Server, groupId, channelId)
+func (c *Client) DisconnectPeer(ctx context.Context, body DisconnectPeerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDisconnectPeerRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -636,8 +1236,8 @@ func (c *Client) DeleteChannelByID(ctx context.Context, groupId openapi_types.UU
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetChannelByID(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetChannelByIDRequest(c.Server, groupId, channelId)
+func (c *Client) GetPreferredPeers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPreferredPeersRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -648,8 +1248,8 @@ func (c *Client) GetChannelByID(ctx context.Context, groupId openapi_types.UUID,
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateChannelByIDWithBody(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateChannelByIDRequestWithBody(c.Server, groupId, channelId, contentType, body)
+func (c *Client) SetPreferredPeersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetPreferredPeersRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -660,8 +1260,8 @@ func (c *Client) UpdateChannelByIDWithBody(ctx context.Context, groupId openapi_
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateChannelByID(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, body UpdateChannelByIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateChannelByIDRequest(c.Server, groupId, channelId, body)
+func (c *Client) SetPreferredPeers(ctx context.Context, body SetPreferredPeersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetPreferredPeersRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -672,8 +1272,8 @@ func (c *Client) UpdateChannelByID(ctx context.Context, groupId openapi_types.UU
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutMessageWithBody(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutMessageRequestWithBody(c.Server, groupId, channelId, contentType, body)
+func (c *Client) GetChallenge(ctx context.Context, params *GetChallengeParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetChallengeRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -684,8 +1284,8 @@ func (c *Client) PutMessageWithBody(ctx context.Context, groupId openapi_types.U
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutMessage(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, body PutMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutMessageRequest(c.Server, groupId, channelId, body)
+func (c *Client) SearchChannelsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchChannelsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -696,8 +1296,8 @@ func (c *Client) PutMessage(ctx context.Context, groupId openapi_types.UUID, cha
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteMessageByID(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteMessageByIDRequest(c.Server, groupId, channelId, messageId)
+func (c *Client) SearchChannels(ctx context.Context, body SearchChannelsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchChannelsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -708,8 +1308,8 @@ func (c *Client) DeleteMessageByID(ctx context.Context, groupId openapi_types.UU
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetMessageByID(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetMessageByIDRequest(c.Server, groupId, channelId, messageId)
+func (c *Client) StreamEvents(ctx context.Context, params *StreamEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStreamEventsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -720,8 +1320,8 @@ func (c *Client) GetMessageByID(ctx context.Context, groupId openapi_types.UUID,
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateMessageByIDWithBody(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateMessageByIDRequestWithBody(c.Server, groupId, channelId, messageId, contentType, body)
+func (c *Client) PutGroupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutGroupRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -732,8 +1332,8 @@ func (c *Client) UpdateMessageByIDWithBody(ctx context.Context, groupId openapi_
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateMessageByID(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID, body UpdateMessageByIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateMessageByIDRequest(c.Server, groupId, channelId, messageId, body)
+func (c *Client) PutGroup(ctx context.Context, body PutGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutGroupRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -744,8 +1344,8 @@ func (c *Client) UpdateMessageByID(ctx context.Context, groupId openapi_types.UU
 	return c.Client.Do(req)
 }
 
-func (c *Client) RemoveGroupMember(ctx context.Context, groupId openapi_types.UUID, memberId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRemoveGroupMemberRequest(c.Server, groupId, memberId)
+func (c *Client) SearchGroupsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchGroupsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -756,8 +1356,8 @@ func (c *Client) RemoveGroupMember(ctx context.Context, groupId openapi_types.UU
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddGroupMember(ctx context.Context, groupId openapi_types.UUID, memberId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddGroupMemberRequest(c.Server, groupId, memberId)
+func (c *Client) SearchGroups(ctx context.Context, body SearchGroupsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchGroupsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -768,8 +1368,8 @@ func (c *Client) AddGroupMember(ctx context.Context, groupId openapi_types.UUID,
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetHealthRequest(c.Server)
+func (c *Client) DeleteGroupByID(ctx context.Context, groupId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteGroupByIDRequest(c.Server, groupId)
 	if err != nil {
 		return nil, err
 	}
@@ -780,8 +1380,8 @@ func (c *Client) GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (
 	return c.Client.Do(req)
 }
 
-func (c *Client) LoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewLoginRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetGroupByID(ctx context.Context, groupId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetGroupByIDRequest(c.Server, groupId)
 	if err != nil {
 		return nil, err
 	}
@@ -792,8 +1392,8 @@ func (c *Client) LoginWithBody(ctx context.Context, contentType string, body io.
 	return c.Client.Do(req)
 }
 
-func (c *Client) Login(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewLoginRequest(c.Server, body)
+func (c *Client) UpdateGroupByIDWithBody(ctx context.Context, groupId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateGroupByIDRequestWithBody(c.Server, groupId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -804,8 +1404,8 @@ func (c *Client) Login(ctx context.Context, body LoginJSONRequestBody, reqEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) SearchMessagesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSearchMessagesRequestWithBody(c.Server, contentType, body)
+func (c *Client) UpdateGroupByID(ctx context.Context, groupId openapi_types.UUID, body UpdateGroupByIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateGroupByIDRequest(c.Server, groupId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -816,8 +1416,8 @@ func (c *Client) SearchMessagesWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) SearchMessages(ctx context.Context, body SearchMessagesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSearchMessagesRequest(c.Server, body)
+func (c *Client) PutChannelWithBody(ctx context.Context, groupId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutChannelRequestWithBody(c.Server, groupId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -828,230 +1428,344 @@ func (c *Client) SearchMessages(ctx context.Context, body SearchMessagesJSONRequ
 	return c.Client.Do(req)
 }
 
-// NewGetRootRequest generates requests for GetRoot
-func NewGetRootRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PutChannel(ctx context.Context, groupId openapi_types.UUID, body PutChannelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutChannelRequest(c.Server, groupId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteChannelByID(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteChannelByIDRequest(c.Server, groupId, channelId)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPutAccountRequest calls the generic PutAccount builder with application/json body
-func NewPutAccountRequest(server string, body PutAccountJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetChannelByID(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetChannelByIDRequest(c.Server, groupId, channelId)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPutAccountRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPutAccountRequestWithBody generates requests for PutAccount with any type of body
-func NewPutAccountRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateChannelByIDWithBody(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateChannelByIDRequestWithBody(c.Server, groupId, channelId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/account/")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateChannelByID(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, body UpdateChannelByIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateChannelByIDRequest(c.Server, groupId, channelId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewSearchAccountsRequest calls the generic SearchAccounts builder with application/json body
-func NewSearchAccountsRequest(server string, body SearchAccountsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PutMessageWithBody(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutMessageRequestWithBody(c.Server, groupId, channelId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewSearchAccountsRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewSearchAccountsRequestWithBody generates requests for SearchAccounts with any type of body
-func NewSearchAccountsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PutMessage(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, body PutMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutMessageRequest(c.Server, groupId, channelId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/account/search")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteMessageByID(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteMessageByIDRequest(c.Server, groupId, channelId, messageId)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteAccountByIDRequest generates requests for DeleteAccountByID
-func NewDeleteAccountByIDRequest(server string, id openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) GetMessageByID(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMessageByIDRequest(c.Server, groupId, channelId, messageId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateMessageByIDWithBody(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMessageByIDRequestWithBody(c.Server, groupId, channelId, messageId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/account/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateMessageByID(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID, body UpdateMessageByIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMessageByIDRequest(c.Server, groupId, channelId, messageId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetAccountByIDRequest generates requests for GetAccountByID
-func NewGetAccountByIDRequest(server string, id openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) RemoveGroupMember(ctx context.Context, groupId openapi_types.UUID, memberId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveGroupMemberRequest(c.Server, groupId, memberId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) AddGroupMember(ctx context.Context, groupId openapi_types.UUID, memberId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddGroupMemberRequest(c.Server, groupId, memberId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/account/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) RevokeGroupRole(ctx context.Context, groupId openapi_types.UUID, memberId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeGroupRoleRequest(c.Server, groupId, memberId)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdateAccountByIDRequest calls the generic UpdateAccountByID builder with application/json body
-func NewUpdateAccountByIDRequest(server string, id openapi_types.UUID, body UpdateAccountByIDJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) AssignGroupRoleWithBody(ctx context.Context, groupId openapi_types.UUID, memberId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAssignGroupRoleRequestWithBody(c.Server, groupId, memberId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateAccountByIDRequestWithBody(server, id, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateAccountByIDRequestWithBody generates requests for UpdateAccountByID with any type of body
-func NewUpdateAccountByIDRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) AssignGroupRole(ctx context.Context, groupId openapi_types.UUID, memberId openapi_types.UUID, body AssignGroupRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAssignGroupRoleRequest(c.Server, groupId, memberId, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) TransferGroupOwnership(ctx context.Context, groupId openapi_types.UUID, memberId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTransferGroupOwnershipRequest(c.Server, groupId, memberId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/account/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHealthRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+func (c *Client) GetLiveness(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLivenessRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req.Header.Add("Content-Type", contentType)
+func (c *Client) GetReadiness(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetReadinessRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) GetJobByID(ctx context.Context, jobId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetJobByIDRequest(c.Server, jobId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetChallengeRequest generates requests for GetChallenge
-func NewGetChallengeRequest(server string, params *GetChallengeParams) (*http.Request, error) {
+func (c *Client) LoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Login(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Logout(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLogoutRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SearchMessagesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchMessagesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SearchMessages(ctx context.Context, body SearchMessagesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchMessagesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RefreshTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRefreshTokenRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RefreshToken(ctx context.Context, body RefreshTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRefreshTokenRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetRootRequest generates requests for GetRoot
+func NewGetRootRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1059,7 +1773,7 @@ func NewGetChallengeRequest(server string, params *GetChallengeParams) (*http.Re
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/challenge")
+	operationPath := fmt.Sprintf("/")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1069,24 +1783,6 @@ func NewGetChallengeRequest(server string, params *GetChallengeParams) (*http.Re
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1095,19 +1791,19 @@ func NewGetChallengeRequest(server string, params *GetChallengeParams) (*http.Re
 	return req, nil
 }
 
-// NewSearchChannelsRequest calls the generic SearchChannels builder with application/json body
-func NewSearchChannelsRequest(server string, body SearchChannelsJSONRequestBody) (*http.Request, error) {
+// NewPutAccountRequest calls the generic PutAccount builder with application/json body
+func NewPutAccountRequest(server string, body PutAccountJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewSearchChannelsRequestWithBody(server, "application/json", bodyReader)
+	return NewPutAccountRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewSearchChannelsRequestWithBody generates requests for SearchChannels with any type of body
-func NewSearchChannelsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewPutAccountRequestWithBody generates requests for PutAccount with any type of body
+func NewPutAccountRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1115,7 +1811,7 @@ func NewSearchChannelsRequestWithBody(server string, contentType string, body io
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/channel/search")
+	operationPath := fmt.Sprintf("/account/")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1135,19 +1831,19 @@ func NewSearchChannelsRequestWithBody(server string, contentType string, body io
 	return req, nil
 }
 
-// NewPutGroupRequest calls the generic PutGroup builder with application/json body
-func NewPutGroupRequest(server string, body PutGroupJSONRequestBody) (*http.Request, error) {
+// NewGetRegistrationChallengeRequest calls the generic GetRegistrationChallenge builder with application/json body
+func NewGetRegistrationChallengeRequest(server string, body GetRegistrationChallengeJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPutGroupRequestWithBody(server, "application/json", bodyReader)
+	return NewGetRegistrationChallengeRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewPutGroupRequestWithBody generates requests for PutGroup with any type of body
-func NewPutGroupRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetRegistrationChallengeRequestWithBody generates requests for GetRegistrationChallenge with any type of body
+func NewGetRegistrationChallengeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1155,7 +1851,7 @@ func NewPutGroupRequestWithBody(server string, contentType string, body io.Reade
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/group/")
+	operationPath := fmt.Sprintf("/account/challenge")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1175,19 +1871,19 @@ func NewPutGroupRequestWithBody(server string, contentType string, body io.Reade
 	return req, nil
 }
 
-// NewSearchGroupsRequest calls the generic SearchGroups builder with application/json body
-func NewSearchGroupsRequest(server string, body SearchGroupsJSONRequestBody) (*http.Request, error) {
+// NewSearchAccountsRequest calls the generic SearchAccounts builder with application/json body
+func NewSearchAccountsRequest(server string, body SearchAccountsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewSearchGroupsRequestWithBody(server, "application/json", bodyReader)
+	return NewSearchAccountsRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewSearchGroupsRequestWithBody generates requests for SearchGroups with any type of body
-func NewSearchGroupsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewSearchAccountsRequestWithBody generates requests for SearchAccounts with any type of body
+func NewSearchAccountsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1195,7 +1891,7 @@ func NewSearchGroupsRequestWithBody(server string, contentType string, body io.R
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/group/search")
+	operationPath := fmt.Sprintf("/account/search")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1215,13 +1911,13 @@ func NewSearchGroupsRequestWithBody(server string, contentType string, body io.R
 	return req, nil
 }
 
-// NewDeleteGroupByIDRequest generates requests for DeleteGroupByID
-func NewDeleteGroupByIDRequest(server string, groupId openapi_types.UUID) (*http.Request, error) {
+// NewDeleteAccountByIDRequest generates requests for DeleteAccountByID
+func NewDeleteAccountByIDRequest(server string, id openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1231,7 +1927,7 @@ func NewDeleteGroupByIDRequest(server string, groupId openapi_types.UUID) (*http
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/group/%s", pathParam0)
+	operationPath := fmt.Sprintf("/account/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1249,13 +1945,13 @@ func NewDeleteGroupByIDRequest(server string, groupId openapi_types.UUID) (*http
 	return req, nil
 }
 
-// NewGetGroupByIDRequest generates requests for GetGroupByID
-func NewGetGroupByIDRequest(server string, groupId openapi_types.UUID) (*http.Request, error) {
+// NewGetAccountByIDRequest generates requests for GetAccountByID
+func NewGetAccountByIDRequest(server string, id openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1265,7 +1961,7 @@ func NewGetGroupByIDRequest(server string, groupId openapi_types.UUID) (*http.Re
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/group/%s", pathParam0)
+	operationPath := fmt.Sprintf("/account/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1283,24 +1979,24 @@ func NewGetGroupByIDRequest(server string, groupId openapi_types.UUID) (*http.Re
 	return req, nil
 }
 
-// NewUpdateGroupByIDRequest calls the generic UpdateGroupByID builder with application/json body
-func NewUpdateGroupByIDRequest(server string, groupId openapi_types.UUID, body UpdateGroupByIDJSONRequestBody) (*http.Request, error) {
+// NewUpdateAccountByIDRequest calls the generic UpdateAccountByID builder with application/json body
+func NewUpdateAccountByIDRequest(server string, id openapi_types.UUID, body UpdateAccountByIDJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateGroupByIDRequestWithBody(server, groupId, "application/json", bodyReader)
+	return NewUpdateAccountByIDRequestWithBody(server, id, "application/json", bodyReader)
 }
 
-// NewUpdateGroupByIDRequestWithBody generates requests for UpdateGroupByID with any type of body
-func NewUpdateGroupByIDRequestWithBody(server string, groupId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateAccountByIDRequestWithBody generates requests for UpdateAccountByID with any type of body
+func NewUpdateAccountByIDRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1310,7 +2006,7 @@ func NewUpdateGroupByIDRequestWithBody(server string, groupId openapi_types.UUID
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/group/%s", pathParam0)
+	operationPath := fmt.Sprintf("/account/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1330,24 +2026,13 @@ func NewUpdateGroupByIDRequestWithBody(server string, groupId openapi_types.UUID
 	return req, nil
 }
 
-// NewPutChannelRequest calls the generic PutChannel builder with application/json body
-func NewPutChannelRequest(server string, groupId openapi_types.UUID, body PutChannelJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPutChannelRequestWithBody(server, groupId, "application/json", bodyReader)
-}
-
-// NewPutChannelRequestWithBody generates requests for PutChannel with any type of body
-func NewPutChannelRequestWithBody(server string, groupId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewListDeviceKeysRequest generates requests for ListDeviceKeys
+func NewListDeviceKeysRequest(server string, id openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1357,7 +2042,7 @@ func NewPutChannelRequestWithBody(server string, groupId openapi_types.UUID, con
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/group/%s/channel/", pathParam0)
+	operationPath := fmt.Sprintf("/account/%s/keys", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1367,30 +2052,32 @@ func NewPutChannelRequestWithBody(server string, groupId openapi_types.UUID, con
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewDeleteChannelByIDRequest generates requests for DeleteChannelByID
-func NewDeleteChannelByIDRequest(server string, groupId openapi_types.UUID, channelId openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+// NewAddDeviceKeyRequest calls the generic AddDeviceKey builder with application/json body
+func NewAddDeviceKeyRequest(server string, id openapi_types.UUID, body AddDeviceKeyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddDeviceKeyRequestWithBody(server, id, "application/json", bodyReader)
+}
 
-	var pathParam1 string
+// NewAddDeviceKeyRequestWithBody generates requests for AddDeviceKey with any type of body
+func NewAddDeviceKeyRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "channelId", runtime.ParamLocationPath, channelId)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1400,7 +2087,7 @@ func NewDeleteChannelByIDRequest(server string, groupId openapi_types.UUID, chan
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/group/%s/channel/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/account/%s/keys", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1410,28 +2097,34 @@ func NewDeleteChannelByIDRequest(server string, groupId openapi_types.UUID, chan
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetChannelByIDRequest generates requests for GetChannelByID
-func NewGetChannelByIDRequest(server string, groupId openapi_types.UUID, channelId openapi_types.UUID) (*http.Request, error) {
+// NewGetDeviceChallengeRequest calls the generic GetDeviceChallenge builder with application/json body
+func NewGetDeviceChallengeRequest(server string, id openapi_types.UUID, body GetDeviceChallengeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewGetDeviceChallengeRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewGetDeviceChallengeRequestWithBody generates requests for GetDeviceChallenge with any type of body
+func NewGetDeviceChallengeRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "channelId", runtime.ParamLocationPath, channelId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1441,7 +2134,7 @@ func NewGetChannelByIDRequest(server string, groupId openapi_types.UUID, channel
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/group/%s/channel/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/account/%s/keys/challenge", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1451,39 +2144,30 @@ func NewGetChannelByIDRequest(server string, groupId openapi_types.UUID, channel
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
+	req.Header.Add("Content-Type", contentType)
 
-// NewUpdateChannelByIDRequest calls the generic UpdateChannelByID builder with application/json body
-func NewUpdateChannelByIDRequest(server string, groupId openapi_types.UUID, channelId openapi_types.UUID, body UpdateChannelByIDJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateChannelByIDRequestWithBody(server, groupId, channelId, "application/json", bodyReader)
+	return req, nil
 }
 
-// NewUpdateChannelByIDRequestWithBody generates requests for UpdateChannelByID with any type of body
-func NewUpdateChannelByIDRequestWithBody(server string, groupId openapi_types.UUID, channelId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewRevokeDeviceKeyRequest generates requests for RevokeDeviceKey
+func NewRevokeDeviceKeyRequest(server string, id openapi_types.UUID, keyId openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "channelId", runtime.ParamLocationPath, channelId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "keyId", runtime.ParamLocationPath, keyId)
 	if err != nil {
 		return nil, err
 	}
@@ -1493,7 +2177,7 @@ func NewUpdateChannelByIDRequestWithBody(server string, groupId openapi_types.UU
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/group/%s/channel/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/account/%s/keys/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1503,51 +2187,24 @@ func NewUpdateChannelByIDRequestWithBody(server string, groupId openapi_types.UU
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewPutMessageRequest calls the generic PutMessage builder with application/json body
-func NewPutMessageRequest(server string, groupId openapi_types.UUID, channelId openapi_types.UUID, body PutMessageJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPutMessageRequestWithBody(server, groupId, channelId, "application/json", bodyReader)
-}
-
-// NewPutMessageRequestWithBody generates requests for PutMessage with any type of body
-func NewPutMessageRequestWithBody(server string, groupId openapi_types.UUID, channelId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewCheckIntegrityRequest generates requests for CheckIntegrity
+func NewCheckIntegrityRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "channelId", runtime.ParamLocationPath, channelId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/group/%s/channel/%s/message", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/admin/integrity")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1557,47 +2214,24 @@ func NewPutMessageRequestWithBody(server string, groupId openapi_types.UUID, cha
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewDeleteMessageByIDRequest generates requests for DeleteMessageByID
-func NewDeleteMessageByIDRequest(server string, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID) (*http.Request, error) {
+// NewRepairIntegrityRequest generates requests for RepairIntegrity
+func NewRepairIntegrityRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "channelId", runtime.ParamLocationPath, channelId)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "messageId", runtime.ParamLocationPath, messageId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/group/%s/channel/%s/message/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/admin/integrity/repair")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1607,7 +2241,7 @@ func NewDeleteMessageByIDRequest(server string, groupId openapi_types.UUID, chan
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1615,37 +2249,43 @@ func NewDeleteMessageByIDRequest(server string, groupId openapi_types.UUID, chan
 	return req, nil
 }
 
-// NewGetMessageByIDRequest generates requests for GetMessageByID
-func NewGetMessageByIDRequest(server string, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID) (*http.Request, error) {
+// NewGetNodeRequest generates requests for GetNode
+func NewGetNodeRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
+	operationPath := fmt.Sprintf("/admin/node")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "channelId", runtime.ParamLocationPath, channelId)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "messageId", runtime.ParamLocationPath, messageId)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
+	return req, nil
+}
+
+// NewListPeersRequest generates requests for ListPeers
+func NewListPeersRequest(server string) (*http.Request, error) {
+	var err error
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/group/%s/channel/%s/message/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/admin/peers")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1663,48 +2303,67 @@ func NewGetMessageByIDRequest(server string, groupId openapi_types.UUID, channel
 	return req, nil
 }
 
-// NewUpdateMessageByIDRequest calls the generic UpdateMessageByID builder with application/json body
-func NewUpdateMessageByIDRequest(server string, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID, body UpdateMessageByIDJSONRequestBody) (*http.Request, error) {
+// NewConnectPeerRequest calls the generic ConnectPeer builder with application/json body
+func NewConnectPeerRequest(server string, body ConnectPeerJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateMessageByIDRequestWithBody(server, groupId, channelId, messageId, "application/json", bodyReader)
+	return NewConnectPeerRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewUpdateMessageByIDRequestWithBody generates requests for UpdateMessageByID with any type of body
-func NewUpdateMessageByIDRequestWithBody(server string, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewConnectPeerRequestWithBody generates requests for ConnectPeer with any type of body
+func NewConnectPeerRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
+	operationPath := fmt.Sprintf("/admin/peers/connect")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "channelId", runtime.ParamLocationPath, channelId)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam2 string
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "messageId", runtime.ParamLocationPath, messageId)
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDisconnectPeerRequest calls the generic DisconnectPeer builder with application/json body
+func NewDisconnectPeerRequest(server string, body DisconnectPeerJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDisconnectPeerRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewDisconnectPeerRequestWithBody generates requests for DisconnectPeer with any type of body
+func NewDisconnectPeerRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/group/%s/channel/%s/message/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/admin/peers/disconnect")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1714,7 +2373,7 @@ func NewUpdateMessageByIDRequestWithBody(server string, groupId openapi_types.UU
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -1724,30 +2383,16 @@ func NewUpdateMessageByIDRequestWithBody(server string, groupId openapi_types.UU
 	return req, nil
 }
 
-// NewRemoveGroupMemberRequest generates requests for RemoveGroupMember
-func NewRemoveGroupMemberRequest(server string, groupId openapi_types.UUID, memberId openapi_types.UUID) (*http.Request, error) {
+// NewGetPreferredPeersRequest generates requests for GetPreferredPeers
+func NewGetPreferredPeersRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "memberId", runtime.ParamLocationPath, memberId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/group/%s/members/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/admin/peers/preferred")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1757,7 +2402,7 @@ func NewRemoveGroupMemberRequest(server string, groupId openapi_types.UUID, memb
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1765,30 +2410,27 @@ func NewRemoveGroupMemberRequest(server string, groupId openapi_types.UUID, memb
 	return req, nil
 }
 
-// NewAddGroupMemberRequest generates requests for AddGroupMember
-func NewAddGroupMemberRequest(server string, groupId openapi_types.UUID, memberId openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+// NewSetPreferredPeersRequest calls the generic SetPreferredPeers builder with application/json body
+func NewSetPreferredPeersRequest(server string, body SetPreferredPeersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetPreferredPeersRequestWithBody(server, "application/json", bodyReader)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "memberId", runtime.ParamLocationPath, memberId)
-	if err != nil {
-		return nil, err
-	}
+// NewSetPreferredPeersRequestWithBody generates requests for SetPreferredPeers with any type of body
+func NewSetPreferredPeersRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/group/%s/members/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/admin/peers/preferred")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1798,16 +2440,18 @@ func NewAddGroupMemberRequest(server string, groupId openapi_types.UUID, memberI
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetHealthRequest generates requests for GetHealth
-func NewGetHealthRequest(server string) (*http.Request, error) {
+// NewGetChallengeRequest generates requests for GetChallenge
+func NewGetChallengeRequest(server string, params *GetChallengeParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1815,7 +2459,7 @@ func NewGetHealthRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/health")
+	operationPath := fmt.Sprintf("/challenge")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1825,6 +2469,24 @@ func NewGetHealthRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1833,19 +2495,19 @@ func NewGetHealthRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewLoginRequest calls the generic Login builder with application/json body
-func NewLoginRequest(server string, body LoginJSONRequestBody) (*http.Request, error) {
+// NewSearchChannelsRequest calls the generic SearchChannels builder with application/json body
+func NewSearchChannelsRequest(server string, body SearchChannelsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewLoginRequestWithBody(server, "application/json", bodyReader)
+	return NewSearchChannelsRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewLoginRequestWithBody generates requests for Login with any type of body
-func NewLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewSearchChannelsRequestWithBody generates requests for SearchChannels with any type of body
+func NewSearchChannelsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1853,7 +2515,7 @@ func NewLoginRequestWithBody(server string, contentType string, body io.Reader)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/login")
+	operationPath := fmt.Sprintf("/channel/search")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1873,19 +2535,8 @@ func NewLoginRequestWithBody(server string, contentType string, body io.Reader)
 	return req, nil
 }
 
-// NewSearchMessagesRequest calls the generic SearchMessages builder with application/json body
-func NewSearchMessagesRequest(server string, body SearchMessagesJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewSearchMessagesRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewSearchMessagesRequestWithBody generates requests for SearchMessages with any type of body
-func NewSearchMessagesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewStreamEventsRequest generates requests for StreamEvents
+func NewStreamEventsRequest(server string, params *StreamEventsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1893,7 +2544,7 @@ func NewSearchMessagesRequestWithBody(server string, contentType string, body io
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/message/search")
+	operationPath := fmt.Sprintf("/events")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1903,1774 +2554,5057 @@ func NewSearchMessagesRequestWithBody(server string, contentType string, body io
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	req.Header.Add("Content-Type", contentType)
+		if params.Group != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "group", runtime.ParamLocationQuery, *params.Group); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	return req, nil
-}
+		}
+
+		if params.Channel != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "channel", runtime.ParamLocationQuery, *params.Channel); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
 		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.LastEventID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, *params.LastEventID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Last-Event-ID", headerParam0)
 		}
+
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+	return req, nil
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+// NewPutGroupRequest calls the generic PutGroup builder with application/json body
+func NewPutGroupRequest(server string, body PutGroupJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+	bodyReader = bytes.NewReader(buf)
+	return NewPutGroupRequestWithBody(server, "application/json", bodyReader)
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+// NewPutGroupRequestWithBody generates requests for PutGroup with any type of body
+func NewPutGroupRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetRootWithResponse request
-	GetRootWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRootResponse, error)
+	operationPath := fmt.Sprintf("/group/")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// PutAccountWithBodyWithResponse request with any body
-	PutAccountWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAccountResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	PutAccountWithResponse(ctx context.Context, body PutAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAccountResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// SearchAccountsWithBodyWithResponse request with any body
-	SearchAccountsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchAccountsResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	SearchAccountsWithResponse(ctx context.Context, body SearchAccountsJSONRequestBody, reqEditors ...RequestEditorFn) (*SearchAccountsResponse, error)
+	return req, nil
+}
 
-	// DeleteAccountByIDWithResponse request
-	DeleteAccountByIDWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAccountByIDResponse, error)
+// NewSearchGroupsRequest calls the generic SearchGroups builder with application/json body
+func NewSearchGroupsRequest(server string, body SearchGroupsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSearchGroupsRequestWithBody(server, "application/json", bodyReader)
+}
 
-	// GetAccountByIDWithResponse request
-	GetAccountByIDWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAccountByIDResponse, error)
+// NewSearchGroupsRequestWithBody generates requests for SearchGroups with any type of body
+func NewSearchGroupsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// UpdateAccountByIDWithBodyWithResponse request with any body
-	UpdateAccountByIDWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAccountByIDResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateAccountByIDWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateAccountByIDJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAccountByIDResponse, error)
+	operationPath := fmt.Sprintf("/group/search")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetChallengeWithResponse request
-	GetChallengeWithResponse(ctx context.Context, params *GetChallengeParams, reqEditors ...RequestEditorFn) (*GetChallengeResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// SearchChannelsWithBodyWithResponse request with any body
-	SearchChannelsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchChannelsResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	SearchChannelsWithResponse(ctx context.Context, body SearchChannelsJSONRequestBody, reqEditors ...RequestEditorFn) (*SearchChannelsResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// PutGroupWithBodyWithResponse request with any body
-	PutGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutGroupResponse, error)
+	return req, nil
+}
 
-	PutGroupWithResponse(ctx context.Context, body PutGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*PutGroupResponse, error)
+// NewDeleteGroupByIDRequest generates requests for DeleteGroupByID
+func NewDeleteGroupByIDRequest(server string, groupId openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-	// SearchGroupsWithBodyWithResponse request with any body
-	SearchGroupsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchGroupsResponse, error)
+	var pathParam0 string
 
-	SearchGroupsWithResponse(ctx context.Context, body SearchGroupsJSONRequestBody, reqEditors ...RequestEditorFn) (*SearchGroupsResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteGroupByIDWithResponse request
-	DeleteGroupByIDWithResponse(ctx context.Context, groupId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteGroupByIDResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetGroupByIDWithResponse request
-	GetGroupByIDWithResponse(ctx context.Context, groupId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetGroupByIDResponse, error)
+	operationPath := fmt.Sprintf("/group/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// UpdateGroupByIDWithBodyWithResponse request with any body
-	UpdateGroupByIDWithBodyWithResponse(ctx context.Context, groupId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateGroupByIDResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateGroupByIDWithResponse(ctx context.Context, groupId openapi_types.UUID, body UpdateGroupByIDJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateGroupByIDResponse, error)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// PutChannelWithBodyWithResponse request with any body
-	PutChannelWithBodyWithResponse(ctx context.Context, groupId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutChannelResponse, error)
+	return req, nil
+}
 
-	PutChannelWithResponse(ctx context.Context, groupId openapi_types.UUID, body PutChannelJSONRequestBody, reqEditors ...RequestEditorFn) (*PutChannelResponse, error)
+// NewGetGroupByIDRequest generates requests for GetGroupByID
+func NewGetGroupByIDRequest(server string, groupId openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-	// DeleteChannelByIDWithResponse request
-	DeleteChannelByIDWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteChannelByIDResponse, error)
+	var pathParam0 string
 
-	// GetChannelByIDWithResponse request
-	GetChannelByIDWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetChannelByIDResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateChannelByIDWithBodyWithResponse request with any body
-	UpdateChannelByIDWithBodyWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateChannelByIDResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateChannelByIDWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, body UpdateChannelByIDJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateChannelByIDResponse, error)
+	operationPath := fmt.Sprintf("/group/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// PutMessageWithBodyWithResponse request with any body
-	PutMessageWithBodyWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutMessageResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	PutMessageWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, body PutMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*PutMessageResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteMessageByIDWithResponse request
-	DeleteMessageByIDWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteMessageByIDResponse, error)
+	return req, nil
+}
 
-	// GetMessageByIDWithResponse request
-	GetMessageByIDWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetMessageByIDResponse, error)
+// NewUpdateGroupByIDRequest calls the generic UpdateGroupByID builder with application/json body
+func NewUpdateGroupByIDRequest(server string, groupId openapi_types.UUID, body UpdateGroupByIDJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateGroupByIDRequestWithBody(server, groupId, "application/json", bodyReader)
+}
 
-	// UpdateMessageByIDWithBodyWithResponse request with any body
-	UpdateMessageByIDWithBodyWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMessageByIDResponse, error)
+// NewUpdateGroupByIDRequestWithBody generates requests for UpdateGroupByID with any type of body
+func NewUpdateGroupByIDRequestWithBody(server string, groupId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	UpdateMessageByIDWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID, body UpdateMessageByIDJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMessageByIDResponse, error)
+	var pathParam0 string
 
-	// RemoveGroupMemberWithResponse request
-	RemoveGroupMemberWithResponse(ctx context.Context, groupId openapi_types.UUID, memberId openapi_types.UUID, reqEditors ...RequestEditorFn) (*RemoveGroupMemberResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
 
-	// AddGroupMemberWithResponse request
-	AddGroupMemberWithResponse(ctx context.Context, groupId openapi_types.UUID, memberId openapi_types.UUID, reqEditors ...RequestEditorFn) (*AddGroupMemberResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetHealthWithResponse request
-	GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error)
+	operationPath := fmt.Sprintf("/group/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// LoginWithBodyWithResponse request with any body
-	LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginResponse, error)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// SearchMessagesWithBodyWithResponse request with any body
-	SearchMessagesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchMessagesResponse, error)
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPutChannelRequest calls the generic PutChannel builder with application/json body
+func NewPutChannelRequest(server string, groupId openapi_types.UUID, body PutChannelJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutChannelRequestWithBody(server, groupId, "application/json", bodyReader)
+}
+
+// NewPutChannelRequestWithBody generates requests for PutChannel with any type of body
+func NewPutChannelRequestWithBody(server string, groupId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/group/%s/channel/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteChannelByIDRequest generates requests for DeleteChannelByID
+func NewDeleteChannelByIDRequest(server string, groupId openapi_types.UUID, channelId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "channelId", runtime.ParamLocationPath, channelId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/group/%s/channel/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetChannelByIDRequest generates requests for GetChannelByID
+func NewGetChannelByIDRequest(server string, groupId openapi_types.UUID, channelId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "channelId", runtime.ParamLocationPath, channelId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/group/%s/channel/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateChannelByIDRequest calls the generic UpdateChannelByID builder with application/json body
+func NewUpdateChannelByIDRequest(server string, groupId openapi_types.UUID, channelId openapi_types.UUID, body UpdateChannelByIDJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateChannelByIDRequestWithBody(server, groupId, channelId, "application/json", bodyReader)
+}
+
+// NewUpdateChannelByIDRequestWithBody generates requests for UpdateChannelByID with any type of body
+func NewUpdateChannelByIDRequestWithBody(server string, groupId openapi_types.UUID, channelId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "channelId", runtime.ParamLocationPath, channelId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/group/%s/channel/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPutMessageRequest calls the generic PutMessage builder with application/json body
+func NewPutMessageRequest(server string, groupId openapi_types.UUID, channelId openapi_types.UUID, body PutMessageJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutMessageRequestWithBody(server, groupId, channelId, "application/json", bodyReader)
+}
+
+// NewPutMessageRequestWithBody generates requests for PutMessage with any type of body
+func NewPutMessageRequestWithBody(server string, groupId openapi_types.UUID, channelId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "channelId", runtime.ParamLocationPath, channelId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/group/%s/channel/%s/message", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteMessageByIDRequest generates requests for DeleteMessageByID
+func NewDeleteMessageByIDRequest(server string, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "channelId", runtime.ParamLocationPath, channelId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "messageId", runtime.ParamLocationPath, messageId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/group/%s/channel/%s/message/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetMessageByIDRequest generates requests for GetMessageByID
+func NewGetMessageByIDRequest(server string, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "channelId", runtime.ParamLocationPath, channelId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "messageId", runtime.ParamLocationPath, messageId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/group/%s/channel/%s/message/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateMessageByIDRequest calls the generic UpdateMessageByID builder with application/json body
+func NewUpdateMessageByIDRequest(server string, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID, body UpdateMessageByIDJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateMessageByIDRequestWithBody(server, groupId, channelId, messageId, "application/json", bodyReader)
+}
+
+// NewUpdateMessageByIDRequestWithBody generates requests for UpdateMessageByID with any type of body
+func NewUpdateMessageByIDRequestWithBody(server string, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "channelId", runtime.ParamLocationPath, channelId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "messageId", runtime.ParamLocationPath, messageId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/group/%s/channel/%s/message/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRemoveGroupMemberRequest generates requests for RemoveGroupMember
+func NewRemoveGroupMemberRequest(server string, groupId openapi_types.UUID, memberId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "memberId", runtime.ParamLocationPath, memberId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/group/%s/members/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddGroupMemberRequest generates requests for AddGroupMember
+func NewAddGroupMemberRequest(server string, groupId openapi_types.UUID, memberId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "memberId", runtime.ParamLocationPath, memberId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/group/%s/members/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRevokeGroupRoleRequest generates requests for RevokeGroupRole
+func NewRevokeGroupRoleRequest(server string, groupId openapi_types.UUID, memberId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "memberId", runtime.ParamLocationPath, memberId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/group/%s/members/%s/role", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAssignGroupRoleRequest calls the generic AssignGroupRole builder with application/json body
+func NewAssignGroupRoleRequest(server string, groupId openapi_types.UUID, memberId openapi_types.UUID, body AssignGroupRoleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAssignGroupRoleRequestWithBody(server, groupId, memberId, "application/json", bodyReader)
+}
+
+// NewAssignGroupRoleRequestWithBody generates requests for AssignGroupRole with any type of body
+func NewAssignGroupRoleRequestWithBody(server string, groupId openapi_types.UUID, memberId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "memberId", runtime.ParamLocationPath, memberId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/group/%s/members/%s/role", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTransferGroupOwnershipRequest generates requests for TransferGroupOwnership
+func NewTransferGroupOwnershipRequest(server string, groupId openapi_types.UUID, memberId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "memberId", runtime.ParamLocationPath, memberId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/group/%s/owner/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetHealthRequest generates requests for GetHealth
+func NewGetHealthRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/health")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetLivenessRequest generates requests for GetLiveness
+func NewGetLivenessRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/health/live")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetReadinessRequest generates requests for GetReadiness
+func NewGetReadinessRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/health/ready")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetJobByIDRequest generates requests for GetJobByID
+func NewGetJobByIDRequest(server string, jobId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "jobId", runtime.ParamLocationPath, jobId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/jobs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLoginRequest calls the generic Login builder with application/json body
+func NewLoginRequest(server string, body LoginJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLoginRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewLoginRequestWithBody generates requests for Login with any type of body
+func NewLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLogoutRequest generates requests for Logout
+func NewLogoutRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/logout")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSearchMessagesRequest calls the generic SearchMessages builder with application/json body
+func NewSearchMessagesRequest(server string, body SearchMessagesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSearchMessagesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSearchMessagesRequestWithBody generates requests for SearchMessages with any type of body
+func NewSearchMessagesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/message/search")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRefreshTokenRequest calls the generic RefreshToken builder with application/json body
+func NewRefreshTokenRequest(server string, body RefreshTokenJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRefreshTokenRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRefreshTokenRequestWithBody generates requests for RefreshToken with any type of body
+func NewRefreshTokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/refresh")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetRootWithResponse request
+	GetRootWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRootResponse, error)
+
+	// PutAccountWithBodyWithResponse request with any body
+	PutAccountWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAccountResponse, error)
+
+	PutAccountWithResponse(ctx context.Context, body PutAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAccountResponse, error)
+
+	// GetRegistrationChallengeWithBodyWithResponse request with any body
+	GetRegistrationChallengeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GetRegistrationChallengeResponse, error)
+
+	GetRegistrationChallengeWithResponse(ctx context.Context, body GetRegistrationChallengeJSONRequestBody, reqEditors ...RequestEditorFn) (*GetRegistrationChallengeResponse, error)
+
+	// SearchAccountsWithBodyWithResponse request with any body
+	SearchAccountsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchAccountsResponse, error)
+
+	SearchAccountsWithResponse(ctx context.Context, body SearchAccountsJSONRequestBody, reqEditors ...RequestEditorFn) (*SearchAccountsResponse, error)
+
+	// DeleteAccountByIDWithResponse request
+	DeleteAccountByIDWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAccountByIDResponse, error)
+
+	// GetAccountByIDWithResponse request
+	GetAccountByIDWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAccountByIDResponse, error)
+
+	// UpdateAccountByIDWithBodyWithResponse request with any body
+	UpdateAccountByIDWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAccountByIDResponse, error)
+
+	UpdateAccountByIDWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateAccountByIDJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAccountByIDResponse, error)
+
+	// ListDeviceKeysWithResponse request
+	ListDeviceKeysWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*ListDeviceKeysResponse, error)
+
+	// AddDeviceKeyWithBodyWithResponse request with any body
+	AddDeviceKeyWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddDeviceKeyResponse, error)
+
+	AddDeviceKeyWithResponse(ctx context.Context, id openapi_types.UUID, body AddDeviceKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*AddDeviceKeyResponse, error)
+
+	// GetDeviceChallengeWithBodyWithResponse request with any body
+	GetDeviceChallengeWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GetDeviceChallengeResponse, error)
+
+	GetDeviceChallengeWithResponse(ctx context.Context, id openapi_types.UUID, body GetDeviceChallengeJSONRequestBody, reqEditors ...RequestEditorFn) (*GetDeviceChallengeResponse, error)
+
+	// RevokeDeviceKeyWithResponse request
+	RevokeDeviceKeyWithResponse(ctx context.Context, id openapi_types.UUID, keyId openapi_types.UUID, reqEditors ...RequestEditorFn) (*RevokeDeviceKeyResponse, error)
+
+	// CheckIntegrityWithResponse request
+	CheckIntegrityWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CheckIntegrityResponse, error)
+
+	// RepairIntegrityWithResponse request
+	RepairIntegrityWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RepairIntegrityResponse, error)
+
+	// GetNodeWithResponse request
+	GetNodeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetNodeResponse, error)
+
+	// ListPeersWithResponse request
+	ListPeersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListPeersResponse, error)
+
+	// ConnectPeerWithBodyWithResponse request with any body
+	ConnectPeerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConnectPeerResponse, error)
+
+	ConnectPeerWithResponse(ctx context.Context, body ConnectPeerJSONRequestBody, reqEditors ...RequestEditorFn) (*ConnectPeerResponse, error)
+
+	// DisconnectPeerWithBodyWithResponse request with any body
+	DisconnectPeerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DisconnectPeerResponse, error)
+
+	DisconnectPeerWithResponse(ctx context.Context, body DisconnectPeerJSONRequestBody, reqEditors ...RequestEditorFn) (*DisconnectPeerResponse, error)
+
+	// GetPreferredPeersWithResponse request
+	GetPreferredPeersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPreferredPeersResponse, error)
+
+	// SetPreferredPeersWithBodyWithResponse request with any body
+	SetPreferredPeersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetPreferredPeersResponse, error)
+
+	SetPreferredPeersWithResponse(ctx context.Context, body SetPreferredPeersJSONRequestBody, reqEditors ...RequestEditorFn) (*SetPreferredPeersResponse, error)
+
+	// GetChallengeWithResponse request
+	GetChallengeWithResponse(ctx context.Context, params *GetChallengeParams, reqEditors ...RequestEditorFn) (*GetChallengeResponse, error)
+
+	// SearchChannelsWithBodyWithResponse request with any body
+	SearchChannelsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchChannelsResponse, error)
+
+	SearchChannelsWithResponse(ctx context.Context, body SearchChannelsJSONRequestBody, reqEditors ...RequestEditorFn) (*SearchChannelsResponse, error)
+
+	// StreamEventsWithResponse request
+	StreamEventsWithResponse(ctx context.Context, params *StreamEventsParams, reqEditors ...RequestEditorFn) (*StreamEventsResponse, error)
+
+	// PutGroupWithBodyWithResponse request with any body
+	PutGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutGroupResponse, error)
+
+	PutGroupWithResponse(ctx context.Context, body PutGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*PutGroupResponse, error)
+
+	// SearchGroupsWithBodyWithResponse request with any body
+	SearchGroupsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchGroupsResponse, error)
+
+	SearchGroupsWithResponse(ctx context.Context, body SearchGroupsJSONRequestBody, reqEditors ...RequestEditorFn) (*SearchGroupsResponse, error)
+
+	// DeleteGroupByIDWithResponse request
+	DeleteGroupByIDWithResponse(ctx context.Context, groupId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteGroupByIDResponse, error)
+
+	// GetGroupByIDWithResponse request
+	GetGroupByIDWithResponse(ctx context.Context, groupId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetGroupByIDResponse, error)
+
+	// UpdateGroupByIDWithBodyWithResponse request with any body
+	UpdateGroupByIDWithBodyWithResponse(ctx context.Context, groupId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateGroupByIDResponse, error)
+
+	UpdateGroupByIDWithResponse(ctx context.Context, groupId openapi_types.UUID, body UpdateGroupByIDJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateGroupByIDResponse, error)
+
+	// PutChannelWithBodyWithResponse request with any body
+	PutChannelWithBodyWithResponse(ctx context.Context, groupId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutChannelResponse, error)
+
+	PutChannelWithResponse(ctx context.Context, groupId openapi_types.UUID, body PutChannelJSONRequestBody, reqEditors ...RequestEditorFn) (*PutChannelResponse, error)
+
+	// DeleteChannelByIDWithResponse request
+	DeleteChannelByIDWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteChannelByIDResponse, error)
+
+	// GetChannelByIDWithResponse request
+	GetChannelByIDWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetChannelByIDResponse, error)
+
+	// UpdateChannelByIDWithBodyWithResponse request with any body
+	UpdateChannelByIDWithBodyWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateChannelByIDResponse, error)
+
+	UpdateChannelByIDWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, body UpdateChannelByIDJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateChannelByIDResponse, error)
+
+	// PutMessageWithBodyWithResponse request with any body
+	PutMessageWithBodyWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutMessageResponse, error)
+
+	PutMessageWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, body PutMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*PutMessageResponse, error)
+
+	// DeleteMessageByIDWithResponse request
+	DeleteMessageByIDWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteMessageByIDResponse, error)
+
+	// GetMessageByIDWithResponse request
+	GetMessageByIDWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetMessageByIDResponse, error)
+
+	// UpdateMessageByIDWithBodyWithResponse request with any body
+	UpdateMessageByIDWithBodyWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMessageByIDResponse, error)
+
+	UpdateMessageByIDWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID, body UpdateMessageByIDJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMessageByIDResponse, error)
+
+	// RemoveGroupMemberWithResponse request
+	RemoveGroupMemberWithResponse(ctx context.Context, groupId openapi_types.UUID, memberId openapi_types.UUID, reqEditors ...RequestEditorFn) (*RemoveGroupMemberResponse, error)
+
+	// AddGroupMemberWithResponse request
+	AddGroupMemberWithResponse(ctx context.Context, groupId openapi_types.UUID, memberId openapi_types.UUID, reqEditors ...RequestEditorFn) (*AddGroupMemberResponse, error)
+
+	// RevokeGroupRoleWithResponse request
+	RevokeGroupRoleWithResponse(ctx context.Context, groupId openapi_types.UUID, memberId openapi_types.UUID, reqEditors ...RequestEditorFn) (*RevokeGroupRoleResponse, error)
+
+	// AssignGroupRoleWithBodyWithResponse request with any body
+	AssignGroupRoleWithBodyWithResponse(ctx context.Context, groupId openapi_types.UUID, memberId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AssignGroupRoleResponse, error)
+
+	AssignGroupRoleWithResponse(ctx context.Context, groupId openapi_types.UUID, memberId openapi_types.UUID, body AssignGroupRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*AssignGroupRoleResponse, error)
+
+	// TransferGroupOwnershipWithResponse request
+	TransferGroupOwnershipWithResponse(ctx context.Context, groupId openapi_types.UUID, memberId openapi_types.UUID, reqEditors ...RequestEditorFn) (*TransferGroupOwnershipResponse, error)
+
+	// GetHealthWithResponse request
+	GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error)
+
+	// GetLivenessWithResponse request
+	GetLivenessWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLivenessResponse, error)
+
+	// GetReadinessWithResponse request
+	GetReadinessWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetReadinessResponse, error)
+
+	// GetJobByIDWithResponse request
+	GetJobByIDWithResponse(ctx context.Context, jobId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetJobByIDResponse, error)
+
+	// LoginWithBodyWithResponse request with any body
+	LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResponse, error)
+
+	LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginResponse, error)
+
+	// LogoutWithResponse request
+	LogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LogoutResponse, error)
+
+	// SearchMessagesWithBodyWithResponse request with any body
+	SearchMessagesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchMessagesResponse, error)
 
 	SearchMessagesWithResponse(ctx context.Context, body SearchMessagesJSONRequestBody, reqEditors ...RequestEditorFn) (*SearchMessagesResponse, error)
+
+	// RefreshTokenWithBodyWithResponse request with any body
+	RefreshTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RefreshTokenResponse, error)
+
+	RefreshTokenWithResponse(ctx context.Context, body RefreshTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*RefreshTokenResponse, error)
+}
+
+type GetRootResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *map[string]interface{}
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRootResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRootResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAccountResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *Account
+	ApplicationproblemJSON409     *Problem
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAccountResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAccountResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRegistrationChallengeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Challenge string `json:"challenge"`
+	}
+	ApplicationproblemJSON409     *Problem
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRegistrationChallengeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRegistrationChallengeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SearchAccountsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *AccountPage
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchAccountsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchAccountsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAccountByIDResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAccountByIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAccountByIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAccountByIDResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Account
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAccountByIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAccountByIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateAccountByIDResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Account
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateAccountByIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateAccountByIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDeviceKeysResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]DeviceKey
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDeviceKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDeviceKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddDeviceKeyResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *DeviceKey
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r AddDeviceKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddDeviceKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDeviceChallengeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Challenge string `json:"challenge"`
+	}
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDeviceChallengeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDeviceChallengeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokeDeviceKeyResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeDeviceKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeDeviceKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CheckIntegrityResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *IntegrityReport
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r CheckIntegrityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CheckIntegrityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RepairIntegrityResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *IntegrityReport
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r RepairIntegrityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RepairIntegrityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetNodeResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *NodeInfo
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetNodeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetNodeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListPeersResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]Peer
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPeersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPeersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConnectPeerResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ConnectPeerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConnectPeerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DisconnectPeerResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r DisconnectPeerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DisconnectPeerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPreferredPeersResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *PreferredPeers
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPreferredPeersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPreferredPeersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetPreferredPeersResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *PreferredPeers
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r SetPreferredPeersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetPreferredPeersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetChallengeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Challenge *string `json:"challenge,omitempty"`
+	}
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetChallengeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetChallengeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SearchChannelsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ChannelPage
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchChannelsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchChannelsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StreamEventsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r StreamEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutGroupResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *Group
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PutGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SearchGroupsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *GroupPage
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchGroupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchGroupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteGroupByIDResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON202                       *Job
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteGroupByIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteGroupByIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetGroupByIDResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Group
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetGroupByIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetGroupByIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateGroupByIDResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *Group
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateGroupByIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateGroupByIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutChannelResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *Channel
+	ApplicationproblemJSON409     *Problem
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PutChannelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutChannelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteChannelByIDResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON202                       *Job
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteChannelByIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteChannelByIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetChannelByIDResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Channel
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetChannelByIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetChannelByIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateChannelByIDResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *Channel
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateChannelByIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateChannelByIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutMessageResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *Message
+	ApplicationproblemJSON409     *Problem
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PutMessageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutMessageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteMessageByIDResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteMessageByIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteMessageByIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMessageByIDResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Message
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMessageByIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMessageByIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateMessageByIDResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *Message
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateMessageByIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateMessageByIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveGroupMemberResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveGroupMemberResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveGroupMemberResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddGroupMemberResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r AddGroupMemberResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddGroupMemberResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokeGroupRoleResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeGroupRoleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeGroupRoleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AssignGroupRoleResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *Group
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r AssignGroupRoleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AssignGroupRoleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TransferGroupOwnershipResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *Group
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r TransferGroupOwnershipResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TransferGroupOwnershipResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHealthResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Liveness
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHealthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHealthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLivenessResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Liveness
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLivenessResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLivenessResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetReadinessResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Readiness
+	JSON503                       *Readiness
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetReadinessResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetReadinessResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetJobByIDResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Job
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetJobByIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetJobByIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LoginResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *TokenPair
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r LoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LogoutResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r LogoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LogoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SearchMessagesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *MessagePage
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchMessagesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchMessagesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RefreshTokenResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *TokenPair
+	ApplicationproblemJSONDefault *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r RefreshTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RefreshTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetRootWithResponse request returning *GetRootResponse
+func (c *ClientWithResponses) GetRootWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRootResponse, error) {
+	rsp, err := c.GetRoot(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRootResponse(rsp)
+}
+
+// PutAccountWithBodyWithResponse request with arbitrary body returning *PutAccountResponse
+func (c *ClientWithResponses) PutAccountWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAccountResponse, error) {
+	rsp, err := c.PutAccountWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAccountResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutAccountWithResponse(ctx context.Context, body PutAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAccountResponse, error) {
+	rsp, err := c.PutAccount(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAccountResponse(rsp)
+}
+
+// GetRegistrationChallengeWithBodyWithResponse request with arbitrary body returning *GetRegistrationChallengeResponse
+func (c *ClientWithResponses) GetRegistrationChallengeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GetRegistrationChallengeResponse, error) {
+	rsp, err := c.GetRegistrationChallengeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRegistrationChallengeResponse(rsp)
+}
+
+func (c *ClientWithResponses) GetRegistrationChallengeWithResponse(ctx context.Context, body GetRegistrationChallengeJSONRequestBody, reqEditors ...RequestEditorFn) (*GetRegistrationChallengeResponse, error) {
+	rsp, err := c.GetRegistrationChallenge(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRegistrationChallengeResponse(rsp)
+}
+
+// SearchAccountsWithBodyWithResponse request with arbitrary body returning *SearchAccountsResponse
+func (c *ClientWithResponses) SearchAccountsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchAccountsResponse, error) {
+	rsp, err := c.SearchAccountsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchAccountsResponse(rsp)
+}
+
+func (c *ClientWithResponses) SearchAccountsWithResponse(ctx context.Context, body SearchAccountsJSONRequestBody, reqEditors ...RequestEditorFn) (*SearchAccountsResponse, error) {
+	rsp, err := c.SearchAccounts(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchAccountsResponse(rsp)
+}
+
+// DeleteAccountByIDWithResponse request returning *DeleteAccountByIDResponse
+func (c *ClientWithResponses) DeleteAccountByIDWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAccountByIDResponse, error) {
+	rsp, err := c.DeleteAccountByID(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAccountByIDResponse(rsp)
+}
+
+// GetAccountByIDWithResponse request returning *GetAccountByIDResponse
+func (c *ClientWithResponses) GetAccountByIDWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAccountByIDResponse, error) {
+	rsp, err := c.GetAccountByID(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAccountByIDResponse(rsp)
+}
+
+// UpdateAccountByIDWithBodyWithResponse request with arbitrary body returning *UpdateAccountByIDResponse
+func (c *ClientWithResponses) UpdateAccountByIDWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAccountByIDResponse, error) {
+	rsp, err := c.UpdateAccountByIDWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAccountByIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateAccountByIDWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateAccountByIDJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAccountByIDResponse, error) {
+	rsp, err := c.UpdateAccountByID(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAccountByIDResponse(rsp)
+}
+
+// ListDeviceKeysWithResponse request returning *ListDeviceKeysResponse
+func (c *ClientWithResponses) ListDeviceKeysWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*ListDeviceKeysResponse, error) {
+	rsp, err := c.ListDeviceKeys(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDeviceKeysResponse(rsp)
+}
+
+// AddDeviceKeyWithBodyWithResponse request with arbitrary body returning *AddDeviceKeyResponse
+func (c *ClientWithResponses) AddDeviceKeyWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddDeviceKeyResponse, error) {
+	rsp, err := c.AddDeviceKeyWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddDeviceKeyResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddDeviceKeyWithResponse(ctx context.Context, id openapi_types.UUID, body AddDeviceKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*AddDeviceKeyResponse, error) {
+	rsp, err := c.AddDeviceKey(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddDeviceKeyResponse(rsp)
+}
+
+// GetDeviceChallengeWithBodyWithResponse request with arbitrary body returning *GetDeviceChallengeResponse
+func (c *ClientWithResponses) GetDeviceChallengeWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GetDeviceChallengeResponse, error) {
+	rsp, err := c.GetDeviceChallengeWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDeviceChallengeResponse(rsp)
+}
+
+func (c *ClientWithResponses) GetDeviceChallengeWithResponse(ctx context.Context, id openapi_types.UUID, body GetDeviceChallengeJSONRequestBody, reqEditors ...RequestEditorFn) (*GetDeviceChallengeResponse, error) {
+	rsp, err := c.GetDeviceChallenge(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDeviceChallengeResponse(rsp)
+}
+
+// RevokeDeviceKeyWithResponse request returning *RevokeDeviceKeyResponse
+func (c *ClientWithResponses) RevokeDeviceKeyWithResponse(ctx context.Context, id openapi_types.UUID, keyId openapi_types.UUID, reqEditors ...RequestEditorFn) (*RevokeDeviceKeyResponse, error) {
+	rsp, err := c.RevokeDeviceKey(ctx, id, keyId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeDeviceKeyResponse(rsp)
+}
+
+// CheckIntegrityWithResponse request returning *CheckIntegrityResponse
+func (c *ClientWithResponses) CheckIntegrityWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CheckIntegrityResponse, error) {
+	rsp, err := c.CheckIntegrity(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCheckIntegrityResponse(rsp)
+}
+
+// RepairIntegrityWithResponse request returning *RepairIntegrityResponse
+func (c *ClientWithResponses) RepairIntegrityWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RepairIntegrityResponse, error) {
+	rsp, err := c.RepairIntegrity(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRepairIntegrityResponse(rsp)
+}
+
+// GetNodeWithResponse request returning *GetNodeResponse
+func (c *ClientWithResponses) GetNodeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetNodeResponse, error) {
+	rsp, err := c.GetNode(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNodeResponse(rsp)
+}
+
+// ListPeersWithResponse request returning *ListPeersResponse
+func (c *ClientWithResponses) ListPeersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListPeersResponse, error) {
+	rsp, err := c.ListPeers(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPeersResponse(rsp)
+}
+
+// ConnectPeerWithBodyWithResponse request with arbitrary body returning *ConnectPeerResponse
+func (c *ClientWithResponses) ConnectPeerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConnectPeerResponse, error) {
+	rsp, err := c.ConnectPeerWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConnectPeerResponse(rsp)
+}
+
+func (c *ClientWithResponses) ConnectPeerWithResponse(ctx context.Context, body ConnectPeerJSONRequestBody, reqEditors ...RequestEditorFn) (*ConnectPeerResponse, error) {
+	rsp, err := c.ConnectPeer(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConnectPeerResponse(rsp)
+}
+
+// DisconnectPeerWithBodyWithResponse request with arbitrary body returning *DisconnectPeerResponse
+func (c *ClientWithResponses) DisconnectPeerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DisconnectPeerResponse, error) {
+	rsp, err := c.DisconnectPeerWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDisconnectPeerResponse(rsp)
+}
+
+func (c *ClientWithResponses) DisconnectPeerWithResponse(ctx context.Context, body DisconnectPeerJSONRequestBody, reqEditors ...RequestEditorFn) (*DisconnectPeerResponse, error) {
+	rsp, err := c.DisconnectPeer(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDisconnectPeerResponse(rsp)
+}
+
+// GetPreferredPeersWithResponse request returning *GetPreferredPeersResponse
+func (c *ClientWithResponses) GetPreferredPeersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPreferredPeersResponse, error) {
+	rsp, err := c.GetPreferredPeers(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPreferredPeersResponse(rsp)
+}
+
+// SetPreferredPeersWithBodyWithResponse request with arbitrary body returning *SetPreferredPeersResponse
+func (c *ClientWithResponses) SetPreferredPeersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetPreferredPeersResponse, error) {
+	rsp, err := c.SetPreferredPeersWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetPreferredPeersResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetPreferredPeersWithResponse(ctx context.Context, body SetPreferredPeersJSONRequestBody, reqEditors ...RequestEditorFn) (*SetPreferredPeersResponse, error) {
+	rsp, err := c.SetPreferredPeers(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetPreferredPeersResponse(rsp)
+}
+
+// GetChallengeWithResponse request returning *GetChallengeResponse
+func (c *ClientWithResponses) GetChallengeWithResponse(ctx context.Context, params *GetChallengeParams, reqEditors ...RequestEditorFn) (*GetChallengeResponse, error) {
+	rsp, err := c.GetChallenge(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetChallengeResponse(rsp)
+}
+
+// SearchChannelsWithBodyWithResponse request with arbitrary body returning *SearchChannelsResponse
+func (c *ClientWithResponses) SearchChannelsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchChannelsResponse, error) {
+	rsp, err := c.SearchChannelsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchChannelsResponse(rsp)
+}
+
+func (c *ClientWithResponses) SearchChannelsWithResponse(ctx context.Context, body SearchChannelsJSONRequestBody, reqEditors ...RequestEditorFn) (*SearchChannelsResponse, error) {
+	rsp, err := c.SearchChannels(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchChannelsResponse(rsp)
+}
+
+// StreamEventsWithResponse request returning *StreamEventsResponse
+func (c *ClientWithResponses) StreamEventsWithResponse(ctx context.Context, params *StreamEventsParams, reqEditors ...RequestEditorFn) (*StreamEventsResponse, error) {
+	rsp, err := c.StreamEvents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreamEventsResponse(rsp)
+}
+
+// PutGroupWithBodyWithResponse request with arbitrary body returning *PutGroupResponse
+func (c *ClientWithResponses) PutGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutGroupResponse, error) {
+	rsp, err := c.PutGroupWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutGroupResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutGroupWithResponse(ctx context.Context, body PutGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*PutGroupResponse, error) {
+	rsp, err := c.PutGroup(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutGroupResponse(rsp)
+}
+
+// SearchGroupsWithBodyWithResponse request with arbitrary body returning *SearchGroupsResponse
+func (c *ClientWithResponses) SearchGroupsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchGroupsResponse, error) {
+	rsp, err := c.SearchGroupsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchGroupsResponse(rsp)
+}
+
+func (c *ClientWithResponses) SearchGroupsWithResponse(ctx context.Context, body SearchGroupsJSONRequestBody, reqEditors ...RequestEditorFn) (*SearchGroupsResponse, error) {
+	rsp, err := c.SearchGroups(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchGroupsResponse(rsp)
+}
+
+// DeleteGroupByIDWithResponse request returning *DeleteGroupByIDResponse
+func (c *ClientWithResponses) DeleteGroupByIDWithResponse(ctx context.Context, groupId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteGroupByIDResponse, error) {
+	rsp, err := c.DeleteGroupByID(ctx, groupId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteGroupByIDResponse(rsp)
+}
+
+// GetGroupByIDWithResponse request returning *GetGroupByIDResponse
+func (c *ClientWithResponses) GetGroupByIDWithResponse(ctx context.Context, groupId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetGroupByIDResponse, error) {
+	rsp, err := c.GetGroupByID(ctx, groupId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetGroupByIDResponse(rsp)
+}
+
+// UpdateGroupByIDWithBodyWithResponse request with arbitrary body returning *UpdateGroupByIDResponse
+func (c *ClientWithResponses) UpdateGroupByIDWithBodyWithResponse(ctx context.Context, groupId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateGroupByIDResponse, error) {
+	rsp, err := c.UpdateGroupByIDWithBody(ctx, groupId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateGroupByIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateGroupByIDWithResponse(ctx context.Context, groupId openapi_types.UUID, body UpdateGroupByIDJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateGroupByIDResponse, error) {
+	rsp, err := c.UpdateGroupByID(ctx, groupId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateGroupByIDResponse(rsp)
+}
+
+// PutChannelWithBodyWithResponse request with arbitrary body returning *PutChannelResponse
+func (c *ClientWithResponses) PutChannelWithBodyWithResponse(ctx context.Context, groupId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutChannelResponse, error) {
+	rsp, err := c.PutChannelWithBody(ctx, groupId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutChannelResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutChannelWithResponse(ctx context.Context, groupId openapi_types.UUID, body PutChannelJSONRequestBody, reqEditors ...RequestEditorFn) (*PutChannelResponse, error) {
+	rsp, err := c.PutChannel(ctx, groupId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutChannelResponse(rsp)
+}
+
+// DeleteChannelByIDWithResponse request returning *DeleteChannelByIDResponse
+func (c *ClientWithResponses) DeleteChannelByIDWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteChannelByIDResponse, error) {
+	rsp, err := c.DeleteChannelByID(ctx, groupId, channelId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteChannelByIDResponse(rsp)
+}
+
+// GetChannelByIDWithResponse request returning *GetChannelByIDResponse
+func (c *ClientWithResponses) GetChannelByIDWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetChannelByIDResponse, error) {
+	rsp, err := c.GetChannelByID(ctx, groupId, channelId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetChannelByIDResponse(rsp)
+}
+
+// UpdateChannelByIDWithBodyWithResponse request with arbitrary body returning *UpdateChannelByIDResponse
+func (c *ClientWithResponses) UpdateChannelByIDWithBodyWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateChannelByIDResponse, error) {
+	rsp, err := c.UpdateChannelByIDWithBody(ctx, groupId, channelId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateChannelByIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateChannelByIDWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, body UpdateChannelByIDJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateChannelByIDResponse, error) {
+	rsp, err := c.UpdateChannelByID(ctx, groupId, channelId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateChannelByIDResponse(rsp)
+}
+
+// PutMessageWithBodyWithResponse request with arbitrary body returning *PutMessageResponse
+func (c *ClientWithResponses) PutMessageWithBodyWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutMessageResponse, error) {
+	rsp, err := c.PutMessageWithBody(ctx, groupId, channelId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutMessageResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutMessageWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, body PutMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*PutMessageResponse, error) {
+	rsp, err := c.PutMessage(ctx, groupId, channelId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutMessageResponse(rsp)
+}
+
+// DeleteMessageByIDWithResponse request returning *DeleteMessageByIDResponse
+func (c *ClientWithResponses) DeleteMessageByIDWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteMessageByIDResponse, error) {
+	rsp, err := c.DeleteMessageByID(ctx, groupId, channelId, messageId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteMessageByIDResponse(rsp)
+}
+
+// GetMessageByIDWithResponse request returning *GetMessageByIDResponse
+func (c *ClientWithResponses) GetMessageByIDWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetMessageByIDResponse, error) {
+	rsp, err := c.GetMessageByID(ctx, groupId, channelId, messageId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMessageByIDResponse(rsp)
+}
+
+// UpdateMessageByIDWithBodyWithResponse request with arbitrary body returning *UpdateMessageByIDResponse
+func (c *ClientWithResponses) UpdateMessageByIDWithBodyWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMessageByIDResponse, error) {
+	rsp, err := c.UpdateMessageByIDWithBody(ctx, groupId, channelId, messageId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMessageByIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateMessageByIDWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID, body UpdateMessageByIDJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMessageByIDResponse, error) {
+	rsp, err := c.UpdateMessageByID(ctx, groupId, channelId, messageId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMessageByIDResponse(rsp)
+}
+
+// RemoveGroupMemberWithResponse request returning *RemoveGroupMemberResponse
+func (c *ClientWithResponses) RemoveGroupMemberWithResponse(ctx context.Context, groupId openapi_types.UUID, memberId openapi_types.UUID, reqEditors ...RequestEditorFn) (*RemoveGroupMemberResponse, error) {
+	rsp, err := c.RemoveGroupMember(ctx, groupId, memberId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveGroupMemberResponse(rsp)
+}
+
+// AddGroupMemberWithResponse request returning *AddGroupMemberResponse
+func (c *ClientWithResponses) AddGroupMemberWithResponse(ctx context.Context, groupId openapi_types.UUID, memberId openapi_types.UUID, reqEditors ...RequestEditorFn) (*AddGroupMemberResponse, error) {
+	rsp, err := c.AddGroupMember(ctx, groupId, memberId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddGroupMemberResponse(rsp)
+}
+
+// RevokeGroupRoleWithResponse request returning *RevokeGroupRoleResponse
+func (c *ClientWithResponses) RevokeGroupRoleWithResponse(ctx context.Context, groupId openapi_types.UUID, memberId openapi_types.UUID, reqEditors ...RequestEditorFn) (*RevokeGroupRoleResponse, error) {
+	rsp, err := c.RevokeGroupRole(ctx, groupId, memberId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeGroupRoleResponse(rsp)
+}
+
+// AssignGroupRoleWithBodyWithResponse request with arbitrary body returning *AssignGroupRoleResponse
+func (c *ClientWithResponses) AssignGroupRoleWithBodyWithResponse(ctx context.Context, groupId openapi_types.UUID, memberId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AssignGroupRoleResponse, error) {
+	rsp, err := c.AssignGroupRoleWithBody(ctx, groupId, memberId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAssignGroupRoleResponse(rsp)
+}
+
+func (c *ClientWithResponses) AssignGroupRoleWithResponse(ctx context.Context, groupId openapi_types.UUID, memberId openapi_types.UUID, body AssignGroupRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*AssignGroupRoleResponse, error) {
+	rsp, err := c.AssignGroupRole(ctx, groupId, memberId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAssignGroupRoleResponse(rsp)
+}
+
+// TransferGroupOwnershipWithResponse request returning *TransferGroupOwnershipResponse
+func (c *ClientWithResponses) TransferGroupOwnershipWithResponse(ctx context.Context, groupId openapi_types.UUID, memberId openapi_types.UUID, reqEditors ...RequestEditorFn) (*TransferGroupOwnershipResponse, error) {
+	rsp, err := c.TransferGroupOwnership(ctx, groupId, memberId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTransferGroupOwnershipResponse(rsp)
+}
+
+// GetHealthWithResponse request returning *GetHealthResponse
+func (c *ClientWithResponses) GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error) {
+	rsp, err := c.GetHealth(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHealthResponse(rsp)
+}
+
+// GetLivenessWithResponse request returning *GetLivenessResponse
+func (c *ClientWithResponses) GetLivenessWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLivenessResponse, error) {
+	rsp, err := c.GetLiveness(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLivenessResponse(rsp)
+}
+
+// GetReadinessWithResponse request returning *GetReadinessResponse
+func (c *ClientWithResponses) GetReadinessWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetReadinessResponse, error) {
+	rsp, err := c.GetReadiness(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetReadinessResponse(rsp)
+}
+
+// GetJobByIDWithResponse request returning *GetJobByIDResponse
+func (c *ClientWithResponses) GetJobByIDWithResponse(ctx context.Context, jobId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetJobByIDResponse, error) {
+	rsp, err := c.GetJobByID(ctx, jobId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetJobByIDResponse(rsp)
+}
+
+// LoginWithBodyWithResponse request with arbitrary body returning *LoginResponse
+func (c *ClientWithResponses) LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResponse, error) {
+	rsp, err := c.LoginWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginResponse(rsp)
+}
+
+func (c *ClientWithResponses) LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginResponse, error) {
+	rsp, err := c.Login(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginResponse(rsp)
+}
+
+// LogoutWithResponse request returning *LogoutResponse
+func (c *ClientWithResponses) LogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LogoutResponse, error) {
+	rsp, err := c.Logout(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLogoutResponse(rsp)
+}
+
+// SearchMessagesWithBodyWithResponse request with arbitrary body returning *SearchMessagesResponse
+func (c *ClientWithResponses) SearchMessagesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchMessagesResponse, error) {
+	rsp, err := c.SearchMessagesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchMessagesResponse(rsp)
+}
+
+func (c *ClientWithResponses) SearchMessagesWithResponse(ctx context.Context, body SearchMessagesJSONRequestBody, reqEditors ...RequestEditorFn) (*SearchMessagesResponse, error) {
+	rsp, err := c.SearchMessages(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchMessagesResponse(rsp)
+}
+
+// RefreshTokenWithBodyWithResponse request with arbitrary body returning *RefreshTokenResponse
+func (c *ClientWithResponses) RefreshTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RefreshTokenResponse, error) {
+	rsp, err := c.RefreshTokenWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRefreshTokenResponse(rsp)
+}
+
+func (c *ClientWithResponses) RefreshTokenWithResponse(ctx context.Context, body RefreshTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*RefreshTokenResponse, error) {
+	rsp, err := c.RefreshToken(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRefreshTokenResponse(rsp)
+}
+
+// ParseGetRootResponse parses an HTTP response from a GetRootWithResponse call
+func ParseGetRootResponse(rsp *http.Response) (*GetRootResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRootResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutAccountResponse parses an HTTP response from a PutAccountWithResponse call
+func ParsePutAccountResponse(rsp *http.Response) (*PutAccountResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutAccountResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Account
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
 }
 
-type GetRootResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *map[string]interface{}
-}
+// ParseGetRegistrationChallengeResponse parses an HTTP response from a GetRegistrationChallengeWithResponse call
+func ParseGetRegistrationChallengeResponse(rsp *http.Response) (*GetRegistrationChallengeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRegistrationChallengeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Challenge string `json:"challenge"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetRootResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetRootResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseSearchAccountsResponse parses an HTTP response from a SearchAccountsWithResponse call
+func ParseSearchAccountsResponse(rsp *http.Response) (*SearchAccountsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PutAccountResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Account
-}
+	response := &SearchAccountsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AccountPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
 
-// Status returns HTTPResponse.Status
-func (r PutAccountResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutAccountResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseDeleteAccountByIDResponse parses an HTTP response from a DeleteAccountByIDWithResponse call
+func ParseDeleteAccountByIDResponse(rsp *http.Response) (*DeleteAccountByIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type SearchAccountsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Account
-}
+	response := &DeleteAccountByIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
 
-// Status returns HTTPResponse.Status
-func (r SearchAccountsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SearchAccountsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseGetAccountByIDResponse parses an HTTP response from a GetAccountByIDWithResponse call
+func ParseGetAccountByIDResponse(rsp *http.Response) (*GetAccountByIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteAccountByIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	response := &GetAccountByIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Account
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
 
-// Status returns HTTPResponse.Status
-func (r DeleteAccountByIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteAccountByIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseUpdateAccountByIDResponse parses an HTTP response from a UpdateAccountByIDWithResponse call
+func ParseUpdateAccountByIDResponse(rsp *http.Response) (*UpdateAccountByIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetAccountByIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Account
+	response := &UpdateAccountByIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Account
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetAccountByIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseListDeviceKeysResponse parses an HTTP response from a ListDeviceKeysWithResponse call
+func ParseListDeviceKeysResponse(rsp *http.Response) (*ListDeviceKeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	response := &ListDeviceKeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []DeviceKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAccountByIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseAddDeviceKeyResponse parses an HTTP response from a AddDeviceKeyWithResponse call
+func ParseAddDeviceKeyResponse(rsp *http.Response) (*AddDeviceKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	response := &AddDeviceKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest DeviceKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
 }
 
-type UpdateAccountByIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Account
+// ParseGetDeviceChallengeResponse parses an HTTP response from a GetDeviceChallengeWithResponse call
+func ParseGetDeviceChallengeResponse(rsp *http.Response) (*GetDeviceChallengeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDeviceChallengeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Challenge string `json:"challenge"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateAccountByIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseRevokeDeviceKeyResponse parses an HTTP response from a RevokeDeviceKeyWithResponse call
+func ParseRevokeDeviceKeyResponse(rsp *http.Response) (*RevokeDeviceKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RevokeDeviceKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateAccountByIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
 	}
-	return 0
+
+	return response, nil
 }
 
-type GetChallengeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Challenge *string `json:"challenge,omitempty"`
+// ParseCheckIntegrityResponse parses an HTTP response from a CheckIntegrityWithResponse call
+func ParseCheckIntegrityResponse(rsp *http.Response) (*CheckIntegrityResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r GetChallengeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &CheckIntegrityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetChallengeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IntegrityReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
 	}
-	return 0
-}
 
-type SearchChannelsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Channel
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r SearchChannelsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseRepairIntegrityResponse parses an HTTP response from a RepairIntegrityWithResponse call
+func ParseRepairIntegrityResponse(rsp *http.Response) (*RepairIntegrityResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SearchChannelsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &RepairIntegrityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type PutGroupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Group
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IntegrityReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r PutGroupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutGroupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type SearchGroupsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Group
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r SearchGroupsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetNodeResponse parses an HTTP response from a GetNodeWithResponse call
+func ParseGetNodeResponse(rsp *http.Response) (*GetNodeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SearchGroupsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetNodeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type DeleteGroupByIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NodeInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r DeleteGroupByIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteGroupByIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type GetGroupByIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Group
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetGroupByIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseListPeersResponse parses an HTTP response from a ListPeersWithResponse call
+func ParseListPeersResponse(rsp *http.Response) (*ListPeersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetGroupByIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &ListPeersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type UpdateGroupByIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Group
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Peer
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r UpdateGroupByIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateGroupByIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type PutChannelResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Channel
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r PutChannelResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseConnectPeerResponse parses an HTTP response from a ConnectPeerWithResponse call
+func ParseConnectPeerResponse(rsp *http.Response) (*ConnectPeerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutChannelResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &ConnectPeerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type DeleteChannelByIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
 
-// Status returns HTTPResponse.Status
-func (r DeleteChannelByIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteChannelByIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseDisconnectPeerResponse parses an HTTP response from a DisconnectPeerWithResponse call
+func ParseDisconnectPeerResponse(rsp *http.Response) (*DisconnectPeerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetChannelByIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Channel
+	response := &DisconnectPeerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetChannelByIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetPreferredPeersResponse parses an HTTP response from a GetPreferredPeersWithResponse call
+func ParseGetPreferredPeersResponse(rsp *http.Response) (*GetPreferredPeersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetChannelByIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetPreferredPeersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type UpdateChannelByIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Channel
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PreferredPeers
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r UpdateChannelByIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateChannelByIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type PutMessageResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Message
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r PutMessageResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseSetPreferredPeersResponse parses an HTTP response from a SetPreferredPeersWithResponse call
+func ParseSetPreferredPeersResponse(rsp *http.Response) (*SetPreferredPeersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutMessageResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &SetPreferredPeersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type DeleteMessageByIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PreferredPeers
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r DeleteMessageByIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteMessageByIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type GetMessageByIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Message
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetMessageByIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetChallengeResponse parses an HTTP response from a GetChallengeWithResponse call
+func ParseGetChallengeResponse(rsp *http.Response) (*GetChallengeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetMessageByIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetChallengeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type UpdateMessageByIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Message
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Challenge *string `json:"challenge,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r UpdateMessageByIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateMessageByIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type RemoveGroupMemberResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r RemoveGroupMemberResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseSearchChannelsResponse parses an HTTP response from a SearchChannelsWithResponse call
+func ParseSearchChannelsResponse(rsp *http.Response) (*SearchChannelsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RemoveGroupMemberResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &SearchChannelsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type AddGroupMemberResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ChannelPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r AddGroupMemberResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AddGroupMemberResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type GetHealthResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetHealthResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseStreamEventsResponse parses an HTTP response from a StreamEventsWithResponse call
+func ParseStreamEventsResponse(rsp *http.Response) (*StreamEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetHealthResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &StreamEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type LoginResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Token *string `json:"token,omitempty"`
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
 	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r LoginResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParsePutGroupResponse parses an HTTP response from a PutGroupWithResponse call
+func ParsePutGroupResponse(rsp *http.Response) (*PutGroupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r LoginResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &PutGroupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type SearchMessagesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Message
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Group
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
 
-// Status returns HTTPResponse.Status
-func (r SearchMessagesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SearchMessagesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-// GetRootWithResponse request returning *GetRootResponse
-func (c *ClientWithResponses) GetRootWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRootResponse, error) {
-	rsp, err := c.GetRoot(ctx, reqEditors...)
+// ParseSearchGroupsResponse parses an HTTP response from a SearchGroupsWithResponse call
+func ParseSearchGroupsResponse(rsp *http.Response) (*SearchGroupsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetRootResponse(rsp)
-}
 
-// PutAccountWithBodyWithResponse request with arbitrary body returning *PutAccountResponse
-func (c *ClientWithResponses) PutAccountWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAccountResponse, error) {
-	rsp, err := c.PutAccountWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &SearchGroupsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePutAccountResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutAccountWithResponse(ctx context.Context, body PutAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAccountResponse, error) {
-	rsp, err := c.PutAccount(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GroupPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
 	}
-	return ParsePutAccountResponse(rsp)
+
+	return response, nil
 }
 
-// SearchAccountsWithBodyWithResponse request with arbitrary body returning *SearchAccountsResponse
-func (c *ClientWithResponses) SearchAccountsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchAccountsResponse, error) {
-	rsp, err := c.SearchAccountsWithBody(ctx, contentType, body, reqEditors...)
+// ParseDeleteGroupByIDResponse parses an HTTP response from a DeleteGroupByIDWithResponse call
+func ParseDeleteGroupByIDResponse(rsp *http.Response) (*DeleteGroupByIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseSearchAccountsResponse(rsp)
-}
 
-func (c *ClientWithResponses) SearchAccountsWithResponse(ctx context.Context, body SearchAccountsJSONRequestBody, reqEditors ...RequestEditorFn) (*SearchAccountsResponse, error) {
-	rsp, err := c.SearchAccounts(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteGroupByIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseSearchAccountsResponse(rsp)
-}
 
-// DeleteAccountByIDWithResponse request returning *DeleteAccountByIDResponse
-func (c *ClientWithResponses) DeleteAccountByIDWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAccountByIDResponse, error) {
-	rsp, err := c.DeleteAccountByID(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest Job
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
 	}
-	return ParseDeleteAccountByIDResponse(rsp)
+
+	return response, nil
 }
 
-// GetAccountByIDWithResponse request returning *GetAccountByIDResponse
-func (c *ClientWithResponses) GetAccountByIDWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAccountByIDResponse, error) {
-	rsp, err := c.GetAccountByID(ctx, id, reqEditors...)
+// ParseGetGroupByIDResponse parses an HTTP response from a GetGroupByIDWithResponse call
+func ParseGetGroupByIDResponse(rsp *http.Response) (*GetGroupByIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAccountByIDResponse(rsp)
-}
 
-// UpdateAccountByIDWithBodyWithResponse request with arbitrary body returning *UpdateAccountByIDResponse
-func (c *ClientWithResponses) UpdateAccountByIDWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAccountByIDResponse, error) {
-	rsp, err := c.UpdateAccountByIDWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetGroupByIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateAccountByIDResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateAccountByIDWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateAccountByIDJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAccountByIDResponse, error) {
-	rsp, err := c.UpdateAccountByID(ctx, id, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Group
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
 	}
-	return ParseUpdateAccountByIDResponse(rsp)
+
+	return response, nil
 }
 
-// GetChallengeWithResponse request returning *GetChallengeResponse
-func (c *ClientWithResponses) GetChallengeWithResponse(ctx context.Context, params *GetChallengeParams, reqEditors ...RequestEditorFn) (*GetChallengeResponse, error) {
-	rsp, err := c.GetChallenge(ctx, params, reqEditors...)
+// ParseUpdateGroupByIDResponse parses an HTTP response from a UpdateGroupByIDWithResponse call
+func ParseUpdateGroupByIDResponse(rsp *http.Response) (*UpdateGroupByIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetChallengeResponse(rsp)
-}
 
-// SearchChannelsWithBodyWithResponse request with arbitrary body returning *SearchChannelsResponse
-func (c *ClientWithResponses) SearchChannelsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchChannelsResponse, error) {
-	rsp, err := c.SearchChannelsWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateGroupByIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseSearchChannelsResponse(rsp)
-}
 
-func (c *ClientWithResponses) SearchChannelsWithResponse(ctx context.Context, body SearchChannelsJSONRequestBody, reqEditors ...RequestEditorFn) (*SearchChannelsResponse, error) {
-	rsp, err := c.SearchChannels(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Group
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
 	}
-	return ParseSearchChannelsResponse(rsp)
+
+	return response, nil
 }
 
-// PutGroupWithBodyWithResponse request with arbitrary body returning *PutGroupResponse
-func (c *ClientWithResponses) PutGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutGroupResponse, error) {
-	rsp, err := c.PutGroupWithBody(ctx, contentType, body, reqEditors...)
+// ParsePutChannelResponse parses an HTTP response from a PutChannelWithResponse call
+func ParsePutChannelResponse(rsp *http.Response) (*PutChannelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutGroupResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutGroupWithResponse(ctx context.Context, body PutGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*PutGroupResponse, error) {
-	rsp, err := c.PutGroup(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PutChannelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePutGroupResponse(rsp)
-}
 
-// SearchGroupsWithBodyWithResponse request with arbitrary body returning *SearchGroupsResponse
-func (c *ClientWithResponses) SearchGroupsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchGroupsResponse, error) {
-	rsp, err := c.SearchGroupsWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Channel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
 	}
-	return ParseSearchGroupsResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) SearchGroupsWithResponse(ctx context.Context, body SearchGroupsJSONRequestBody, reqEditors ...RequestEditorFn) (*SearchGroupsResponse, error) {
-	rsp, err := c.SearchGroups(ctx, body, reqEditors...)
+// ParseDeleteChannelByIDResponse parses an HTTP response from a DeleteChannelByIDWithResponse call
+func ParseDeleteChannelByIDResponse(rsp *http.Response) (*DeleteChannelByIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseSearchGroupsResponse(rsp)
-}
 
-// DeleteGroupByIDWithResponse request returning *DeleteGroupByIDResponse
-func (c *ClientWithResponses) DeleteGroupByIDWithResponse(ctx context.Context, groupId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteGroupByIDResponse, error) {
-	rsp, err := c.DeleteGroupByID(ctx, groupId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteChannelByIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteGroupByIDResponse(rsp)
-}
 
-// GetGroupByIDWithResponse request returning *GetGroupByIDResponse
-func (c *ClientWithResponses) GetGroupByIDWithResponse(ctx context.Context, groupId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetGroupByIDResponse, error) {
-	rsp, err := c.GetGroupByID(ctx, groupId, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest Job
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
 	}
-	return ParseGetGroupByIDResponse(rsp)
+
+	return response, nil
 }
 
-// UpdateGroupByIDWithBodyWithResponse request with arbitrary body returning *UpdateGroupByIDResponse
-func (c *ClientWithResponses) UpdateGroupByIDWithBodyWithResponse(ctx context.Context, groupId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateGroupByIDResponse, error) {
-	rsp, err := c.UpdateGroupByIDWithBody(ctx, groupId, contentType, body, reqEditors...)
+// ParseGetChannelByIDResponse parses an HTTP response from a GetChannelByIDWithResponse call
+func ParseGetChannelByIDResponse(rsp *http.Response) (*GetChannelByIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateGroupByIDResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateGroupByIDWithResponse(ctx context.Context, groupId openapi_types.UUID, body UpdateGroupByIDJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateGroupByIDResponse, error) {
-	rsp, err := c.UpdateGroupByID(ctx, groupId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetChannelByIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateGroupByIDResponse(rsp)
-}
 
-// PutChannelWithBodyWithResponse request with arbitrary body returning *PutChannelResponse
-func (c *ClientWithResponses) PutChannelWithBodyWithResponse(ctx context.Context, groupId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutChannelResponse, error) {
-	rsp, err := c.PutChannelWithBody(ctx, groupId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Channel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
 	}
-	return ParsePutChannelResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PutChannelWithResponse(ctx context.Context, groupId openapi_types.UUID, body PutChannelJSONRequestBody, reqEditors ...RequestEditorFn) (*PutChannelResponse, error) {
-	rsp, err := c.PutChannel(ctx, groupId, body, reqEditors...)
+// ParseUpdateChannelByIDResponse parses an HTTP response from a UpdateChannelByIDWithResponse call
+func ParseUpdateChannelByIDResponse(rsp *http.Response) (*UpdateChannelByIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutChannelResponse(rsp)
-}
 
-// DeleteChannelByIDWithResponse request returning *DeleteChannelByIDResponse
-func (c *ClientWithResponses) DeleteChannelByIDWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteChannelByIDResponse, error) {
-	rsp, err := c.DeleteChannelByID(ctx, groupId, channelId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateChannelByIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteChannelByIDResponse(rsp)
-}
 
-// GetChannelByIDWithResponse request returning *GetChannelByIDResponse
-func (c *ClientWithResponses) GetChannelByIDWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetChannelByIDResponse, error) {
-	rsp, err := c.GetChannelByID(ctx, groupId, channelId, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Channel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
 	}
-	return ParseGetChannelByIDResponse(rsp)
+
+	return response, nil
 }
 
-// UpdateChannelByIDWithBodyWithResponse request with arbitrary body returning *UpdateChannelByIDResponse
-func (c *ClientWithResponses) UpdateChannelByIDWithBodyWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateChannelByIDResponse, error) {
-	rsp, err := c.UpdateChannelByIDWithBody(ctx, groupId, channelId, contentType, body, reqEditors...)
+// ParsePutMessageResponse parses an HTTP response from a PutMessageWithResponse call
+func ParsePutMessageResponse(rsp *http.Response) (*PutMessageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateChannelByIDResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateChannelByIDWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, body UpdateChannelByIDJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateChannelByIDResponse, error) {
-	rsp, err := c.UpdateChannelByID(ctx, groupId, channelId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PutMessageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateChannelByIDResponse(rsp)
-}
 
-// PutMessageWithBodyWithResponse request with arbitrary body returning *PutMessageResponse
-func (c *ClientWithResponses) PutMessageWithBodyWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutMessageResponse, error) {
-	rsp, err := c.PutMessageWithBody(ctx, groupId, channelId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
 	}
-	return ParsePutMessageResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PutMessageWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, body PutMessageJSONRequestBody, reqEditors ...RequestEditorFn) (*PutMessageResponse, error) {
-	rsp, err := c.PutMessage(ctx, groupId, channelId, body, reqEditors...)
+// ParseDeleteMessageByIDResponse parses an HTTP response from a DeleteMessageByIDWithResponse call
+func ParseDeleteMessageByIDResponse(rsp *http.Response) (*DeleteMessageByIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutMessageResponse(rsp)
-}
 
-// DeleteMessageByIDWithResponse request returning *DeleteMessageByIDResponse
-func (c *ClientWithResponses) DeleteMessageByIDWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteMessageByIDResponse, error) {
-	rsp, err := c.DeleteMessageByID(ctx, groupId, channelId, messageId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteMessageByIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteMessageByIDResponse(rsp)
-}
 
-// GetMessageByIDWithResponse request returning *GetMessageByIDResponse
-func (c *ClientWithResponses) GetMessageByIDWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetMessageByIDResponse, error) {
-	rsp, err := c.GetMessageByID(ctx, groupId, channelId, messageId, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
 	}
-	return ParseGetMessageByIDResponse(rsp)
+
+	return response, nil
 }
 
-// UpdateMessageByIDWithBodyWithResponse request with arbitrary body returning *UpdateMessageByIDResponse
-func (c *ClientWithResponses) UpdateMessageByIDWithBodyWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMessageByIDResponse, error) {
-	rsp, err := c.UpdateMessageByIDWithBody(ctx, groupId, channelId, messageId, contentType, body, reqEditors...)
+// ParseGetMessageByIDResponse parses an HTTP response from a GetMessageByIDWithResponse call
+func ParseGetMessageByIDResponse(rsp *http.Response) (*GetMessageByIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateMessageByIDResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateMessageByIDWithResponse(ctx context.Context, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID, body UpdateMessageByIDJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMessageByIDResponse, error) {
-	rsp, err := c.UpdateMessageByID(ctx, groupId, channelId, messageId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetMessageByIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateMessageByIDResponse(rsp)
-}
 
-// RemoveGroupMemberWithResponse request returning *RemoveGroupMemberResponse
-func (c *ClientWithResponses) RemoveGroupMemberWithResponse(ctx context.Context, groupId openapi_types.UUID, memberId openapi_types.UUID, reqEditors ...RequestEditorFn) (*RemoveGroupMemberResponse, error) {
-	rsp, err := c.RemoveGroupMember(ctx, groupId, memberId, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
 	}
-	return ParseRemoveGroupMemberResponse(rsp)
+
+	return response, nil
 }
 
-// AddGroupMemberWithResponse request returning *AddGroupMemberResponse
-func (c *ClientWithResponses) AddGroupMemberWithResponse(ctx context.Context, groupId openapi_types.UUID, memberId openapi_types.UUID, reqEditors ...RequestEditorFn) (*AddGroupMemberResponse, error) {
-	rsp, err := c.AddGroupMember(ctx, groupId, memberId, reqEditors...)
+// ParseUpdateMessageByIDResponse parses an HTTP response from a UpdateMessageByIDWithResponse call
+func ParseUpdateMessageByIDResponse(rsp *http.Response) (*UpdateMessageByIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseAddGroupMemberResponse(rsp)
-}
 
-// GetHealthWithResponse request returning *GetHealthResponse
-func (c *ClientWithResponses) GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error) {
-	rsp, err := c.GetHealth(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateMessageByIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetHealthResponse(rsp)
-}
 
-// LoginWithBodyWithResponse request with arbitrary body returning *LoginResponse
-func (c *ClientWithResponses) LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResponse, error) {
-	rsp, err := c.LoginWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Message
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
 	}
-	return ParseLoginResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginResponse, error) {
-	rsp, err := c.Login(ctx, body, reqEditors...)
+// ParseRemoveGroupMemberResponse parses an HTTP response from a RemoveGroupMemberWithResponse call
+func ParseRemoveGroupMemberResponse(rsp *http.Response) (*RemoveGroupMemberResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseLoginResponse(rsp)
-}
 
-// SearchMessagesWithBodyWithResponse request with arbitrary body returning *SearchMessagesResponse
-func (c *ClientWithResponses) SearchMessagesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchMessagesResponse, error) {
-	rsp, err := c.SearchMessagesWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &RemoveGroupMemberResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseSearchMessagesResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) SearchMessagesWithResponse(ctx context.Context, body SearchMessagesJSONRequestBody, reqEditors ...RequestEditorFn) (*SearchMessagesResponse, error) {
-	rsp, err := c.SearchMessages(ctx, body, reqEditors...)
+// ParseAddGroupMemberResponse parses an HTTP response from a AddGroupMemberWithResponse call
+func ParseAddGroupMemberResponse(rsp *http.Response) (*AddGroupMemberResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseSearchMessagesResponse(rsp)
-}
 
-// ParseGetRootResponse parses an HTTP response from a GetRootWithResponse call
-func ParseGetRootResponse(rsp *http.Response) (*GetRootResponse, error) {
+	response := &AddGroupMemberResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseRevokeGroupRoleResponse parses an HTTP response from a RevokeGroupRoleWithResponse call
+func ParseRevokeGroupRoleResponse(rsp *http.Response) (*RevokeGroupRoleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetRootResponse{
+	response := &RevokeGroupRoleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest map[string]interface{}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.ApplicationproblemJSONDefault = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParsePutAccountResponse parses an HTTP response from a PutAccountWithResponse call
-func ParsePutAccountResponse(rsp *http.Response) (*PutAccountResponse, error) {
+// ParseAssignGroupRoleResponse parses an HTTP response from a AssignGroupRoleWithResponse call
+func ParseAssignGroupRoleResponse(rsp *http.Response) (*AssignGroupRoleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutAccountResponse{
+	response := &AssignGroupRoleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Account
+		var dest Group
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON201 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseSearchAccountsResponse parses an HTTP response from a SearchAccountsWithResponse call
-func ParseSearchAccountsResponse(rsp *http.Response) (*SearchAccountsResponse, error) {
+// ParseTransferGroupOwnershipResponse parses an HTTP response from a TransferGroupOwnershipWithResponse call
+func ParseTransferGroupOwnershipResponse(rsp *http.Response) (*TransferGroupOwnershipResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SearchAccountsResponse{
+	response := &TransferGroupOwnershipResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Account
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Group
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseDeleteAccountByIDResponse parses an HTTP response from a DeleteAccountByIDWithResponse call
-func ParseDeleteAccountByIDResponse(rsp *http.Response) (*DeleteAccountByIDResponse, error) {
+// ParseGetHealthResponse parses an HTTP response from a GetHealthWithResponse call
+func ParseGetHealthResponse(rsp *http.Response) (*GetHealthResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteAccountByIDResponse{
+	response := &GetHealthResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Liveness
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseGetAccountByIDResponse parses an HTTP response from a GetAccountByIDWithResponse call
-func ParseGetAccountByIDResponse(rsp *http.Response) (*GetAccountByIDResponse, error) {
+// ParseGetLivenessResponse parses an HTTP response from a GetLivenessWithResponse call
+func ParseGetLivenessResponse(rsp *http.Response) (*GetLivenessResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAccountByIDResponse{
+	response := &GetLivenessResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Account
+		var dest Liveness
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseUpdateAccountByIDResponse parses an HTTP response from a UpdateAccountByIDWithResponse call
-func ParseUpdateAccountByIDResponse(rsp *http.Response) (*UpdateAccountByIDResponse, error) {
+// ParseGetReadinessResponse parses an HTTP response from a GetReadinessWithResponse call
+func ParseGetReadinessResponse(rsp *http.Response) (*GetReadinessResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateAccountByIDResponse{
+	response := &GetReadinessResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Account
+		var dest Readiness
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Readiness
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetChallengeResponse parses an HTTP response from a GetChallengeWithResponse call
-func ParseGetChallengeResponse(rsp *http.Response) (*GetChallengeResponse, error) {
+// ParseGetJobByIDResponse parses an HTTP response from a GetJobByIDWithResponse call
+func ParseGetJobByIDResponse(rsp *http.Response) (*GetJobByIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetChallengeResponse{
+	response := &GetJobByIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Challenge *string `json:"challenge,omitempty"`
-		}
+		var dest Job
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseSearchChannelsResponse parses an HTTP response from a SearchChannelsWithResponse call
-func ParseSearchChannelsResponse(rsp *http.Response) (*SearchChannelsResponse, error) {
+// ParseLoginResponse parses an HTTP response from a LoginWithResponse call
+func ParseLoginResponse(rsp *http.Response) (*LoginResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SearchChannelsResponse{
+	response := &LoginResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Channel
+		var dest TokenPair
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParsePutGroupResponse parses an HTTP response from a PutGroupWithResponse call
-func ParsePutGroupResponse(rsp *http.Response) (*PutGroupResponse, error) {
+// ParseLogoutResponse parses an HTTP response from a LogoutWithResponse call
+func ParseLogoutResponse(rsp *http.Response) (*LogoutResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutGroupResponse{
+	response := &LogoutResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Group
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.ApplicationproblemJSONDefault = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseSearchGroupsResponse parses an HTTP response from a SearchGroupsWithResponse call
-func ParseSearchGroupsResponse(rsp *http.Response) (*SearchGroupsResponse, error) {
+// ParseSearchMessagesResponse parses an HTTP response from a SearchMessagesWithResponse call
+func ParseSearchMessagesResponse(rsp *http.Response) (*SearchMessagesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SearchGroupsResponse{
+	response := &SearchMessagesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Group
+		var dest MessagePage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	}
-
-	return response, nil
-}
-
-// ParseDeleteGroupByIDResponse parses an HTTP response from a DeleteGroupByIDWithResponse call
-func ParseDeleteGroupByIDResponse(rsp *http.Response) (*DeleteGroupByIDResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
 
-	response := &DeleteGroupByIDResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
 	}
 
 	return response, nil
 }
 
-// ParseGetGroupByIDResponse parses an HTTP response from a GetGroupByIDWithResponse call
-func ParseGetGroupByIDResponse(rsp *http.Response) (*GetGroupByIDResponse, error) {
+// ParseRefreshTokenResponse parses an HTTP response from a RefreshTokenWithResponse call
+func ParseRefreshTokenResponse(rsp *http.Response) (*RefreshTokenResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetGroupByIDResponse{
+	response := &RefreshTokenResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Group
+		var dest TokenPair
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Root Endpoint
+	// (GET /)
+	GetRoot(w http.ResponseWriter, r *http.Request)
+	// Create an account, signing the challenge from /account/challenge with its key
+	// (POST /account/)
+	PutAccount(w http.ResponseWriter, r *http.Request)
+	// Get the challenge to sign to create an account
+	// (POST /account/challenge)
+	GetRegistrationChallenge(w http.ResponseWriter, r *http.Request)
+	// Search for accounts satisfying various properties.
+	// (POST /account/search)
+	SearchAccounts(w http.ResponseWriter, r *http.Request)
+	// Delete Account By ID
+	// (DELETE /account/{id})
+	DeleteAccountByID(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get Account By ID
+	// (GET /account/{id})
+	GetAccountByID(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Update Account By ID
+	// (PUT /account/{id})
+	UpdateAccountByID(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List the device keys of an account
+	// (GET /account/{id}/keys)
+	ListDeviceKeys(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Add a device key to an account, authorized by signatures of its challenge from an existing key and the new key
+	// (POST /account/{id}/keys)
+	AddDeviceKey(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get the challenge to sign to add a device key to an account
+	// (POST /account/{id}/keys/challenge)
+	GetDeviceChallenge(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Revoke a device key, ending every session that was signed in with it
+	// (DELETE /account/{id}/keys/{keyId})
+	RevokeDeviceKey(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, keyId openapi_types.UUID)
+	// Find the items that refer to something that is gone, only admins can check.
+	// (GET /admin/integrity)
+	CheckIntegrity(w http.ResponseWriter, r *http.Request)
+	// Find and repair the items that refer to something that is gone, only admins can repair.
+	// (POST /admin/integrity/repair)
+	RepairIntegrity(w http.ResponseWriter, r *http.Request)
+	// Get the id of this node and the addresses other peers can reach it on, only admins can see it.
+	// (GET /admin/node)
+	GetNode(w http.ResponseWriter, r *http.Request)
+	// List the peers this node is connected to, only admins can list them.
+	// (GET /admin/peers)
+	ListPeers(w http.ResponseWriter, r *http.Request)
+	// Dial a peer, only admins can connect.
+	// (POST /admin/peers/connect)
+	ConnectPeer(w http.ResponseWriter, r *http.Request)
+	// Close the connection on an address, or every connection to a peer given only its id, only admins can disconnect.
+	// (POST /admin/peers/disconnect)
+	DisconnectPeer(w http.ResponseWriter, r *http.Request)
+	// Get the peers this node dials whenever it starts, only admins can see them.
+	// (GET /admin/peers/preferred)
+	GetPreferredPeers(w http.ResponseWriter, r *http.Request)
+	// Replace the peers this node dials whenever it starts, only admins can change them.
+	// (PUT /admin/peers/preferred)
+	SetPreferredPeers(w http.ResponseWriter, r *http.Request)
+	// Get login challenge
+	// (GET /challenge)
+	GetChallenge(w http.ResponseWriter, r *http.Request, params GetChallengeParams)
+	// Search for channels satisfying various properties.
+	// (POST /channel/search)
+	SearchChannels(w http.ResponseWriter, r *http.Request)
+	// Stream changes to items as server-sent events.
+	// (GET /events)
+	StreamEvents(w http.ResponseWriter, r *http.Request, params StreamEventsParams)
+	// Create a group
+	// (POST /group/)
+	PutGroup(w http.ResponseWriter, r *http.Request)
+	// Search for groups satisfying various properties.
+	// (POST /group/search)
+	SearchGroups(w http.ResponseWriter, r *http.Request)
+	// Delete Group By ID
+	// (DELETE /group/{groupId})
+	DeleteGroupByID(w http.ResponseWriter, r *http.Request, groupId openapi_types.UUID)
+	// Get Group By ID
+	// (GET /group/{groupId})
+	GetGroupByID(w http.ResponseWriter, r *http.Request, groupId openapi_types.UUID)
+	// Update Group By ID
+	// (PUT /group/{groupId})
+	UpdateGroupByID(w http.ResponseWriter, r *http.Request, groupId openapi_types.UUID)
+	// Create a channel within a group
+	// (POST /group/{groupId}/channel/)
+	PutChannel(w http.ResponseWriter, r *http.Request, groupId openapi_types.UUID)
+	// Delete Channel in Group By ID
+	// (DELETE /group/{groupId}/channel/{channelId})
+	DeleteChannelByID(w http.ResponseWriter, r *http.Request, groupId openapi_types.UUID, channelId openapi_types.UUID)
+	// Get Channel in Group By ID
+	// (GET /group/{groupId}/channel/{channelId})
+	GetChannelByID(w http.ResponseWriter, r *http.Request, groupId openapi_types.UUID, channelId openapi_types.UUID)
+	// Update Channel in Group By ID
+	// (PUT /group/{groupId}/channel/{channelId})
+	UpdateChannelByID(w http.ResponseWriter, r *http.Request, groupId openapi_types.UUID, channelId openapi_types.UUID)
+	// Create a message within a channel
+	// (POST /group/{groupId}/channel/{channelId}/message)
+	PutMessage(w http.ResponseWriter, r *http.Request, groupId openapi_types.UUID, channelId openapi_types.UUID)
+	// Delete Message in Channel By ID
+	// (DELETE /group/{groupId}/channel/{channelId}/message/{messageId})
+	DeleteMessageByID(w http.ResponseWriter, r *http.Request, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID)
+	// Get Message in Channel By ID
+	// (GET /group/{groupId}/channel/{channelId}/message/{messageId})
+	GetMessageByID(w http.ResponseWriter, r *http.Request, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID)
+	// Update Message in Channel By ID
+	// (PUT /group/{groupId}/channel/{channelId}/message/{messageId})
+	UpdateMessageByID(w http.ResponseWriter, r *http.Request, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID)
+	// Remove member from a group
+	// (DELETE /group/{groupId}/members/{memberId})
+	RemoveGroupMember(w http.ResponseWriter, r *http.Request, groupId openapi_types.UUID, memberId openapi_types.UUID)
+	// Add new member to a group
+	// (POST /group/{groupId}/members/{memberId})
+	AddGroupMember(w http.ResponseWriter, r *http.Request, groupId openapi_types.UUID, memberId openapi_types.UUID)
+	// Revoke the role of a member of a group, making them a plain member
+	// (DELETE /group/{groupId}/members/{memberId}/role)
+	RevokeGroupRole(w http.ResponseWriter, r *http.Request, groupId openapi_types.UUID, memberId openapi_types.UUID)
+	// Assign a role to a member of a group
+	// (PUT /group/{groupId}/members/{memberId}/role)
+	AssignGroupRole(w http.ResponseWriter, r *http.Request, groupId openapi_types.UUID, memberId openapi_types.UUID)
+	// Transfer ownership of a group to another member, the previous owner becomes an admin
+	// (PUT /group/{groupId}/owner/{memberId})
+	TransferGroupOwnership(w http.ResponseWriter, r *http.Request, groupId openapi_types.UUID, memberId openapi_types.UUID)
+	// Health Check
+	// (GET /health)
+	GetHealth(w http.ResponseWriter, r *http.Request)
+	// Liveness Check
+	// (GET /health/live)
+	GetLiveness(w http.ResponseWriter, r *http.Request)
+	// Readiness Check
+	// (GET /health/ready)
+	GetReadiness(w http.ResponseWriter, r *http.Request)
+	// Get the status of a background job, only whoever started it can see it.
+	// (GET /jobs/{jobId})
+	GetJobByID(w http.ResponseWriter, r *http.Request, jobId openapi_types.UUID)
+	// Login using signed challenge
+	// (POST /login)
+	Login(w http.ResponseWriter, r *http.Request)
+	// End the session of the token used, revoking every token issued in it
+	// (POST /logout)
+	Logout(w http.ResponseWriter, r *http.Request)
+	// Search for messages satisfying various properties.
+	// (POST /message/search)
+	SearchMessages(w http.ResponseWriter, r *http.Request)
+	// Swap a refresh token for new tokens, each refresh token can only be used once
+	// (POST /refresh)
+	RefreshToken(w http.ResponseWriter, r *http.Request)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetRoot operation middleware
+func (siw *ServerInterfaceWrapper) GetRoot(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRoot(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutAccount operation middleware
+func (siw *ServerInterfaceWrapper) PutAccount(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutAccount(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRegistrationChallenge operation middleware
+func (siw *ServerInterfaceWrapper) GetRegistrationChallenge(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRegistrationChallenge(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r)
 }
 
-// ParseUpdateGroupByIDResponse parses an HTTP response from a UpdateGroupByIDWithResponse call
-func ParseUpdateGroupByIDResponse(rsp *http.Response) (*UpdateGroupByIDResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// SearchAccounts operation middleware
+func (siw *ServerInterfaceWrapper) SearchAccounts(w http.ResponseWriter, r *http.Request) {
 
-	response := &UpdateGroupByIDResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx := r.Context()
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Group
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SearchAccounts(w, r)
+	}))
 
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r)
 }
 
-// ParsePutChannelResponse parses an HTTP response from a PutChannelWithResponse call
-func ParsePutChannelResponse(rsp *http.Response) (*PutChannelResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// DeleteAccountByID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAccountByID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
 
-	response := &PutChannelResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx := r.Context()
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Channel
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAccountByID(w, r, id)
+	}))
 
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r)
 }
 
-// ParseDeleteChannelByIDResponse parses an HTTP response from a DeleteChannelByIDWithResponse call
-func ParseDeleteChannelByIDResponse(rsp *http.Response) (*DeleteChannelByIDResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// GetAccountByID operation middleware
+func (siw *ServerInterfaceWrapper) GetAccountByID(w http.ResponseWriter, r *http.Request) {
 
-	response := &DeleteChannelByIDResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	var err error
 
-	return response, nil
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-// ParseGetChannelByIDResponse parses an HTTP response from a GetChannelByIDWithResponse call
-func ParseGetChannelByIDResponse(rsp *http.Response) (*GetChannelByIDResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
 
-	response := &GetChannelByIDResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx := r.Context()
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Channel
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	}
+	r = r.WithContext(ctx)
 
-	return response, nil
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAccountByID(w, r, id)
+	}))
 
-// ParseUpdateChannelByIDResponse parses an HTTP response from a UpdateChannelByIDWithResponse call
-func ParseUpdateChannelByIDResponse(rsp *http.Response) (*UpdateChannelByIDResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
-	response := &UpdateChannelByIDResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	handler.ServeHTTP(w, r)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Channel
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+// UpdateAccountByID operation middleware
+func (siw *ServerInterfaceWrapper) UpdateAccountByID(w http.ResponseWriter, r *http.Request) {
 
-	}
+	var err error
 
-	return response, nil
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-// ParsePutMessageResponse parses an HTTP response from a PutMessageWithResponse call
-func ParsePutMessageResponse(rsp *http.Response) (*PutMessageResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
 
-	response := &PutMessageResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx := r.Context()
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Message
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateAccountByID(w, r, id)
+	}))
 
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r)
 }
 
-// ParseDeleteMessageByIDResponse parses an HTTP response from a DeleteMessageByIDWithResponse call
-func ParseDeleteMessageByIDResponse(rsp *http.Response) (*DeleteMessageByIDResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// ListDeviceKeys operation middleware
+func (siw *ServerInterfaceWrapper) ListDeviceKeys(w http.ResponseWriter, r *http.Request) {
 
-	response := &DeleteMessageByIDResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	var err error
 
-	return response, nil
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-// ParseGetMessageByIDResponse parses an HTTP response from a GetMessageByIDWithResponse call
-func ParseGetMessageByIDResponse(rsp *http.Response) (*GetMessageByIDResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
 
-	response := &GetMessageByIDResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx := r.Context()
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Message
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListDeviceKeys(w, r, id)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r)
 }
 
-// ParseUpdateMessageByIDResponse parses an HTTP response from a UpdateMessageByIDWithResponse call
-func ParseUpdateMessageByIDResponse(rsp *http.Response) (*UpdateMessageByIDResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// AddDeviceKey operation middleware
+func (siw *ServerInterfaceWrapper) AddDeviceKey(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
 
-	response := &UpdateMessageByIDResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx := r.Context()
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Message
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddDeviceKey(w, r, id)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r)
 }
 
-// ParseRemoveGroupMemberResponse parses an HTTP response from a RemoveGroupMemberWithResponse call
-func ParseRemoveGroupMemberResponse(rsp *http.Response) (*RemoveGroupMemberResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// GetDeviceChallenge operation middleware
+func (siw *ServerInterfaceWrapper) GetDeviceChallenge(w http.ResponseWriter, r *http.Request) {
 
-	response := &RemoveGroupMemberResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	var err error
 
-	return response, nil
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-// ParseAddGroupMemberResponse parses an HTTP response from a AddGroupMemberWithResponse call
-func ParseAddGroupMemberResponse(rsp *http.Response) (*AddGroupMemberResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
 
-	response := &AddGroupMemberResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx := r.Context()
 
-	return response, nil
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-// ParseGetHealthResponse parses an HTTP response from a GetHealthWithResponse call
-func ParseGetHealthResponse(rsp *http.Response) (*GetHealthResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	r = r.WithContext(ctx)
 
-	response := &GetHealthResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDeviceChallenge(w, r, id)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r)
 }
 
-// ParseLoginResponse parses an HTTP response from a LoginWithResponse call
-func ParseLoginResponse(rsp *http.Response) (*LoginResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// RevokeDeviceKey operation middleware
+func (siw *ServerInterfaceWrapper) RevokeDeviceKey(w http.ResponseWriter, r *http.Request) {
 
-	response := &LoginResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	var err error
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Token *string `json:"token,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
 
-	return response, nil
-}
+	// ------------- Path parameter "keyId" -------------
+	var keyId openapi_types.UUID
 
-// ParseSearchMessagesResponse parses an HTTP response from a SearchMessagesWithResponse call
-func ParseSearchMessagesResponse(rsp *http.Response) (*SearchMessagesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "keyId", mux.Vars(r)["keyId"], &keyId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyId", Err: err})
+		return
 	}
 
-	response := &SearchMessagesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx := r.Context()
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Message
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	}
+	r = r.WithContext(ctx)
 
-	return response, nil
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeDeviceKey(w, r, id, keyId)
+	}))
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Root Endpoint
-	// (GET /)
-	GetRoot(w http.ResponseWriter, r *http.Request)
-	// Create an account
-	// (POST /account/)
-	PutAccount(w http.ResponseWriter, r *http.Request)
-	// Search for accounts satisfying various properties.
-	// (POST /account/search)
-	SearchAccounts(w http.ResponseWriter, r *http.Request)
-	// Delete Account By ID
-	// (DELETE /account/{id})
-	DeleteAccountByID(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
-	// Get Account By ID
-	// (GET /account/{id})
-	GetAccountByID(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
-	// Update Account By ID
-	// (PUT /account/{id})
-	UpdateAccountByID(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
-	// Get login challenge
-	// (GET /challenge)
-	GetChallenge(w http.ResponseWriter, r *http.Request, params GetChallengeParams)
-	// Search for channels satisfying various properties.
-	// (POST /channel/search)
-	SearchChannels(w http.ResponseWriter, r *http.Request)
-	// Create a group
-	// (POST /group/)
-	PutGroup(w http.ResponseWriter, r *http.Request)
-	// Search for groups satisfying various properties.
-	// (POST /group/search)
-	SearchGroups(w http.ResponseWriter, r *http.Request)
-	// Delete Group By ID
-	// (DELETE /group/{groupId})
-	DeleteGroupByID(w http.ResponseWriter, r *http.Request, groupId openapi_types.UUID)
-	// Get Group By ID
-	// (GET /group/{groupId})
-	GetGroupByID(w http.ResponseWriter, r *http.Request, groupId openapi_types.UUID)
-	// Update Group By ID
-	// (PUT /group/{groupId})
-	UpdateGroupByID(w http.ResponseWriter, r *http.Request, groupId openapi_types.UUID)
-	// Create a channel within a group
-	// (POST /group/{groupId}/channel/)
-	PutChannel(w http.ResponseWriter, r *http.Request, groupId openapi_types.UUID)
-	// Delete Channel in Group By ID
-	// (DELETE /group/{groupId}/channel/{channelId})
-	DeleteChannelByID(w http.ResponseWriter, r *http.Request, groupId openapi_types.UUID, channelId openapi_types.UUID)
-	// Get Channel in Group By ID
-	// (GET /group/{groupId}/channel/{channelId})
-	GetChannelByID(w http.ResponseWriter, r *http.Request, groupId openapi_types.UUID, channelId openapi_types.UUID)
-	// Update Channel in Group By ID
-	// (PUT /group/{groupId}/channel/{channelId})
-	UpdateChannelByID(w http.ResponseWriter, r *http.Request, groupId openapi_types.UUID, channelId openapi_types.UUID)
-	// Create a message within a channel
-	// (POST /group/{groupId}/channel/{channelId}/message)
-	PutMessage(w http.ResponseWriter, r *http.Request, groupId openapi_types.UUID, channelId openapi_types.UUID)
-	// Delete Message in Channel By ID
-	// (DELETE /group/{groupId}/channel/{channelId}/message/{messageId})
-	DeleteMessageByID(w http.ResponseWriter, r *http.Request, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID)
-	// Get Message in Channel By ID
-	// (GET /group/{groupId}/channel/{channelId}/message/{messageId})
-	GetMessageByID(w http.ResponseWriter, r *http.Request, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID)
-	// Update Message in Channel By ID
-	// (PUT /group/{groupId}/channel/{channelId}/message/{messageId})
-	UpdateMessageByID(w http.ResponseWriter, r *http.Request, groupId openapi_types.UUID, channelId openapi_types.UUID, messageId openapi_types.UUID)
-	// Remove member from a group
-	// (DELETE /group/{groupId}/members/{memberId})
-	RemoveGroupMember(w http.ResponseWriter, r *http.Request, groupId openapi_types.UUID, memberId openapi_types.UUID)
-	// Add new member to a group
-	// (POST /group/{groupId}/members/{memberId})
-	AddGroupMember(w http.ResponseWriter, r *http.Request, groupId openapi_types.UUID, memberId openapi_types.UUID)
-	// Health Check
-	// (GET /health)
-	GetHealth(w http.ResponseWriter, r *http.Request)
-	// Login using signed challenge
-	// (POST /login)
-	Login(w http.ResponseWriter, r *http.Request)
-	// Search for messages satisfying various properties.
-	// (POST /message/search)
-	SearchMessages(w http.ResponseWriter, r *http.Request)
-}
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+	handler.ServeHTTP(w, r)
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
-
-// GetRoot operation middleware
-func (siw *ServerInterfaceWrapper) GetRoot(w http.ResponseWriter, r *http.Request) {
+// CheckIntegrity operation middleware
+func (siw *ServerInterfaceWrapper) CheckIntegrity(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -3679,7 +7613,7 @@ func (siw *ServerInterfaceWrapper) GetRoot(w http.ResponseWriter, r *http.Reques
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetRoot(w, r)
+		siw.Handler.CheckIntegrity(w, r)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -3689,8 +7623,8 @@ func (siw *ServerInterfaceWrapper) GetRoot(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r)
 }
 
-// PutAccount operation middleware
-func (siw *ServerInterfaceWrapper) PutAccount(w http.ResponseWriter, r *http.Request) {
+// RepairIntegrity operation middleware
+func (siw *ServerInterfaceWrapper) RepairIntegrity(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -3699,7 +7633,7 @@ func (siw *ServerInterfaceWrapper) PutAccount(w http.ResponseWriter, r *http.Req
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutAccount(w, r)
+		siw.Handler.RepairIntegrity(w, r)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -3709,8 +7643,8 @@ func (siw *ServerInterfaceWrapper) PutAccount(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// SearchAccounts operation middleware
-func (siw *ServerInterfaceWrapper) SearchAccounts(w http.ResponseWriter, r *http.Request) {
+// GetNode operation middleware
+func (siw *ServerInterfaceWrapper) GetNode(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -3719,7 +7653,7 @@ func (siw *ServerInterfaceWrapper) SearchAccounts(w http.ResponseWriter, r *http
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SearchAccounts(w, r)
+		siw.Handler.GetNode(w, r)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -3729,19 +7663,8 @@ func (siw *ServerInterfaceWrapper) SearchAccounts(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteAccountByID operation middleware
-func (siw *ServerInterfaceWrapper) DeleteAccountByID(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// ListPeers operation middleware
+func (siw *ServerInterfaceWrapper) ListPeers(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -3750,7 +7673,7 @@ func (siw *ServerInterfaceWrapper) DeleteAccountByID(w http.ResponseWriter, r *h
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteAccountByID(w, r, id)
+		siw.Handler.ListPeers(w, r)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -3760,20 +7683,29 @@ func (siw *ServerInterfaceWrapper) DeleteAccountByID(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// GetAccountByID operation middleware
-func (siw *ServerInterfaceWrapper) GetAccountByID(w http.ResponseWriter, r *http.Request) {
+// ConnectPeer operation middleware
+func (siw *ServerInterfaceWrapper) ConnectPeer(w http.ResponseWriter, r *http.Request) {
 
-	var err error
+	ctx := r.Context()
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ConnectPeer(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
+	handler.ServeHTTP(w, r)
+}
+
+// DisconnectPeer operation middleware
+func (siw *ServerInterfaceWrapper) DisconnectPeer(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -3781,7 +7713,7 @@ func (siw *ServerInterfaceWrapper) GetAccountByID(w http.ResponseWriter, r *http
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAccountByID(w, r, id)
+		siw.Handler.DisconnectPeer(w, r)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -3791,20 +7723,29 @@ func (siw *ServerInterfaceWrapper) GetAccountByID(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateAccountByID operation middleware
-func (siw *ServerInterfaceWrapper) UpdateAccountByID(w http.ResponseWriter, r *http.Request) {
+// GetPreferredPeers operation middleware
+func (siw *ServerInterfaceWrapper) GetPreferredPeers(w http.ResponseWriter, r *http.Request) {
 
-	var err error
+	ctx := r.Context()
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPreferredPeers(w, r)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
+	handler.ServeHTTP(w, r)
+}
+
+// SetPreferredPeers operation middleware
+func (siw *ServerInterfaceWrapper) SetPreferredPeers(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -3812,7 +7753,7 @@ func (siw *ServerInterfaceWrapper) UpdateAccountByID(w http.ResponseWriter, r *h
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateAccountByID(w, r, id)
+		siw.Handler.SetPreferredPeers(w, r)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -3876,6 +7817,68 @@ func (siw *ServerInterfaceWrapper) SearchChannels(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
+// StreamEvents operation middleware
+func (siw *ServerInterfaceWrapper) StreamEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamEventsParams
+
+	// ------------- Optional query parameter "group" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "group", r.URL.Query(), &params.Group)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "channel" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "channel", r.URL.Query(), &params.Channel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "channel", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Last-Event-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Last-Event-ID", Err: err})
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StreamEvents(w, r, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // PutGroup operation middleware
 func (siw *ServerInterfaceWrapper) PutGroup(w http.ResponseWriter, r *http.Request) {
 
@@ -4427,9 +8430,182 @@ func (siw *ServerInterfaceWrapper) AddGroupMember(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
+// RevokeGroupRole operation middleware
+func (siw *ServerInterfaceWrapper) RevokeGroupRole(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "groupId" -------------
+	var groupId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", mux.Vars(r)["groupId"], &groupId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "memberId" -------------
+	var memberId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "memberId", mux.Vars(r)["memberId"], &memberId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "memberId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeGroupRole(w, r, groupId, memberId)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AssignGroupRole operation middleware
+func (siw *ServerInterfaceWrapper) AssignGroupRole(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "groupId" -------------
+	var groupId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", mux.Vars(r)["groupId"], &groupId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "memberId" -------------
+	var memberId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "memberId", mux.Vars(r)["memberId"], &memberId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "memberId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AssignGroupRole(w, r, groupId, memberId)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TransferGroupOwnership operation middleware
+func (siw *ServerInterfaceWrapper) TransferGroupOwnership(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "groupId" -------------
+	var groupId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", mux.Vars(r)["groupId"], &groupId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "memberId" -------------
+	var memberId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "memberId", mux.Vars(r)["memberId"], &memberId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "memberId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TransferGroupOwnership(w, r, groupId, memberId)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetHealth operation middleware
 func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
 
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHealth(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetLiveness operation middleware
+func (siw *ServerInterfaceWrapper) GetLiveness(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLiveness(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReadiness operation middleware
+func (siw *ServerInterfaceWrapper) GetReadiness(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReadiness(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetJobByID operation middleware
+func (siw *ServerInterfaceWrapper) GetJobByID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "jobId" -------------
+	var jobId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "jobId", mux.Vars(r)["jobId"], &jobId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "jobId", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -4437,7 +8613,7 @@ func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Requ
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetHealth(w, r)
+		siw.Handler.GetJobByID(w, r, jobId)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -4461,6 +8637,26 @@ func (siw *ServerInterfaceWrapper) Login(w http.ResponseWriter, r *http.Request)
 	handler.ServeHTTP(w, r)
 }
 
+// Logout operation middleware
+func (siw *ServerInterfaceWrapper) Logout(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Logout(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // SearchMessages operation middleware
 func (siw *ServerInterfaceWrapper) SearchMessages(w http.ResponseWriter, r *http.Request) {
 
@@ -4481,6 +8677,20 @@ func (siw *ServerInterfaceWrapper) SearchMessages(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
+// RefreshToken operation middleware
+func (siw *ServerInterfaceWrapper) RefreshToken(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RefreshToken(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -4598,6 +8808,8 @@ func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.H
 
 	r.HandleFunc(options.BaseURL+"/account/", wrapper.PutAccount).Methods("POST")
 
+	r.HandleFunc(options.BaseURL+"/account/challenge", wrapper.GetRegistrationChallenge).Methods("POST")
+
 	r.HandleFunc(options.BaseURL+"/account/search", wrapper.SearchAccounts).Methods("POST")
 
 	r.HandleFunc(options.BaseURL+"/account/{id}", wrapper.DeleteAccountByID).Methods("DELETE")
@@ -4606,10 +8818,36 @@ func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.H
 
 	r.HandleFunc(options.BaseURL+"/account/{id}", wrapper.UpdateAccountByID).Methods("PUT")
 
+	r.HandleFunc(options.BaseURL+"/account/{id}/keys", wrapper.ListDeviceKeys).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/account/{id}/keys", wrapper.AddDeviceKey).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/account/{id}/keys/challenge", wrapper.GetDeviceChallenge).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/account/{id}/keys/{keyId}", wrapper.RevokeDeviceKey).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/admin/integrity", wrapper.CheckIntegrity).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/admin/integrity/repair", wrapper.RepairIntegrity).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/admin/node", wrapper.GetNode).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/admin/peers", wrapper.ListPeers).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/admin/peers/connect", wrapper.ConnectPeer).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/admin/peers/disconnect", wrapper.DisconnectPeer).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/admin/peers/preferred", wrapper.GetPreferredPeers).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/admin/peers/preferred", wrapper.SetPreferredPeers).Methods("PUT")
+
 	r.HandleFunc(options.BaseURL+"/challenge", wrapper.GetChallenge).Methods("GET")
 
 	r.HandleFunc(options.BaseURL+"/channel/search", wrapper.SearchChannels).Methods("POST")
 
+	r.HandleFunc(options.BaseURL+"/events", wrapper.StreamEvents).Methods("GET")
+
 	r.HandleFunc(options.BaseURL+"/group/", wrapper.PutGroup).Methods("POST")
 
 	r.HandleFunc(options.BaseURL+"/group/search", wrapper.SearchGroups).Methods("POST")
@@ -4640,58 +8878,165 @@ func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.H
 
 	r.HandleFunc(options.BaseURL+"/group/{groupId}/members/{memberId}", wrapper.AddGroupMember).Methods("POST")
 
+	r.HandleFunc(options.BaseURL+"/group/{groupId}/members/{memberId}/role", wrapper.RevokeGroupRole).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/group/{groupId}/members/{memberId}/role", wrapper.AssignGroupRole).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/group/{groupId}/owner/{memberId}", wrapper.TransferGroupOwnership).Methods("PUT")
+
 	r.HandleFunc(options.BaseURL+"/health", wrapper.GetHealth).Methods("GET")
 
+	r.HandleFunc(options.BaseURL+"/health/live", wrapper.GetLiveness).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/health/ready", wrapper.GetReadiness).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/jobs/{jobId}", wrapper.GetJobByID).Methods("GET")
+
 	r.HandleFunc(options.BaseURL+"/login", wrapper.Login).Methods("POST")
 
+	r.HandleFunc(options.BaseURL+"/logout", wrapper.Logout).Methods("POST")
+
 	r.HandleFunc(options.BaseURL+"/message/search", wrapper.SearchMessages).Methods("POST")
 
+	r.HandleFunc(options.BaseURL+"/refresh", wrapper.RefreshToken).Methods("POST")
+
 	return r
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xbW2/cuBX+KwTbhxYYz0y2TtrOm2N3XS8aIE2y6ENgLGjpzIhridSSlJOJ4f9e8KL7",
-	"jfKOxhNgX3YnJkUenu87h9+hqEcc8CTlDJiSePOIZRBBQszPiyDgGVP6ZwgyEDRVlDO8wT9LEMi1oitQ",
-	"hMZyiRc4FTwFoSiYxwMBREH4CzEjbLlI9C8cEgVniiaAF1jtU8AbLJWgbIefFpiGui98JUka65bXr9fw",
-	"j/P1+gx++Ofd2fmr8PyM/P3Vm7Pz8zdvXr8+P1+v12u8KAfPMhp2jZsKvqUx/JLSoGbMHZHw5rzziezu",
-	"Hva6c6spkyAYSaBu6k88YuiKdyzraYEF/JZRASHefMbGwmKMum3FvLfFKPzuVwiUntd5/EcaKxBtVC4Y",
-	"sn2RiohCVKKUSwUhUhypCNAdCe6BmX/+loHYoy0XiNgxJdKeCBFnaGuGR4GgCgQlbVy3gift2a9BlYM5",
-	"6JHuilREJdKoL6tIedBgYAKzwog8ACIM0RB9oSqizE4VU6kQ3yIaGlJSBYmsgd5HEvcHIgTZG5yZovHM",
-	"K61yaWy9f9lm377ReP9XlBAVRAbUVPAHGkKI8oH01PA1ISUpOwnZR66fU23uSMjbTv2RPz3anhFSrRVc",
-	"RoQx6ADsAkkwjEhASrIDmdOFoGvBs3SB1D6lAYnjPeJiRxj9BiG62yPFUxocJrHVLKqu8RoYCBKjgLMH",
-	"EJLoHhLtOIpAGDBbQ+20zV6EtmE02q3t+HeEMr88Zo1xY9z2o3LQpBXYMZ+TtPzQKlz8/ORhff/8531B",
-	"qaQpn7UNBE5f6Lvm0ahvULyXZh4WXef+bwayAUbjr1YSxAMIHdY6eciZw/STTu1UIoIUSEXZzpqy9BEx",
-	"PgIlgeQOhKw9+NlP/twuDkqzT2591y6yPZKAEzJV55Uruu3D96ApwaAxX0L4vdFcgXcGrGbJCgajvpxg",
-	"Go+ZEd7ZrbsrJ7hdvWCLBKaQ2d2LEK2bRTIVceEFwR0Pu2uAoBQbo4M8vxAar2soYxBWLLzjPAbCuuPU",
-	"Lby0vhjArfS23/MHjdZCiPnHawna8wPIB83nj37cjNKP/AFi32HeF/2ueTT+e/09SNuGNU8LLCHIBFX7",
-	"j0EEiR36LRAB4iJTkZnI/OvHfLU//e8TXtiTDDO4aS1XHimV4ic9MGVb3l7epwjQB5DqLKb3gC7e3xjO",
-	"ajJ/hEDpojlNYxoYqa4XbZWIxJvPjzgTMd7g1cOrFUkpftIBRZXJ1fZZvMC6r53o1XK9XGt/8BSY7r/B",
-	"fzN/WuCUqMgsdKX/swOTOrRvzaw3oS0QP3CusA50mXImrWd+WK+N+uFMgT28qZi7+lXaXGyPeSoIFO5u",
-	"qiD8MQsCkNIikSUJEXu8wXpq9C8WppwybYMiO+0B/I7KYIlvdeeVK1/NEnRWqK/hCm/w+0zlp0yLxvJq",
-	"TTqVgVRvHaG8F/dnAVu8wX9alYdcK3fCtcpH71hyXuiGltk6eZEwXOJqTlUig6eW718d0zyzsVDOkLQQ",
-	"bbN42YDp0uw9iLD8LKECVT5DHSwJRARRP2QfTbt7VrZgazXPCJ3bkwY8ZBej5WxKBElAuUphDMZpIVRk",
-	"cS88m7m8bf1/zUapx4hBb6UlvPG+CbD1d/0sTxJF5Xavl/1ABOWZRGViXo4y4JGGTzYr6unbDLgyf3fP",
-	"vt3fXLVI0NWjBMAkyvqKb650IecM0OFm5zbnd7pdp8O80NhYMVOHcFGB49DHxzqLN/hx3iGEnPFfiHTW",
-	"h02srFuKY7S3e2Q800Zj0c74VzbjD3m91TzN5TtQJ+vv9THT6heqIiRTCOiWQohurpo4XoPyAjHNOkC0",
-	"omkIx64e06DM0vwU+lTQnG0PcBr1oJv4UdhmDQ8L2EZZ59T2OPF0Lg8iEsfAbMXcJx8vi04tghnemIKt",
-	"JE7lnVU/fQ4dy40zveqyRt8rdBQTLRgKHyAqZQZhreDAm8+3zcCP+Y4yFFRcVyCQqQiYcmspgdClpaes",
-	"cmetfbKq0jxHSNXP6rudZY6CT0hW5W995pNVxdsGb1mV22QZYE6hhmug/Ky1VQHlDXOgbcfu8JM93HvJ",
-	"2mfEtAl1D8pfUOXY2KGryPhFpnmuLy6Lxtlw6o9J65ITikgH3mzx6I76vaOxjfij+d+NT5VjHh6ocart",
-	"HhrNvr8aq2+ceSdY5FiyjZc4tl9TouRI9Jc3/e5uNE7xdX9hcyKOXh8rcXpVNmPYDVQ1/fC126cgOFjP",
-	"+ID4QjVK9R3a97XR5tXJzo83rjYZpk5HAi7k8aA8uixeV7UEUtk0hU8JuYdc1+k95ftjViF7+0X6S/LK",
-	"w7wpIi6Hqri31VR1XYq7TbJH98Nr53dDDuz99R7Td/+SgYInM1Jw0W1NPvuYGimc9vvjYExeXFZwruWb",
-	"EbmRP0dZTwoq+dGvPYYAbzVP1B+nA3W/GJoL5/Uxs4qXwplElwG5M8SYrh7TJc/p8GZQgh2QOrNtlf0y",
-	"7MQ3zFyKeVPcibEJLPfcNFdJeQurV6zlN7U6xFrZ9Ef6PG4M5J7voFd+mecl2e9h3hS5mN8ILORied+t",
-	"uKbiZpzM/dWj++ElIt00AyKy3uMZO0S+2BPZIXzsOUyo9FhUXAcd2bMKGOeXte8qfJwia/PnKCuSeTOH",
-	"lzzuF7ZDJGw1T83MJ0M/T2OOxb3+jWIu4q2PmY69dPZE/g4o7SEKd/X4I49+j3l0Nt3Tr/2/E/Xjq/kn",
-	"RVyX8nEfqmiVo3+0RE7n/lY/LLZP2s9wFUcCEv4A7q9Ld9u5EuAfTLupUN6ZPq0A7+oxJcBrFrxQfLvJ",
-	"C2t6g8l6fZIJFWKyLI7H9grbp3KTu+Wegdfmi6LwcxEwgQnme20GX/q5cBGGQ0RoNU9hAQnDEoWXJIDL",
-	"LyeC/kUYVjAx9g3emYiAxPaji75bZf+2PUb1iYKvapXGhHZ/kFDY377Y9/4GUYmsJftGIrSzo8sIgvue",
-	"zxLMDa7uMwy9hP+Y5ufvR/Uba5LuGFGZqNxYI96fwnvdZzvsFY+69YrfA/O2pPEZjX7W51adcTjKJGU7",
-	"pN0Fof/1urwq97vE4/a+vms8leYZxUj/VZ589z6hyzyFNpntOk/xLaD3hZ6Kgqmzqv5Z2Ofbp9un/wcA",
-	"AP//Y2bvZt9GAAA=",
+	"H4sIAAAAAAAC/+x9/W/cuBHov0LoPaAtnmI7ueTa5jdfkrv6Pnp5SYp7wL3A4K5md3nWkipJ2bcX+H9/",
+	"mOGHpBWl1ca7tvNaFOjFS4oczgzni8Php2yu1pWSIK3JXn7KNJhKSQP0x1utZiWs8Z9zJS1Ii//kVVWK",
+	"ObdCydPK9fhfvxklsc3MV7Dm+K//qWGRvcz+x2kz/qlrNadh3Nvb2zwrwMy1qHC47GX2YQVMw79rMJYt",
+	"uCihyJldAfMTsQIsF6Vhhm/YzWpzkuEQflyc9nw+V7WDszvwvwxo5lvZazfKSZZnlVYVaCvciucauIXi",
+	"ktMIC6XX+K+s4BaeWLGGLM/spoLsZWasFnKZ0QKuxRxMf0pcyxVsDFMLWoPvyOyKWzbnkpVqyYRkVlEz",
+	"d8AhUMLC2uzC4msa7gfYZLcRKq41p79FgZ/D73xdldjw4sUZ/O352dkTePb32ZPnT4vnT/hfn3795Pnz",
+	"r79+8eL587Ozs7Msb1Zc16JILbbSaiFKuKzEvIOhGTfw9fPkF/XsCjZ97Lx98xMDOVcFFOztDxf/h1X1",
+	"rBRzxFgbHeyGG+bJwm6EXeUMhF2BZm+KZy9ePP17zt68ev3+nL198uzF10xp9vbJV397nuO/3r0/P2EX",
+	"ls1grtZg2sP+ybCF0MZ6ouCsJynoawNa8jV0sfm9Wkn2WiXY4TbPkH2FhiJ7+WtGSIxjdNEXUfMxjqJm",
+	"v8Hc4ryeU78VpQXdR965ZK6vYyZhWKUMYsjz0ozPr0DSn/+uQW/YQumwdMOQWAVTki1oeDbXwoIWPLEf",
+	"am2UTrO2hN/tpeuAHM5ZpeFaqNqwii8hx7mXYNtIN2yhylLdCLlkwibxvdBq3Z/uO7DNGIEZsCuzK2EY",
+	"bs+TNveO7ldR7JiAULri18C4ZMJxnZBuqlIYi6sVhels1J0bZ3uDlmItvJha8Lq02cunZ2cpabhWpg2b",
+	"YhpsrSUKDiWBcI2QrPnvYl2vaZizPFsL6f+MUwtpYQkaJzdK213i5b3S9mdduA9qaUV5ZLq0t9ou6vx5",
+	"Uf/xhyg3f2FrbueroCKuBUqTMBBODb+vebNnk/t1aO+95csELOeEcWL4ABGBgDzNmQGu56v+Pop8Mkmy",
+	"ewBSbNPadAmRyo1h3Mm5ZmPiX4avwQPX3pc4muMgdj4zIC0KBWwoubGRtXaIOFrRiAz7V4Vk36GRXadh",
+	"xby/3vkMyd1bwasVlxLKFBcYIDmwBmP4EkwQEpx9p1Vd5cxuKjHnZblhSi+5FH9AwWYbZlUl5oeyO1oQ",
+	"tdf4HUjQvGRzJa9BG7LUDFsqtgINSam7RJgniTFRTOrWR/xPXMhp6tIB48f4OEyVg+rGuRvzAXRjnHmy",
+	"bpzGHpGmn6+jRHG37/fTcRERh9FxU1nwLtpwCiVG5MouDRMxcnAN4wH40jWMX8aQhvHNO5XLliQdlGYT",
+	"KOucslcrXpYgl/DOubKDviHjM1VbRNgMGC8KJ6W4bDuDW4pwf3dqT49pJz1G3JbGJ02uuIEJ2Qa39pZr",
+	"rBajiycM7aUkJ+or5MbL2sTBu6D/sgLHtAg4+qPYndXGUct58dPt3L5g+pFXVlUHcp4PTW1CWHBfHTh5",
+	"Q4gUE7zRWulXqoAUJrllN4BuvVZymZPurbRaar4mwc/nKCEQKpAo2n/NZry49BGhLM+ueSkKsmguXXgo",
+	"Q3HMa7tSGm2sLM+EpE6XRiwlt7VGwKW6nIc9SV+YuqqURpPLLWih9EwUBUjqbC8XqpY42FzJRSnmtuXG",
+	"X1p+Rf00t3BJOi6Acc1FyWcloQpAX9ZSA5+v/E+onLTkZfaxh/M8e3MNqbDVOSmBJbA1L8DLBpSHCXNk",
+	"yFb90BgZxMT4OROGCZkzgfttczIl8lNwy9OD04B8YUFHgwbl/c+y3DBhDbrQwrCluAbJbnArCRfVKaAE",
+	"C8VJluChaL30p6Omu6xEFOmBK2UE/hmEEiBJKECH+s1q4OucVV4D/siNfUJEe3Lx2pkspl6Dx4OwHUiE",
+	"tG0npWWl4BIGsFoEOGiZLmhIqC0mrdL9MG4GEPwfsGNy19MQHkbPAMn9HkdJqzkhaSm9ZTAuC7ZSN0zE",
+	"n9obP2gB7xtlefylroqtXzwrBe+h9Y37u/nC/d309zuj9UX4pfkm/NJ85d2+1lfhl+ar8Ev4KrXrv0uz",
+	"+bln8vmK21MD+hrIzkIJZI7sPH7AwI0wjDMLxqLN6TA2vJGab6ew5RrWM9Cm8+Gv08LTH+8Sb+tr3Q9+",
+	"fd95f7M3hFZlNDtIMPDybQfxY1uLRn2nSsjSZxw4tgsgED5yDA6EkPfF6xP2k/vdbRiugUllKfoIhYtN",
+	"omRwH9NgLtjl1/ZpGkJfZupGOjm0talHbID2WhpypuQCoeCgXjqx4gP46H7eA3vo9+te+zUcxrlu7eIj",
+	"bMnH4aQT++5y0T1WD+6g0+RfunveyMDkBkSx5Xael2Mxhrp0MdSblZivWAFzUWCEFeWEXaHTrIFx3IpO",
+	"XBQKeVnYtvHgBBs6KmsK+qxVAZpbpaPMGtbHCPG5QfdhnbTJI+yISHFN/41rUIsAf58FtEfFRL2xhWf6",
+	"ehDNQzEQarzPCMjF22/fv7fc1gMn4sYiJJ4tsTOTqnDqDC1MlO1M19J4N7ALp5KlkEm/EsjnJZ7G4Vbc",
+	"MAn2RukrIZctJp4pVQKXpN+hUgOeALerACL2yhmsK+tPUd0EV1CRb7CGtdLps2P88hK0Tu3UX1abODqb",
+	"q7osSMHP8BdekDsjbNMwPL4Rfwxsr9nGgmHGKg1F8GLwk0meyRbvebx7nKWY8AK/1MJuLoypIanvGwdA",
+	"w4JsG8WMWoMlyRnMgKWSkJaeU/ykSa4ReiS79mF3PT/gF4STBWiQcxiDhQSVaK1yElAaKi4SnPIPddNx",
+	"dl0/KGKcZ7ZhGtbq2iERWISRKc3+XXPNpRUytBKSWHAwblbKeGFPEtNNsFbXZGUact+pwaxExazm0ixA",
+	"68ZMW4nlCoxlmssrKIIQLGFhKcIkTAMBcuGCSUVWBx6lw8Ke/F/ZEtpu5ssGyXkWpryMUGR51gyZFOIB",
+	"Q+NigvCJYYjQPS0kaP8MSTKlYSsQsVtdEvOFcaNz3V6z54TWQnZvuB88SyfCbT5i1Np021st4H8tjBFy",
+	"eekVZB5/CEdy4e8Qa0phP8L1Dipvs6VDRmhP187CJ9D2EQfzFcyv9g0FI56mW2BbAi1hipk5YqFIb9k1",
+	"lxu/zBvQwDzEJ7tlbWttzRwR/BQrfK9mKRuVAg8Yz5pzrYXzmISM3hUSVRboafojbK4hxORcchSl3sl4",
+	"DJVTwKbhd2ugXJDwyJlBE+g3NXN0M1ZV6EZpDPRtmIRrEgr8GmeRG0fhGawEjcdR75VKLk0rwNllAPZP",
+	"5T6ac9k5Kml28kqU+E/8ZAbYNYQX2YVTDwgdxoxNzhAeD4Wwcc0k+5y+PGHfCinMCgr8zKGGND5aAGEw",
+	"DRYkIbgCLVTUQ2gfOMF2iDgNwTbCY27StQsKzABkXI9RbMH1STLuuMMsCbiCaIoUoji5wwHL5Dhnl3b7",
+	"aPOE6OuEHrcZI/c6sDn9H7Cy6OwBisvZwKlWCNt41ucake+xOFH1O67bSeWGVRvBUtWWtnMrAt9QL018",
+	"E83zMQH4vZp5Ox5ln7K83CnpULkCbRarPKw5U3IOESw0KghbDkXrNIA+iLrHTkkFrLym9RrWLzospdla",
+	"LfxvUTtvb9kOVANSeMjv+cXhhTAgDMPjC4VGWBSYJJeQlMbRMimgnPz1EjDwGV9yIbtmVAWycFEUXUvp",
+	"/lUoMt8dVyT19o94PgMm6bVx23hWwjCMSCNoHlkJf7LhsOiQX2Ufd1HNf5VCrldSKTXn4+xRYRh/ajPo",
+	"hrvTwklia6aKTdIhbp227Rzkc+T+RLFaiWCBbFuuqQ3hF95AHwfwKx3B/EHjuDFrbnoktyHa5wccp1Dz",
+	"80c/UKw54uaLjjbHVRwm3jzM5w8RGfZbYldsOOLg4NFhD8CXHh/2yxgKXfrmncHLwW09Kh170PwTbgZv",
+	"D/m5u/lJiCgn23MKXbNKK0KnV5fUpjRbqbJwOK+0uMalrHi5cHapCTdPttjhS76+c+cMpDxrcnd6E39D",
+	"oLLYI3BwzO9h5CkX0VULeTtkPRF4lEsT8jqwBZ1UbhjHXK6tVK5pCeZbjFsbG8K5lCxEh7tSEVI88+RM",
+	"LKXSzqc13ePbA98vaqPzY5rxR/L2zltXpMg/L3bmKF7B5vIzKEikaMhIVKJ95STRjSMeXfNwdrEwbAVl",
+	"MZFejz3v7rBc3zA6obCVa9ncgIPfhUuBoFuKwxuimWUSqrd4dDt3sJ2X1+WUJHOqAi7kQo0HD4RxzkkI",
+	"UPGi0GAMGOY2XQWgDblOlJCHzJM6XoqfpWdb16UV2Kc/bCF42fhISubMOWHuZNIwFz2Jyn1i6n0fBJxx",
+	"e9VJXqJUEXk5fUURePdlOH+bCnJKMPVgyFsYThH7LSRdDLfqhsrCsLmSEubOzxik4441uw3kBsIonghr",
+	"7i21ENr1GT9SIDCRE6BooKUdH/9qml33dvhdyJnPOlW1Df+s5ZVUNzLpsosiSZiSW5DzzeV6AAFku9Go",
+	"TLsIvBYVQ/OXDjRFWQoDcyULk9MhDVO19VFAWkX4eAPdLMdC1S7R1UMkazpGILMD3LHRBPwJ0xZW8Utq",
+	"NOkzmkorq+aqHFhvbG4m8em/5q4cHhitzSJteNpLH2L482nM6txGAN0XLTkz9XyFAvtUVM9Pn56d4P+e",
+	"ndp5dfr87OzpafWsOn367PVXPyj1y8nJ6H4Zl+WhY3ItYaW4KDMsvszWbjCUFExHBMLHT106XLP2ZsF2",
+	"BUJ7aToiuz+TpjukU1MOoR81572yBdz0qhb8+d23r9hf/3b217/04Z/7hPnRfN2YWU9HAzjozhz7YEIJ",
+	"EyD0WfegqhKY5n77cRkT8dPGyY6cjhqFcgFN2oQrJJEO8FphSxgdy6Ln6cdqDX8yluocIxMZXat5OSu5",
+	"vMq2YxQXBUgrFsIXBAhnBJ5WOePlDd8Y1hoiusykfJrPUSBKY4EXu71gag0Lb0WjiewpbnsHvBDpgGwv",
+	"24VoywuyzyldOJC6dWYX825CekIBFcginWcjqsXuA9Im0SfcoNEQS4MM3KIBabUAf3SB37DwTbg5HoRc",
+	"W/NI1AjNAZcR4UiBVu9D4dNv4KyFvKzSQioeaFC7m9PdzmosjmBi0+SukbCfZvVpE3WToRIGTmJgx7Bm",
+	"+BQvjIUqmyAMeUzhFoYw+1mlNMhAFA5pcrmbC2iLO+vC03EqS0y0NegoZ3oo7T12b9h4VD245bcwH2cL",
+	"ZG7zVu52UXpvL4WxmlA07a7gdhBjOxLlY+/t64T+2OFxXCG8cyWVdpBj+P5hE+ftqIPmBOaSm3mWilor",
+	"/KoVrfaxUA2mLq27YjTbOJyir4CipW2792Zo/YCTJe33Nu+N50q2LGLHceGvkDK5pw/0s54J+/qb4Cd3",
+	"xk57QGper0ORqL4sKhUvxqx7YU3c540cd18RSwtZwO9DOVgD2acteTSsH2lJdOyswd3485vFRq2ZmjQd",
+	"w2jMfb/gPMqEBkEpvvygrkC+Teb3nTOzUto+KQUm3fH5HOlhsX+juzUsNJiV+7kXn0fmQCOvzwPweyU0",
+	"mPFLre0pmf9iui71oF3S52lm60CNcTz6wYTA1BxGwejNODJTZwyrGB4agrSkS6JJFHG1w2BT/pZnZ4F5",
+	"G6cfU/dmDMxrLezmPSoYR4ZvgGvQ57Vd4V8z+uvbgN3vf/mQ+RJmxIHU2kC3srZyhdLEYBTsHRjknytg",
+	"528vonnyHuZWadYq14aLdrfJTPby109ZrcvsZXZ6/fSUVyK7/Rjt8sx9m+UZ9nUTPUWnEvGvKpDY/2X2",
+	"Ff2UZ5guTQs9xf9bAnEb8iHNelG4Ej7vlLKEz1aFuWdnZ873SVaX61eV20Z3727V+5pYwPlHXvynDYAI",
+	"R6scXZ6Zer3mepO9zBBc9kYWlRIS4bZ8iVjLfhJmfpJ9xM6nXv3Ssitlttb9GnVqbcOhUr6Fkk6TZ85v",
+	"/FHWZISMmTatA60EpnxT9E1daP8ka+8Cq2u47ZHs6cEgnABe1LjGUXZRlydI3ednf7/vuoTRBhPGHeuc",
+	"fD6beSGRvfz1Y5vpXuFqoWXZ5RTyD8nbTbSf7OLIf83vJFP9sWKLaQOiu2wbPxvmX9y3KWO1x80jHY/B",
+	"26MW9C76tWzodqXBeJw7ZRPsJ7d6V/UbvO97nNJ8nFA/vXW/2jof+v9363zX1FPyK7aKNk9D19a+2rk3",
+	"nP0/vDHeU7v/1vS2Q6/5GJugWyRyRIi6xaAUqbjma7A+lH5YJp8AKqXMJAD935Qaht/51OUo7MvNyQGU",
+	"uSNHtwqm4VaYxQaxcs21S8aK2/RkJ4N8EsWtM8cQ5D6DvKbf/bffbC5e93gk1aOhD1loW5HL1626g02K",
+	"7QnVFslekh0WLmq/dAcUXQrnLWodOrkEzcct9nme8HZaVV1jkvfd6etQGasJfrNhF6+TFMz75qlXc2OU",
+	"6jXvR6Yl2EdLo7P7NObIOjEVzDF+XmCdgwPQHgX/FMJXdYLwLrdsjPapHvuR3+WNPyYOOJoqcsg6rLtx",
+	"LxzqAC8YPyanukkmMOu2njnFBJ2kb408/KMwNiZw9U2RXvN+3EsVj21Tyvzz+PjYgumuFdPTZmuT+Wa2",
+	"nIVDsMOPAbNb0+ywUvMBi/S8KJoFbjPBVuN+LEApf6uQAXhEBjhKDKRF9TSVm8TG+41+TAOMTvO5cVce",
+	"D8F250XB+HZSZyvg0BTTwxOPmJ1nQsLyVhCCy04eYYxZ+4TN6fItHY5I1rLz88+5ZAqrzM0gVmNs86qv",
+	"M9dkRrqIOh4GuCMyXJxb5kx1kyS7qZFbgYKtJbrbVz2DcqsUaMqo7Hf5j9mYA4VSD7JFv+DYzAFs4cEg",
+	"CB/d+Hts1E9XsLkY937fwbW6gmF91G/fj/M1fR+Z/1hmSZ4GxCPPATEwM+HowDZRwqH+wZekdbAcREE4",
+	"2nRYJeYc0sVUZsAYOgUPyskLUiFDAHqYm7Ag06kIxRUGjdpXWAUh1mDo8U+v+WhOw3Y9iwEJFS5Ah+o2",
+	"Y9Us7k6ib0WrEsO0aXOnKgn/LmedCk10Al3YlqbTaVOeJq2Zv3OF0GJpwu57HVwDAeGvw6g65PYJHSog",
+	"nrBXody5q0rjbudH6FHpNq8tUI9Q1bbpo4G54jX+9mlTKcYnAtzQTWyqIuYvUpcK9w3jlindXKafbdiK",
+	"yyKl2t8RJoZZs9/+2HjT1+8Z4pNQlpVuSnQr5ByIc3EGN+idmdgNM87F0qfWDoX+8I5Jyjzzvx+NfPFu",
+	"yxDdHKZal1k6tz4OZy7c6RpNnyYGINxZHqRJTIscDGm4NPJUNCO0HD9igDNNDRY0GZv+qsIBIwTbefNb",
+	"CaJ9EoSozXoCFU79UMOHXa9cB8JGTxN32o7hKrQvSdze3m6bVLdTzKRXLWy1Lt8c4OQBL36FnOWehnWz",
+	"TqFBIcxOMryOfZKU6DU/VmI0gDbPhR2OIq9KZWD7bpeS5Oo4wClP1VmyrS5UxROB8KXqVVO/vk/ZhlxT",
+	"iNu5/zSkhbbuzyT0Ua/H0TTT1kwDEq93OetwGmn6RaGU8hkSfPEQaOhmEtfxhl6vUlpMVXQFaA1YZypR",
+	"BZ4GtO61BIP3SVq3cIDNlLLGal55pCUszPc7meF9khmOsNsTfHB/5zWfxYV08crw60P5wlXJHdnvwJP+",
+	"DY8xfdyJOw2lUI5ECikCQRV2mhBEK2d+OApx6JOYO4bT7jFqNpJItHXtvU2zJqVYqBbx0BGdmDQU/NyB",
+	"pKFW8zG2dPf1vDSCscMjSBpqP5P2sElD8SW2yUlDr0KJVGIQekqm7e50V/IGnSkvJEIFM27oaAU/ZLiJ",
+	"i/i0jGG4SfJGpby59h9gW8EtP2G/CLvCKAvvFTkMpba8/dNMSg/cQJE7X+9GGHByzHUxzTO44A2kpQv5",
+	"tNHDNZywD/G5HOYvGKZS/P3hjKpA+pedQ9Z/zjR448r9zptbA9E17b6/4w9o6PaYQ5mGOQgfCZpzrTd4",
+	"zzGlawnQN44+vQ3ZbRwNUdNzR37ZAWXO1wixrNZlzAZhnahWxPA6BpW3RHqoxJvwY/es6HWb77WCfhHY",
+	"fcBuCs4dA/BuGdEEE+TtB5rCEtsvNRHMK+AF6AboDotld9OZeJ3ZSYEnbvrpUpAgSN51cOtQC7fag9jf",
+	"73vkJzKRTUU3R56QcAoTNvLOQemkHTHp+KWI8OhO70pEaDiG6nNjJzBJDQ96E2IHaEO3IO7qKvu0aBbE",
+	"SqCmA6dNzWmmDX03ZNjExqPRdtiooeZHYNI0j8o8rEHjdfdkc6bPEJ/oPxdTkqDp45EU6Hb7hGNgmnhn",
+	"+rMH76Hza58djHWwyvuACxyPyrYKXLdCDz5zp7E6wuGbU+LOatuqGG+SFeNzX5mUCcvsSqt6uYrVrsnA",
+	"cnqU1v+jGqkG4R9P8NU9VFOl3Z/MuWncpeBh3Xt7sOxxJyO2szID5w9njg+z91bjPrw9nDP+SBj77L50",
+	"39GSxnfReyRhfJjk/fZ9qD6aKj6F8A+UzdV+gurLsq9C4vfyeLzmptjBbgnFGsNKo5b0q+ha9Wzppmkf",
+	"Hlzzqya1YqH0l8eNYd0jwa2H5MUJ4D2SW8/nkQ+aImKiYLx01aYoSdb4AodDVshB/ZQ2OE2J/8Gw29CG",
+	"+uT/Mcl69UOO2K/dHvtbsM1u02p9xO02kM0YZt9lUUekHTir8V5M5Fau2LiR/F+LuLGIgzgSckB7Ndtt",
+	"2Dwe2z+95j1N5Mezc4bt9WNtm7P7VEhHM8L3YrERi3yMy1I99rfKHw+vjXoJB2S3o1lmw57CI7fPgrdw",
+	"1G3hJtlnZ0y0dU7XzStKg/5EeFsk4U80Tf8V0/e7bwLmEyzpmx50x0wA79F4NH4PTPJoAuMod+x+Dw5O",
+	"GzpycJqT01iAzHXZf9uffvL/mOT2+GlG3J5uj89QqGGxj0ShToHnMFJiAKIw/y4VH8l4/OtlP7V3S1vR",
+	"HaF6S5hLyKj7tlVew/vD/sYY4/aa91Vkj4ZlJwJzX/w6rFePxaxn96m9jub+7MnzIw7QGNunevxXXn+J",
+	"8vpopuWwS/aFGJjHdMX22qUpq8zfcT395P7RM8CSurd7NOW+9Be7FNOAF139ryfZbXvLu1uq2E6O40/h",
+	"Of/te6r9HvsIhQ4EDyQT/OQRmsEN6LC+FwgtZpZ1We7SSa5P++bANnpG8qyamj5+1+zBCXTlGhNkB3nh",
+	"vCjGGKHXvA8X8KJoqPCQDOBl0iOhPhbZaWhC8E1IshsTGKdaldCVGqmSGzToO+yaLrnRbp9O51VkZPSX",
+	"5cNu9Fj5AzEyXPrjs8j+WU4SovNIRThay6ToAGGB/k3IzvG03leHpndSSi6k7zY9p+XcGLGUw5zTb/8i",
+	"OYcbVwknoHRQXh2Oc46UaYNUcERZD+SGv3ML9GvOmbqRoM1KVJ2iWe4eh79jYTWXZuHe2XmciTkNM/3J",
+	"kHRFMh6kJJrjDB74IrXVJgpuQvSWnZfcdB88ummwnwN5entvsNs+SrpN2ofafZRWhBQkDN2vzH4YdhXW",
+	"calf8N3ZNPBCazc3/Els69+YdmjMwwua15TYTR+xGczVGoy7Db8WcoCtV8BL91xJvLBWaaA3uQJBEs8V",
+	"UXF995gZPmcjwRhXZShnV1BZd5WuFLhYZxHPYKFc0oN2GpQzHd69a+oT9WJ4/3DQHTEm9KMHfyidJFTh",
+	"MKDpNWov6s3Bb6O6pTKqdzXwComj1SlifPCGIb2LAoVJ3FNPrCLHbsRJStPrceFi4Ywb6Lyb1CNNRNx/",
+	"AnECIFPIE9+qStLHFXAy3Zcah5/7ogx+tdh6NrCWhSPs2iUvdd+GpGuYvn5bfBRr+x2uzkNZrTey8v4L",
+	"hUqWQrZeHMHPqDOflb6KFEjKhWpqK8SyHw5Ahxq30V0HJBleB12JEtrrpzYrypKAFXKZ+6U4gecpjGBx",
+	"aW5AQ8FenH3FamlF6Wo2oJu8fQE0PlziRc4xmbaZZAfXOka5zbMXZ1/d/+yttyKd9qBdYJjh+Gzk5uBb",
+	"KAI2uod+UzNz+uk3NfMW1dDZz/dqNnTu0zRNsJxC9l7aSiE4Hu1xxkgSJKYSRkv6GMcZ3exF3q6e8pua",
+	"+cIYNytFGsi/24piJl01DFfi6E/1GNJpK1QUjJo/3+Hq1qyIJY8bSvKRohXdpy0nVbS4v/uGzcODKZZQ",
+	"9A5fUx/Z37T35T0Pry6RTKw2qJN9xdDJJTZKtVS1HWUBbJ8SrnnvlsdAFgcpuvsmvLDsx/Xyw9UrqA0U",
+	"uQsMNfVTXZPHOz3jvmv5IYVj2jVcfxgxdBG31XzEE6Xhy7i+wyO4jusheQQXcmM1hclXcrsnTv6ZyuEN",
+	"8s51+BCftTyEqOy9/jleH7vb/eOXJRtt8HCPJR7f3/CKHOD2Y684c/Nmau6Ksna79Mrgo6U/KlC6QHQf",
+	"KP314+3H2/83AByH1WYXwgAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -1,28 +1,48 @@
 package v1
 
 import (
+	"Sector/internal/auth"
+	"Sector/internal/config"
 	"Sector/internal/database"
 	"Sector/internal/logger"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"path/filepath"
 	"reflect"
 	"slices"
+	"sync"
 	"testing"
 	"time"
 
 	orbitdb "berty.tech/go-orbit-db"
 	"berty.tech/go-orbit-db/iface"
+	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime/types"
 	"go.uber.org/zap"
 )
 
 type SectorAPI struct {
-	Logger *zap.Logger
-	DB     *database.Database
+	Logger     *zap.Logger
+	DB         *database.Database
+	Events     *EventBroker
+	Gateway    *Gateway
+	Auth       *auth.Service       // Issues and validates the tokens of the node
+	Challenges auth.ChallengeStore // The login and registration challenges that have not been answered yet
+	Jobs       *Jobs               // Deletions of groups and channels, run in the background
+	Admins     []types.UUID        // The accounts that can use the admin endpoints
+	MinPeers   int                 // How many peers have to be connected for the node to be ready
+
+	connectMu sync.Mutex
+	connected bool // Whether Connect has finished
+	closed    bool // Whether Close has been called, after which Connect disconnects rather than finishing
 }
 
+// ErrClosed is given by Connect if the API was closed before it could finish
+var ErrClosed = errors.New("closed while connecting")
+
 //#region Authentication API
 
 //#endregion Authentication API
@@ -33,43 +53,85 @@ type SectorAPI struct {
 func (s *SectorAPI) SearchAccounts(w http.ResponseWriter, r *http.Request) {
 	var filter AccountFilter
 	if err := json.NewDecoder(r.Body).Decode(&filter); err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "Could not parse request body.", http.StatusBadRequest)
+		s.log(r).Debug("Could not parse request body", zap.Error(err))
+		writeProblem(w, http.StatusBadRequest, BadRequest, "Could not parse request body.")
 		return
 	}
 
-	accounts, err := searchItem(s.DB.Store, reflect.TypeOf(Account{}), StructToMap(filter))
+	accounts, err := searchItem(r.Context(), s.DB, reflect.TypeOf(Account{}), StructToMap(filter))
 	if err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "Could not perform database query.", http.StatusInternalServerError)
+		s.fail(w, r, err, "Could not perform database query.")
 		return
 	}
 
-	// Filter out everything that is not an account
+	page, err := paginate(accounts, filter.Limit, filter.Cursor, filter.Sort)
+	if err != nil {
+		s.log(r).Debug("Could not parse cursor", zap.Error(err))
+		writeProblem(w, http.StatusBadRequest, BadRequest, "Could not parse cursor.")
+		return
+	}
 
-	w.WriteHeader(http.StatusOK)
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(accounts)
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(page)
 }
 
 // PutAccount implements ServerInterface.
 func (s *SectorAPI) PutAccount(w http.ResponseWriter, r *http.Request) {
-	var accountDetails Account
-	if err := json.NewDecoder(r.Body).Decode(&accountDetails); err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "Could not parse request body.", http.StatusBadRequest)
+	var newAccount NewAccount
+	if err := json.NewDecoder(r.Body).Decode(&newAccount); err != nil {
+		s.log(r).Debug("Could not parse request body", zap.Error(err))
+		writeProblem(w, http.StatusBadRequest, BadRequest, "Could not parse request body.")
 		return
 	}
 
-	if accountDetails.CreatedAt == nil {
-		var now = time.Now()
-		accountDetails.CreatedAt = &now
+	// Check the key now, rather than when it is first used to log in
+	if _, err := auth.ParsePublicKey(newAccount.Pubkey); err != nil {
+		s.log(r).Debug("Unsupported public key", zap.Error(err))
+		writeProblem(w, http.StatusBadRequest, UnsupportedKey, "Unsupported public key, use an Ed25519, ECDSA P-256 or P-384, or RSA key.")
+		return
+	}
+
+	// The creator has to prove they hold the key by signing the challenge issued for it
+	key := registrationKey(newAccount.Username, newAccount.Pubkey)
+	challenges := s.Challenges.Get(key)
+	if len(challenges) == 0 {
+		writeProblem(w, http.StatusBadRequest, NoChallenge, "No active challenge found, please request a new one.")
+		return
+	}
+	challenge, _, err := answeredChallenge([]DeviceKey{{Pubkey: newAccount.Pubkey}}, challenges, newAccount.Signature)
+	if err != nil {
+		s.log(r).Debug("Invalid signature", zap.Error(err))
+		writeProblem(w, http.StatusUnauthorized, InvalidSignature, "Invalid signature.")
+		return
+	}
+	s.Challenges.Remove(key, challenge)
+
+	var now = time.Now()
+	accountDetails := Account{
+		Id:         newAccount.Id,
+		CreatedAt:  &now,
+		Username:   newAccount.Username,
+		ProfilePic: newAccount.ProfilePic,
+		Pubkey:     newAccount.Pubkey,
 	}
 
-	newItem, err := addItem(s.DB.Store, accountDetails)
+	// The key the account is created with is its first device, more are added through their own endpoint
+	accountDetails.Devices = &[]DeviceKey{{
+		Id:      uuid.New(),
+		Name:    defaultDeviceName,
+		Pubkey:  accountDetails.Pubkey,
+		AddedAt: *accountDetails.CreatedAt,
+	}}
+
+	newItem, err := addItem(r.Context(), s.DB, accountDetails)
+	if errors.Is(err, database.ErrConflict) {
+		s.log(r).Debug("Username is taken", zap.Error(err))
+		writeProblem(w, http.StatusConflict, UsernameTaken, "Username is taken.")
+		return
+	}
 	if err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "", http.StatusInternalServerError)
+		s.fail(w, r, err, "Could not add to database.")
 		return
 	}
 	w.WriteHeader(http.StatusCreated)
@@ -79,17 +141,29 @@ func (s *SectorAPI) PutAccount(w http.ResponseWriter, r *http.Request) {
 
 // UpdateAccountByID implements ServerInterface.
 func (s *SectorAPI) UpdateAccountByID(w http.ResponseWriter, r *http.Request, id types.UUID) {
+	caller, err := callerOf(r)
+	if s.denied(w, r, err) {
+		return
+	}
+	if s.denied(w, r, authorizeAccount(caller, id)) {
+		return
+	}
+
 	var updateDetails AccountUpdate
 	if err := json.NewDecoder(r.Body).Decode(&updateDetails); err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "Could not parse request body.", http.StatusBadRequest)
+		s.log(r).Debug("Could not parse request body", zap.Error(err))
+		writeProblem(w, http.StatusBadRequest, BadRequest, "Could not parse request body.")
 		return
 	}
 
-	newItem, err := updateItem(s.DB.Store, id, updateDetails)
+	newItem, err := updateItem(r.Context(), s.DB, reflect.TypeOf(Account{}), id, updateDetails)
+	if errors.Is(err, database.ErrConflict) {
+		s.log(r).Debug("Username is taken", zap.Error(err))
+		writeProblem(w, http.StatusConflict, UsernameTaken, "Username is taken.")
+		return
+	}
 	if err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "", http.StatusInternalServerError)
+		s.fail(w, r, err, "Could not update within database.")
 		return
 	}
 	w.WriteHeader(http.StatusCreated)
@@ -99,10 +173,17 @@ func (s *SectorAPI) UpdateAccountByID(w http.ResponseWriter, r *http.Request, id
 
 // DeleteAccountByID implements ServerInterface.
 func (s *SectorAPI) DeleteAccountByID(w http.ResponseWriter, r *http.Request, id types.UUID) {
-	err := removeItem(s.DB.Store, id)
+	caller, err := callerOf(r)
+	if s.denied(w, r, err) {
+		return
+	}
+	if s.denied(w, r, authorizeAccount(caller, id)) {
+		return
+	}
+
+	err = removeItem(r.Context(), s.DB, reflect.TypeOf(Account{}), id)
 	if err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "Could not delete within database.", http.StatusInternalServerError)
+		s.fail(w, r, err, "Could not delete within database.")
 		return
 	}
 	w.WriteHeader(http.StatusNoContent)
@@ -110,10 +191,9 @@ func (s *SectorAPI) DeleteAccountByID(w http.ResponseWriter, r *http.Request, id
 
 // GetAccountByID implements ServerInterface.
 func (s *SectorAPI) GetAccountByID(w http.ResponseWriter, r *http.Request, id types.UUID) {
-	account, err := getItem(s.DB.Store, id)
+	account, err := getItem(r.Context(), s.DB, reflect.TypeOf(Account{}), id)
 	if err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "Could not get within database.", http.StatusInternalServerError)
+		s.fail(w, r, err, "Could not get within database.")
 		return
 	}
 	w.WriteHeader(http.StatusOK)
@@ -129,29 +209,40 @@ func (s *SectorAPI) GetAccountByID(w http.ResponseWriter, r *http.Request, id ty
 func (s *SectorAPI) SearchGroups(w http.ResponseWriter, r *http.Request) {
 	var filter GroupFilter
 	if err := json.NewDecoder(r.Body).Decode(&filter); err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "Could not parse request body.", http.StatusBadRequest)
+		s.log(r).Debug("Could not parse request body", zap.Error(err))
+		writeProblem(w, http.StatusBadRequest, BadRequest, "Could not parse request body.")
 		return
 	}
 
-	groups, err := searchItem(s.DB.Store, reflect.TypeOf(Group{}), StructToMap(filter))
+	groups, err := searchItem(r.Context(), s.DB, reflect.TypeOf(Group{}), StructToMap(filter))
 	if err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "Could not perform database query.", http.StatusInternalServerError)
+		s.fail(w, r, err, "Could not perform database query.")
+		return
+	}
+
+	page, err := paginate(groups, filter.Limit, filter.Cursor, filter.Sort)
+	if err != nil {
+		s.log(r).Debug("Could not parse cursor", zap.Error(err))
+		writeProblem(w, http.StatusBadRequest, BadRequest, "Could not parse cursor.")
 		return
 	}
 
-	w.WriteHeader(http.StatusOK)
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(groups)
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(page)
 }
 
 // PutGroup implements ServerInterface.
 func (s *SectorAPI) PutGroup(w http.ResponseWriter, r *http.Request) {
+	caller, err := callerOf(r)
+	if s.denied(w, r, err) {
+		return
+	}
+
 	var groupDetails Group
 	if err := json.NewDecoder(r.Body).Decode(&groupDetails); err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "Could not parse request body.", http.StatusBadRequest)
+		s.log(r).Debug("Could not parse request body", zap.Error(err))
+		writeProblem(w, http.StatusBadRequest, BadRequest, "Could not parse request body.")
 		return
 	}
 
@@ -160,10 +251,19 @@ func (s *SectorAPI) PutGroup(w http.ResponseWriter, r *http.Request) {
 		groupDetails.CreatedAt = &now
 	}
 
-	newItem, err := addItem(s.DB.Store, groupDetails)
+	// Whoever creates a group is a member of it, and its owner
+	if !slices.Contains(groupDetails.Members, caller) {
+		groupDetails.Members = append(groupDetails.Members, caller)
+	}
+	groupDetails.Roles = &map[string]GroupRole{caller.String(): Owner}
+	if err := requireAccounts(s.DB, groupDetails.Members); err != nil {
+		s.fail(w, r, err, "Could not add to database.")
+		return
+	}
+
+	newItem, err := addItem(r.Context(), s.DB, groupDetails)
 	if err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "", http.StatusInternalServerError)
+		s.fail(w, r, err, "Could not add to database.")
 		return
 	}
 	w.WriteHeader(http.StatusCreated)
@@ -173,17 +273,24 @@ func (s *SectorAPI) PutGroup(w http.ResponseWriter, r *http.Request) {
 
 // UpdateGroupByID implements ServerInterface.
 func (s *SectorAPI) UpdateGroupByID(w http.ResponseWriter, r *http.Request, groupId types.UUID) {
+	caller, err := callerOf(r)
+	if s.denied(w, r, err) {
+		return
+	}
+	if _, err := authorizeGroupPermission(r.Context(), s.DB, caller, groupId, UpdateGroup); s.denied(w, r, err) {
+		return
+	}
+
 	var updateDetails GroupUpdate
 	if err := json.NewDecoder(r.Body).Decode(&updateDetails); err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "Could not parse request body.", http.StatusBadRequest)
+		s.log(r).Debug("Could not parse request body", zap.Error(err))
+		writeProblem(w, http.StatusBadRequest, BadRequest, "Could not parse request body.")
 		return
 	}
 
-	newItem, err := updateItem(s.DB.Store, groupId, updateDetails)
+	newItem, err := updateItem(r.Context(), s.DB, reflect.TypeOf(Group{}), groupId, updateDetails)
 	if err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "", http.StatusInternalServerError)
+		s.fail(w, r, err, "Could not update within database.")
 		return
 	}
 	w.WriteHeader(http.StatusCreated)
@@ -193,21 +300,27 @@ func (s *SectorAPI) UpdateGroupByID(w http.ResponseWriter, r *http.Request, grou
 
 // DeleteGroupByID implements ServerInterface.
 func (s *SectorAPI) DeleteGroupByID(w http.ResponseWriter, r *http.Request, id types.UUID) {
-	err := removeItem(s.DB.Store, id)
+	caller, err := callerOf(r)
+	if s.denied(w, r, err) {
+		return
+	}
+	if _, err := authorizeGroupPermission(r.Context(), s.DB, caller, id, DeleteGroup); s.denied(w, r, err) {
+		return
+	}
+
+	job, err := s.Jobs.StartDeletion(reflect.TypeOf(Group{}), id, caller)
 	if err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "Could not delete within database.", http.StatusInternalServerError)
+		s.fail(w, r, err, "Could not start deleting within database.")
 		return
 	}
-	w.WriteHeader(http.StatusNoContent)
+	writeJob(w, job)
 }
 
 // GetGroupByID implements ServerInterface.
 func (s *SectorAPI) GetGroupByID(w http.ResponseWriter, r *http.Request, id types.UUID) {
-	group, err := getItem(s.DB.Store, id)
+	group, err := getItem(r.Context(), s.DB, reflect.TypeOf(Group{}), id)
 	if err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "Could not get within database.", http.StatusInternalServerError)
+		s.fail(w, r, err, "Could not get within database.")
 		return
 	}
 	w.WriteHeader(http.StatusOK)
@@ -217,33 +330,31 @@ func (s *SectorAPI) GetGroupByID(w http.ResponseWriter, r *http.Request, id type
 
 // AddGroupMember implements ServerInterface.
 func (s *SectorAPI) AddGroupMember(w http.ResponseWriter, r *http.Request, groupId types.UUID, memberId types.UUID) {
-	item, err := getItem(s.DB.Store, groupId)
-	if err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "Could not get within database.", http.StatusInternalServerError)
+	caller, err := callerOf(r)
+	if s.denied(w, r, err) {
 		return
 	}
 
-	var group Group
-	err = MapToStruct(item.(map[string]interface{}), &group)
-	if err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "Could not get within database.", http.StatusInternalServerError)
+	group, err := authorizeGroupPermission(r.Context(), s.DB, caller, groupId, AddMembers)
+	if s.denied(w, r, err) {
 		return
 	}
 
 	if slices.Contains(group.Members, memberId) {
-		http.Error(w, "Already a member of this group.", http.StatusInternalServerError)
+		writeProblem(w, http.StatusConflict, Conflict, "Already a member of this group.")
+		return
+	}
+
+	if err := requireAccounts(s.DB, []types.UUID{memberId}); err != nil {
+		s.fail(w, r, err, "Could not update within database.")
 		return
 	}
 
 	// Update the group by sending the new list of members
-	newItem, err := updateItem(s.DB.Store, groupId, map[string]interface{}{
-		"members": append(group.Members, memberId),
-	})
+	group.Members = append(group.Members, memberId)
+	newItem, err := updateMembership(r.Context(), s.DB, group)
 	if err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "", http.StatusInternalServerError)
+		s.fail(w, r, err, "Could not update within database.")
 		return
 	}
 	w.WriteHeader(http.StatusCreated)
@@ -253,23 +364,31 @@ func (s *SectorAPI) AddGroupMember(w http.ResponseWriter, r *http.Request, group
 
 // RemoveGroupMember implements ServerInterface.
 func (s *SectorAPI) RemoveGroupMember(w http.ResponseWriter, r *http.Request, groupId types.UUID, memberId types.UUID) {
-	item, err := getItem(s.DB.Store, groupId)
-	if err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "Could not get within database.", http.StatusInternalServerError)
+	caller, err := callerOf(r)
+	if s.denied(w, r, err) {
 		return
 	}
 
-	var group Group
-	err = MapToStruct(item.(map[string]interface{}), &group)
-	if err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "Could not get within database.", http.StatusInternalServerError)
+	group, err := authorizeGroup(r.Context(), s.DB, caller, groupId)
+	if s.denied(w, r, err) {
 		return
 	}
 
 	if !slices.Contains(group.Members, memberId) {
-		http.Error(w, "Already not a member of this group.", http.StatusInternalServerError)
+		writeProblem(w, http.StatusConflict, Conflict, "Already not a member of this group.")
+		return
+	}
+
+	// Anyone can leave a group, except its owner who has to hand it over first. Removing someone else
+	// takes the permission to, and a role above theirs.
+	role := roleOf(group, caller)
+	if memberId == caller {
+		if role == Owner {
+			writeProblem(w, http.StatusBadRequest, BadRequest, "The owner cannot leave a group without transferring it.")
+			return
+		}
+	} else if !role.Can(RemoveMembers) || !role.Outranks(roleOf(group, memberId)) {
+		s.denied(w, r, ErrForbidden)
 		return
 	}
 
@@ -282,12 +401,10 @@ func (s *SectorAPI) RemoveGroupMember(w http.ResponseWriter, r *http.Request, gr
 	}
 
 	// Update the group by sending the new list of members
-	newItem, err := updateItem(s.DB.Store, groupId, map[string]interface{}{
-		"members": newMembers,
-	})
+	group.Members = newMembers
+	newItem, err := updateMembership(r.Context(), s.DB, group)
 	if err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "", http.StatusInternalServerError)
+		s.fail(w, r, err, "Could not update within database.")
 		return
 	}
 	w.WriteHeader(http.StatusNoContent)
@@ -301,31 +418,56 @@ func (s *SectorAPI) RemoveGroupMember(w http.ResponseWriter, r *http.Request, gr
 
 // SearchChannels implements ServerInterface.
 func (s *SectorAPI) SearchChannels(w http.ResponseWriter, r *http.Request) {
+	caller, err := callerOf(r)
+	if s.denied(w, r, err) {
+		return
+	}
+
 	var filter ChannelFilter
 	if err := json.NewDecoder(r.Body).Decode(&filter); err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "Could not parse request body.", http.StatusBadRequest)
+		s.log(r).Debug("Could not parse request body", zap.Error(err))
+		writeProblem(w, http.StatusBadRequest, BadRequest, "Could not parse request body.")
 		return
 	}
 
-	channels, err := searchItem(s.DB.Store, reflect.TypeOf(Channel{}), StructToMap(filter))
+	channels, err := searchItem(r.Context(), s.DB, reflect.TypeOf(Channel{}), StructToMap(filter))
 	if err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "Could not perform database query.", http.StatusInternalServerError)
+		s.fail(w, r, err, "Could not perform database query.")
+		return
+	}
+	channels = visibleItems(s.DB, caller, reflect.TypeOf(Channel{}), channels)
+
+	page, err := paginate(channels, filter.Limit, filter.Cursor, filter.Sort)
+	if err != nil {
+		s.log(r).Debug("Could not parse cursor", zap.Error(err))
+		writeProblem(w, http.StatusBadRequest, BadRequest, "Could not parse cursor.")
 		return
 	}
 
-	w.WriteHeader(http.StatusOK)
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(channels)
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(page)
 }
 
 // PutChannel implements ServerInterface.
 func (s *SectorAPI) PutChannel(w http.ResponseWriter, r *http.Request, groupId types.UUID) {
+	caller, err := callerOf(r)
+	if s.denied(w, r, err) {
+		return
+	}
+	if _, err := authorizeGroupPermission(r.Context(), s.DB, caller, groupId, ManageChannels); s.denied(w, r, err) {
+		return
+	}
+
 	var channelDetails Channel
 	if err := json.NewDecoder(r.Body).Decode(&channelDetails); err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "Could not parse request body.", http.StatusBadRequest)
+		s.log(r).Debug("Could not parse request body", zap.Error(err))
+		writeProblem(w, http.StatusBadRequest, BadRequest, "Could not parse request body.")
+		return
+	}
+
+	if channelDetails.Group != groupId {
+		writeProblem(w, http.StatusBadRequest, BadRequest, "Channel must be in the group it is created in.")
 		return
 	}
 
@@ -334,10 +476,9 @@ func (s *SectorAPI) PutChannel(w http.ResponseWriter, r *http.Request, groupId t
 		channelDetails.CreatedAt = &now
 	}
 
-	newItem, err := addItem(s.DB.Store, channelDetails)
+	newItem, err := addItem(r.Context(), s.DB, channelDetails)
 	if err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "", http.StatusInternalServerError)
+		s.fail(w, r, err, "Could not add to database.")
 		return
 	}
 	w.WriteHeader(http.StatusCreated)
@@ -347,17 +488,27 @@ func (s *SectorAPI) PutChannel(w http.ResponseWriter, r *http.Request, groupId t
 
 // UpdateChannelByID implements ServerInterface.
 func (s *SectorAPI) UpdateChannelByID(w http.ResponseWriter, r *http.Request, groupId types.UUID, channelId types.UUID) {
+	caller, err := callerOf(r)
+	if s.denied(w, r, err) {
+		return
+	}
+	if _, err := authorizeChannel(r.Context(), s.DB, caller, groupId, channelId); s.denied(w, r, err) {
+		return
+	}
+	if _, err := authorizeGroupPermission(r.Context(), s.DB, caller, groupId, ManageChannels); s.denied(w, r, err) {
+		return
+	}
+
 	var updateDetails ChannelUpdate
 	if err := json.NewDecoder(r.Body).Decode(&updateDetails); err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "Could not parse request body.", http.StatusBadRequest)
+		s.log(r).Debug("Could not parse request body", zap.Error(err))
+		writeProblem(w, http.StatusBadRequest, BadRequest, "Could not parse request body.")
 		return
 	}
 
-	newItem, err := updateItem(s.DB.Store, channelId, updateDetails)
+	newItem, err := updateItem(r.Context(), s.DB, reflect.TypeOf(Channel{}), channelId, updateDetails)
 	if err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "", http.StatusInternalServerError)
+		s.fail(w, r, err, "Could not update within database.")
 		return
 	}
 	w.WriteHeader(http.StatusCreated)
@@ -367,21 +518,38 @@ func (s *SectorAPI) UpdateChannelByID(w http.ResponseWriter, r *http.Request, gr
 
 // DeleteChannelByID implements ServerInterface.
 func (s *SectorAPI) DeleteChannelByID(w http.ResponseWriter, r *http.Request, groupId types.UUID, channelId types.UUID) {
-	err := removeItem(s.DB.Store, channelId)
+	caller, err := callerOf(r)
+	if s.denied(w, r, err) {
+		return
+	}
+	if _, err := authorizeChannel(r.Context(), s.DB, caller, groupId, channelId); s.denied(w, r, err) {
+		return
+	}
+	if _, err := authorizeGroupPermission(r.Context(), s.DB, caller, groupId, ManageChannels); s.denied(w, r, err) {
+		return
+	}
+
+	job, err := s.Jobs.StartDeletion(reflect.TypeOf(Channel{}), channelId, caller)
 	if err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "Could not delete within database.", http.StatusInternalServerError)
+		s.fail(w, r, err, "Could not start deleting within database.")
 		return
 	}
-	w.WriteHeader(http.StatusNoContent)
+	writeJob(w, job)
 }
 
 // GetChannelByID implements ServerInterface.
 func (s *SectorAPI) GetChannelByID(w http.ResponseWriter, r *http.Request, groupId types.UUID, channelId types.UUID) {
-	channel, err := getItem(s.DB.Store, channelId)
+	caller, err := callerOf(r)
+	if s.denied(w, r, err) {
+		return
+	}
+	if _, err := authorizeChannel(r.Context(), s.DB, caller, groupId, channelId); s.denied(w, r, err) {
+		return
+	}
+
+	channel, err := getItem(r.Context(), s.DB, reflect.TypeOf(Channel{}), channelId)
 	if err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "Could not get within database.", http.StatusInternalServerError)
+		s.fail(w, r, err, "Could not get within database.")
 		return
 	}
 	w.WriteHeader(http.StatusOK)
@@ -395,43 +563,75 @@ func (s *SectorAPI) GetChannelByID(w http.ResponseWriter, r *http.Request, group
 
 // SearchMessages implements ServerInterface.
 func (s *SectorAPI) SearchMessages(w http.ResponseWriter, r *http.Request) {
+	caller, err := callerOf(r)
+	if s.denied(w, r, err) {
+		return
+	}
+
 	var filter MessageFilter
 	if err := json.NewDecoder(r.Body).Decode(&filter); err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "Could not parse request body.", http.StatusBadRequest)
+		s.log(r).Debug("Could not parse request body", zap.Error(err))
+		writeProblem(w, http.StatusBadRequest, BadRequest, "Could not parse request body.")
 		return
 	}
 
-	messages, err := searchItem(s.DB.Store, reflect.TypeOf(Message{}), StructToMap(filter))
+	messages, err := searchItem(r.Context(), s.DB, reflect.TypeOf(Message{}), StructToMap(filter))
 	if err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "Could not perform database query.", http.StatusInternalServerError)
+		s.fail(w, r, err, "Could not perform database query.")
+		return
+	}
+	messages = visibleItems(s.DB, caller, reflect.TypeOf(Message{}), messages)
+
+	page, err := paginate(messages, filter.Limit, filter.Cursor, filter.Sort)
+	if err != nil {
+		s.log(r).Debug("Could not parse cursor", zap.Error(err))
+		writeProblem(w, http.StatusBadRequest, BadRequest, "Could not parse cursor.")
 		return
 	}
 
-	w.WriteHeader(http.StatusOK)
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(messages)
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(page)
 }
 
 // PutMessage implements ServerInterface.
 func (s *SectorAPI) PutMessage(w http.ResponseWriter, r *http.Request, groupId types.UUID, channelId types.UUID) {
+	caller, err := callerOf(r)
+	if s.denied(w, r, err) {
+		return
+	}
+	if _, err := authorizeChannel(r.Context(), s.DB, caller, groupId, channelId); s.denied(w, r, err) {
+		return
+	}
+
 	var messageDetails Message
 	if err := json.NewDecoder(r.Body).Decode(&messageDetails); err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "Could not parse request body.", http.StatusBadRequest)
+		s.log(r).Debug("Could not parse request body", zap.Error(err))
+		writeProblem(w, http.StatusBadRequest, BadRequest, "Could not parse request body.")
+		return
+	}
+
+	if messageDetails.Channel != channelId {
+		writeProblem(w, http.StatusBadRequest, BadRequest, "Message must be in the channel it is posted to.")
+		return
+	}
+	if s.denied(w, r, authorizeAccount(caller, messageDetails.Author)) {
 		return
 	}
+	if messageDetails.Pinned {
+		if _, err := authorizeGroupPermission(r.Context(), s.DB, caller, groupId, PinMessages); s.denied(w, r, err) {
+			return
+		}
+	}
 
 	if messageDetails.CreatedAt == nil {
 		var now = time.Now()
 		messageDetails.CreatedAt = &now
 	}
 
-	newItem, err := addItem(s.DB.Store, messageDetails)
+	newItem, err := addItem(r.Context(), s.DB, messageDetails)
 	if err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "", http.StatusInternalServerError)
+		s.fail(w, r, err, "Could not add to database.")
 		return
 	}
 	w.WriteHeader(http.StatusCreated)
@@ -441,17 +641,35 @@ func (s *SectorAPI) PutMessage(w http.ResponseWriter, r *http.Request, groupId t
 
 // UpdateMessageByID implements ServerInterface.
 func (s *SectorAPI) UpdateMessageByID(w http.ResponseWriter, r *http.Request, groupId types.UUID, channelId types.UUID, messageId types.UUID) {
+	caller, err := callerOf(r)
+	if s.denied(w, r, err) {
+		return
+	}
 	var updateDetails MessageUpdate
 	if err := json.NewDecoder(r.Body).Decode(&updateDetails); err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "Could not parse request body.", http.StatusBadRequest)
+		s.log(r).Debug("Could not parse request body", zap.Error(err))
+		writeProblem(w, http.StatusBadRequest, BadRequest, "Could not parse request body.")
 		return
 	}
 
-	newItem, err := updateItem(s.DB.Store, messageId, updateDetails)
+	// Only the author can edit a message, but pinning it is up to the group's moderators
+	if _, err := authorizeMessage(r.Context(), s.DB, caller, groupId, channelId, messageId); s.denied(w, r, err) {
+		return
+	}
+	if updateDetails.Body != nil {
+		if _, err := authorizeAuthor(r.Context(), s.DB, caller, groupId, channelId, messageId); s.denied(w, r, err) {
+			return
+		}
+	}
+	if updateDetails.Pinned != nil {
+		if _, err := authorizeGroupPermission(r.Context(), s.DB, caller, groupId, PinMessages); s.denied(w, r, err) {
+			return
+		}
+	}
+
+	newItem, err := updateItem(r.Context(), s.DB, reflect.TypeOf(Message{}), messageId, updateDetails)
 	if err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "", http.StatusInternalServerError)
+		s.fail(w, r, err, "Could not update within database.")
 		return
 	}
 	w.WriteHeader(http.StatusCreated)
@@ -461,10 +679,17 @@ func (s *SectorAPI) UpdateMessageByID(w http.ResponseWriter, r *http.Request, gr
 
 // DeleteMessageByID implements ServerInterface.
 func (s *SectorAPI) DeleteMessageByID(w http.ResponseWriter, r *http.Request, groupId types.UUID, channelId types.UUID, messageId types.UUID) {
-	err := removeItem(s.DB.Store, messageId)
+	caller, err := callerOf(r)
+	if s.denied(w, r, err) {
+		return
+	}
+	if _, err := authorizeAuthor(r.Context(), s.DB, caller, groupId, channelId, messageId); s.denied(w, r, err) {
+		return
+	}
+
+	err = removeItem(r.Context(), s.DB, reflect.TypeOf(Message{}), messageId)
 	if err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "Could not delete within database.", http.StatusInternalServerError)
+		s.fail(w, r, err, "Could not delete within database.")
 		return
 	}
 	w.WriteHeader(http.StatusNoContent)
@@ -472,10 +697,17 @@ func (s *SectorAPI) DeleteMessageByID(w http.ResponseWriter, r *http.Request, gr
 
 // GetMessageByID implements ServerInterface.
 func (s *SectorAPI) GetMessageByID(w http.ResponseWriter, r *http.Request, groupId types.UUID, channelId types.UUID, messageId types.UUID) {
-	message, err := getItem(s.DB.Store, messageId)
+	caller, err := callerOf(r)
+	if s.denied(w, r, err) {
+		return
+	}
+	if _, err := authorizeMessage(r.Context(), s.DB, caller, groupId, channelId, messageId); s.denied(w, r, err) {
+		return
+	}
+
+	message, err := getItem(r.Context(), s.DB, reflect.TypeOf(Message{}), messageId)
 	if err != nil {
-		s.Logger.Debug(err.Error())
-		http.Error(w, "Could not get within database.", http.StatusInternalServerError)
+		s.fail(w, r, err, "Could not get within database.")
 		return
 	}
 	w.WriteHeader(http.StatusOK)
@@ -485,13 +717,41 @@ func (s *SectorAPI) GetMessageByID(w http.ResponseWriter, r *http.Request, group
 
 //#endregion Message API
 
-//#region Misc. API
+//#region Job API
+
+// GetJobByID implements ServerInterface.
+func (s *SectorAPI) GetJobByID(w http.ResponseWriter, r *http.Request, jobId types.UUID) {
+	caller, err := callerOf(r)
+	if s.denied(w, r, err) {
+		return
+	}
+
+	job, err := s.Jobs.Get(jobId)
+	if err != nil {
+		s.fail(w, r, err, "Could not get within database.")
+		return
+	}
+	if s.denied(w, r, authorizeAccount(caller, job.RequestedBy)) {
+		return
+	}
 
-// GetHealth implements ServerInterface.
-func (s *SectorAPI) GetHealth(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(job)
 }
 
+// Write the response for a job that was started, pointing to where it can be followed
+func writeJob(w http.ResponseWriter, job Job) {
+	w.Header().Set("Location", "/v1/api/jobs/"+job.Id.String())
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(job)
+}
+
+//#endregion Job API
+
+//#region Misc. API
+
 // GetRoot implements ServerInterface.
 func (s *SectorAPI) GetRoot(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
@@ -505,63 +765,178 @@ func (s *SectorAPI) GetRoot(w http.ResponseWriter, r *http.Request) {
 
 var _ ServerInterface = (*SectorAPI)(nil)
 
-// Create a new Sector API instance
-func NewSector(ctx context.Context, logfile, dbCache string) *SectorAPI {
-	// Setup the logger
-	logger, err := logger.NewLogger(logfile)
-	if err != nil {
+// Create a new Sector API instance, connected to the database
+func NewSector(ctx context.Context, cfg config.Config) *SectorAPI {
+	s := OpenSector(ctx, cfg)
+	if err := s.Connect(); err != nil {
 		panic(err)
 	}
+	return s
+}
 
-	// Setup the database
-	db, err := database.NewDatabase(ctx, dbCache, logger)
+/**
+ * Create a new Sector API instance without connecting to the database yet, so that it can be served while Connect
+ * loads the stores. Until then the health checks report how loading is getting on, and every other request is
+ * answered 503, see WhileConnecting.
+ */
+func OpenSector(ctx context.Context, cfg config.Config) *SectorAPI {
+	// Setup the logger
+	logger, err := logger.NewLogger(cfg.Log)
 	if err != nil {
 		panic(err)
 	}
-	// defer db.Disconnect() (TODO: FIGURE OUT WHEN TO CALL DISCONNECT)
 
-	err = db.Connect(func(address string) {
-		fmt.Println("Connected: ", address)
-	})
+	// Setup the database
+	db, err := database.NewDatabase(ctx, cfg.Database, logger)
 	if err != nil {
 		panic(err)
 	}
 
+	tokens := newAuth(cfg)
+	events := NewEventBroker(db)
 	return &SectorAPI{
-		Logger: logger,
-		DB:     db,
+		Logger:     logger,
+		DB:         db,
+		Events:     events,
+		Gateway:    NewGateway(logger, db, events, tokens),
+		Auth:       tokens,
+		Challenges: newChallengeStore(cfg.Auth),
+		Jobs:       NewJobs(logger, db, cfg.Database.JobRetention),
+		Admins:     cfg.Admins,
+		MinPeers:   cfg.Database.MinPeers,
 	}
 }
 
 // Create a new SectorAPI instance for unit testing
-func NewTestingSector(ctx context.Context, logfile, dbCache string, t *testing.T) *SectorAPI {
+func NewTestingSector(ctx context.Context, cfg config.Config, t *testing.T) *SectorAPI {
 	// Setup the logger
-	logger, err := logger.NewLogger(logfile)
+	logger, err := logger.NewLogger(cfg.Log)
 	if err != nil {
 		panic(err)
 	}
 
 	// Setup the database
-	db, err := database.NewTestingDatabase(ctx, dbCache, logger, t)
+	db, err := database.NewTestingDatabase(ctx, cfg.Database, logger, t)
 	if err != nil {
 		panic(err)
 	}
 
-	err = db.Connect(func(address string) {
-		fmt.Println("Connected: ", address)
+	tokens := newAuth(cfg)
+	challenges := newChallengeStore(cfg.Auth)
+	t.Cleanup(challenges.Close)
+
+	jobs := NewJobs(logger, db, cfg.Database.JobRetention)
+	t.Cleanup(jobs.Close)
+
+	events := NewEventBroker(db)
+	s := &SectorAPI{
+		Logger:     logger,
+		DB:         db,
+		Events:     events,
+		Gateway:    NewGateway(logger, db, events, tokens),
+		Auth:       tokens,
+		Challenges: challenges,
+		Jobs:       jobs,
+		Admins:     cfg.Admins,
+		MinPeers:   cfg.Database.MinPeers,
+	}
+	if err := s.Connect(); err != nil {
+		panic(err)
+	}
+	return s
+}
+
+/**
+ * Connect to the database and load its stores, then bring it up to date and carry on with the deletions that were
+ * cut short when we last stopped. Requests other than the health checks can be served once it has.
+ */
+func (s *SectorAPI) Connect() error {
+	err := s.DB.Connect(func(address string) {
+		s.Logger.Info("Connected", zap.String("address", address))
 	})
 	if err != nil {
-		panic(err)
+		return err
 	}
 
-	return &SectorAPI{
-		Logger: logger,
-		DB:     db,
+	s.connectMu.Lock()
+	defer s.connectMu.Unlock()
+	if s.closed {
+		s.DB.Disconnect()
+		return ErrClosed
+	}
+	if err := MigrateDatabase(s.DB); err != nil {
+		return err
+	}
+	if err := s.Jobs.Resume(); err != nil {
+		return err
 	}
+	s.connected = true
+	return nil
+}
+
+// Whether Connect has finished, and the API can serve requests that use the database
+func (s *SectorAPI) Connected() bool {
+	s.connectMu.Lock()
+	defer s.connectMu.Unlock()
+	return s.connected
+}
+
+// Stop the work done in the background and disconnect from the database, the API cannot be used after it is closed
+func (s *SectorAPI) Close() {
+	s.connectMu.Lock()
+	s.closed = true
+	connected := s.connected
+	s.connectMu.Unlock()
+
+	s.Gateway.Close()
+	s.Jobs.Close()
+	s.Challenges.Close()
+	// A Connect still loading the stores disconnects once it is done instead
+	if connected {
+		s.DB.Disconnect()
+	}
+	s.Logger.Sync()
 }
 
 //#region Helper Functions
 
+/**
+ * Create the auth service of the node. Revoked tokens and the keys tokens are signed with are kept alongside the
+ * database, so that logging out and sessions survive a restart. Tokens signed with the JWT secret before there were
+ * keys are still accepted, as access tokens, until they expire.
+ */
+func newAuth(cfg config.Config) *auth.Service {
+	revocations, err := auth.OpenRevocationList(filepath.Join(cfg.Database.Cache, "revoked_tokens.json"))
+	if err != nil {
+		panic(err)
+	}
+
+	keyring, err := auth.OpenKeyring(filepath.Join(cfg.Database.Cache, "jwt_keys.json"), auth.KeyringOptions{
+		Algorithm:        cfg.Auth.JWTAlgorithm,
+		RotationInterval: cfg.Auth.JWTRotationInterval,
+		GracePeriod:      cfg.Auth.RefreshTokenExpiry,
+		LegacySecret:     []byte(cfg.Auth.JWTSecret),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return &auth.Service{
+		Keyring:            keyring,
+		Revocations:        revocations,
+		AccessTokenExpiry:  cfg.Auth.AccessTokenExpiry,
+		RefreshTokenExpiry: cfg.Auth.RefreshTokenExpiry,
+	}
+}
+
+// Create the store for login and registration challenges
+func newChallengeStore(cfg config.AuthConfig) auth.ChallengeStore {
+	return auth.NewChallengeStore(auth.ChallengeStoreOptions{
+		TTL:    cfg.ChallengeTTL,
+		PerKey: cfg.ChallengesPerUser,
+		Total:  cfg.ChallengesTotal,
+	})
+}
+
 // Get an item from a document store as a struct (a widely used helper function, TODO: this should be even more widely used, but hasn't been refactored in yet. Ensure you pass &obj as the final arg)
 func getDatabaseItem(store orbitdb.DocumentStore, id string, obj interface{}) error {
 	matches, err := store.Get(context.Background(), id, &iface.DocumentStoreGetOptions{})
@@ -1,14 +1,56 @@
 package auth
 
 import (
+	"errors"
 	"sync"
 	"time"
 )
 
-// ChallengeStore manages authentication challenges
-type ChallengeStore struct {
-	challenges map[string]Challenge
-	mu         sync.RWMutex
+// Challenge store related constants
+const (
+	// DefaultChallengeTTL is how long a challenge can be answered for
+	DefaultChallengeTTL = time.Minute * 5
+	// DefaultChallengesPerKey is how many challenges can be outstanding for a single username
+	DefaultChallengesPerKey = 5
+	// DefaultMaxChallenges is how many challenges can be outstanding altogether
+	DefaultMaxChallenges = 10000
+)
+
+var ErrTooManyChallenges = errors.New("too many outstanding challenges")
+
+// Clock tells the time, so that tests can control when things expire
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// SystemClock is the clock that tells the real time
+var SystemClock Clock = systemClock{}
+
+// ChallengeStore keeps the challenges that have been issued but not yet answered, keyed by what they were issued for
+type ChallengeStore interface {
+	// Add a challenge for a key. Fails with ErrTooManyChallenges if the key, or the store, has as many as it can hold.
+	Add(key, challenge string) error
+	// Get the challenges that are outstanding for a key, oldest first
+	Get(key string) []string
+	// Remove a challenge once it has been answered, reporting whether it was outstanding
+	Remove(key, challenge string) bool
+	// Close stops the store from dropping expired challenges in the background
+	Close()
+}
+
+// ChallengeStoreOptions configures a MemoryChallengeStore, any left unset take their default
+type ChallengeStoreOptions struct {
+	TTL          time.Duration // How long a challenge can be answered for
+	PerKey       int           // How many challenges can be outstanding for one key, new ones for it are refused past it
+	Total        int           // How many challenges can be outstanding altogether, new ones are refused past it
+	ReapInterval time.Duration // How often expired challenges are dropped, defaults to the TTL
+	Clock        Clock
 }
 
 // Challenge represents an authentication challenge
@@ -17,55 +59,164 @@ type Challenge struct {
 	CreatedAt time.Time
 }
 
-// NewChallengeStore creates a new challenge store
-func NewChallengeStore() *ChallengeStore {
-	return &ChallengeStore{
-		challenges: make(map[string]Challenge),
+// MemoryChallengeStore is a ChallengeStore kept in memory, with a single goroutine dropping expired challenges
+type MemoryChallengeStore struct {
+	options    ChallengeStoreOptions
+	challenges map[string][]Challenge // Oldest first
+	total      int
+	mu         sync.Mutex
+	stop       chan struct{}
+	closeOnce  sync.Once
+}
+
+// NewChallengeStore creates a new challenge store, and starts dropping its expired challenges until it is closed
+func NewChallengeStore(options ChallengeStoreOptions) *MemoryChallengeStore {
+	if options.TTL <= 0 {
+		options.TTL = DefaultChallengeTTL
+	}
+	if options.PerKey <= 0 {
+		options.PerKey = DefaultChallengesPerKey
+	}
+	if options.Total <= 0 {
+		options.Total = DefaultMaxChallenges
 	}
+	if options.ReapInterval <= 0 {
+		options.ReapInterval = options.TTL
+	}
+	if options.Clock == nil {
+		options.Clock = SystemClock
+	}
+
+	s := &MemoryChallengeStore{
+		options:    options,
+		challenges: make(map[string][]Challenge),
+		stop:       make(chan struct{}),
+	}
+	go s.reaper()
+	return s
 }
 
-// Add adds a challenge for a user
-func (s *ChallengeStore) Add(username, challenge string) {
+/**
+ * Add adds a challenge for a key, refusing it if the key already has as many as it can. Outstanding challenges are
+ * never dropped for new ones, or anyone could ask for challenges for a username until the one its owner is about to
+ * answer is gone.
+ */
+func (s *MemoryChallengeStore) Add(key, challenge string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	s.challenges[username] = Challenge{
-		Value:     challenge,
-		CreatedAt: time.Now(),
+	now := s.options.Clock.Now()
+	s.expire(key, now)
+
+	if len(s.challenges[key]) >= s.options.PerKey {
+		return ErrTooManyChallenges
+	}
+	if s.total >= s.options.Total {
+		s.reap(now)
+		if s.total >= s.options.Total {
+			return ErrTooManyChallenges
+		}
 	}
 
-	// Start a cleanup goroutine to remove expired challenges
-	go s.cleanupExpired(username, 5*time.Minute)
+	s.challenges[key] = append(s.challenges[key], Challenge{
+		Value:     challenge,
+		CreatedAt: now,
+	})
+	s.total++
+	return nil
 }
 
-// Get retrieves a challenge for a user
-func (s *ChallengeStore) Get(username string) (string, bool) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+// Get retrieves the challenges that have not expired for a key
+func (s *MemoryChallengeStore) Get(key string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.expire(key, s.options.Clock.Now())
 
-	challenge, exists := s.challenges[username]
-	if !exists {
-		return "", false
+	values := make([]string, 0, len(s.challenges[key]))
+	for _, challenge := range s.challenges[key] {
+		values = append(values, challenge.Value)
 	}
+	return values
+}
+
+// Remove removes a challenge for a key
+func (s *MemoryChallengeStore) Remove(key, challenge string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	// Check if the challenge has expired (10 minutes)
-	if time.Since(challenge.CreatedAt) > 10*time.Minute {
-		return "", false
+	for i, pending := range s.challenges[key] {
+		if pending.Value == challenge {
+			s.challenges[key] = append(s.challenges[key][:i:i], s.challenges[key][i+1:]...)
+			if len(s.challenges[key]) == 0 {
+				delete(s.challenges, key)
+			}
+			s.total--
+			return true
+		}
 	}
+	return false
+}
+
+// Len is how many challenges are outstanding, including any that have expired but not been dropped yet
+func (s *MemoryChallengeStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	return challenge.Value, true
+	return s.total
 }
 
-// Remove removes a challenge for a user
-func (s *ChallengeStore) Remove(username string) {
+// Reap drops every challenge that has expired
+func (s *MemoryChallengeStore) Reap() {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	delete(s.challenges, username)
+	s.reap(s.options.Clock.Now())
 }
 
-// cleanupExpired removes a challenge after it expires
-func (s *ChallengeStore) cleanupExpired(username string, after time.Duration) {
-	time.Sleep(after)
-	s.Remove(username)
+// Close stops the reaper
+func (s *MemoryChallengeStore) Close() {
+	s.closeOnce.Do(func() {
+		close(s.stop)
+	})
+}
+
+// reaper periodically drops expired challenges, so that ones that are never answered do not build up
+func (s *MemoryChallengeStore) reaper() {
+	ticker := time.NewTicker(s.options.ReapInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.Reap()
+		case <-s.stop:
+			return
+		}
+	}
+}
+
+// reap drops the expired challenges of every key. The caller must hold the lock.
+func (s *MemoryChallengeStore) reap(now time.Time) {
+	for key := range s.challenges {
+		s.expire(key, now)
+	}
+}
+
+// expire drops the expired challenges of a key. The caller must hold the lock.
+func (s *MemoryChallengeStore) expire(key string, now time.Time) {
+	pending := s.challenges[key]
+	live := pending[:0]
+	for _, challenge := range pending {
+		if now.Sub(challenge.CreatedAt) < s.options.TTL {
+			live = append(live, challenge)
+		}
+	}
+
+	s.total -= len(pending) - len(live)
+	if len(live) == 0 {
+		delete(s.challenges, key)
+	} else {
+		s.challenges[key] = live
+	}
 }
@@ -1,12 +1,11 @@
 package auth
 
 import (
-	"Sector/internal/config"
 	"crypto/rand"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"net/http"
-	"os"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -14,17 +13,59 @@ import (
 
 // JWT related constants
 const (
-	// TokenExpiry is the default token expiration time
-	TokenExpiry = time.Hour * 24
+	// AccessTokenExpiry is how long an access token can be used to make requests for, unless a Service is given another
+	AccessTokenExpiry = time.Minute * 15
+	// RefreshTokenExpiry is how long a refresh token can be used to get new tokens for, each refresh starts it over,
+	// unless a Service is given another
+	RefreshTokenExpiry = time.Hour * 24 * 30
+
+	// The kinds of token that are issued
+	AccessToken  = "access"
+	RefreshToken = "refresh"
+)
+
+var (
+	ErrTokenRevoked   = errors.New("token has been revoked")
+	ErrWrongTokenType = errors.New("wrong type of token")
 )
 
+// Service issues and validates the tokens of a node, signing them with its keyring and checking them against its
+// revocation list
+type Service struct {
+	Keyring            *Keyring
+	Revocations        *RevocationList
+	AccessTokenExpiry  time.Duration // How long the access tokens that are issued last
+	RefreshTokenExpiry time.Duration // How long the refresh tokens that are issued last
+}
+
+// NewService creates a service kept in memory, with a keyring of its own and an empty revocation list, that issues
+// tokens with the default lifetimes
+func NewService() *Service {
+	return &Service{
+		Keyring:            NewKeyring(KeyringOptions{}),
+		Revocations:        NewRevocationList(""),
+		AccessTokenExpiry:  AccessTokenExpiry,
+		RefreshTokenExpiry: RefreshTokenExpiry,
+	}
+}
+
 // Claims defines the custom claims for the JWT token
 type Claims struct {
-	UserID   string `json:"user_id"`
-	Username string `json:"username"`
+	UserID    string `json:"user_id"`
+	Username  string `json:"username"`
+	Type      string `json:"typ"` // Whether this is an access or a refresh token
+	SessionID string `json:"sid"` // The login that the token was issued for, shared by every token refreshed from it
+	DeviceID  string `json:"dev"` // The device key that signed in to the session, if any
 	jwt.RegisteredClaims
 }
 
+// TokenPair is an access token along with the refresh token used to get the next one
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+	ExpiresAt    time.Time // When the access token expires
+}
+
 // GenerateChallenge creates a random challenge string for authentication
 func GenerateChallenge() (string, error) {
 	b := make([]byte, 32)
@@ -32,41 +73,122 @@ func GenerateChallenge() (string, error) {
 	if err != nil {
 		return "", err
 	}
-	fmt.Println(config.GetEnv("JWT_SECRET", "fallback"))
 	return base64.StdEncoding.EncodeToString(b), nil
 }
 
-// GenerateToken creates a new JWT token for a user
-func GenerateToken(userID, username string) (string, error) {
-	expirationTime := time.Now().Add(TokenExpiry)
+// GenerateToken creates a new access token for a user, in a session of its own
+func (s *Service) GenerateToken(userID, username string) (string, error) {
+	return s.generateToken(userID, username, randomID(), "", AccessToken, s.AccessTokenExpiry)
+}
+
+// GenerateTokenPair starts a new session for a user signed in with the given device, creating its first access and refresh tokens
+func (s *Service) GenerateTokenPair(userID, username, deviceID string) (*TokenPair, error) {
+	return s.generateTokenPair(userID, username, randomID(), deviceID)
+}
+
+// RefreshTokens swaps a refresh token for a new pair of tokens in the same session. Each refresh token can only be
+// used once, if one is used again it has probably been stolen, so the whole session is revoked.
+func (s *Service) RefreshTokens(refreshToken string) (*TokenPair, error) {
+	claims, err := s.parseToken(refreshToken, RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	if s.isRevoked(claims.SessionID, claims.DeviceID) {
+		return nil, ErrTokenRevoked
+	}
+
+	// Revoke the refresh token as it is used, so that two uses at once cannot both get through
+	first, err := s.Revocations.RevokeIfNew(claims.ID, claims.ExpiresAt.Time)
+	if err != nil {
+		return nil, err
+	}
+	if !first {
+		if err := s.RevokeSession(claims); err != nil {
+			return nil, err
+		}
+		return nil, ErrTokenRevoked
+	}
+	return s.generateTokenPair(claims.UserID, claims.Username, claims.SessionID, claims.DeviceID)
+}
+
+// RevokeSession revokes every token issued in the same session as the given one
+func (s *Service) RevokeSession(claims *Claims) error {
+	// No token of the session can outlive a refresh token issued right now
+	return s.Revocations.Revoke(claims.SessionID, time.Now().Add(s.RefreshTokenExpiry))
+}
+
+// RevokeDevice revokes every token issued in a session that was signed in to with the given device key
+func (s *Service) RevokeDevice(deviceID string) error {
+	return s.Revocations.Revoke(deviceID, time.Now().Add(s.RefreshTokenExpiry))
+}
+
+// isRevoked checks if any of the given ids have been revoked, ignoring those that are empty
+func (s *Service) isRevoked(ids ...string) bool {
+	for _, id := range ids {
+		if id != "" && s.Revocations.IsRevoked(id) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Service) generateTokenPair(userID, username, sessionID, deviceID string) (*TokenPair, error) {
+	accessToken, err := s.generateToken(userID, username, sessionID, deviceID, AccessToken, s.AccessTokenExpiry)
+	if err != nil {
+		return nil, err
+	}
+	refreshToken, err := s.generateToken(userID, username, sessionID, deviceID, RefreshToken, s.RefreshTokenExpiry)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpiresAt:    time.Now().Add(s.AccessTokenExpiry),
+	}, nil
+}
+
+func (s *Service) generateToken(userID, username, sessionID, deviceID, tokenType string, expiry time.Duration) (string, error) {
+	expirationTime := time.Now().Add(expiry)
 	claims := &Claims{
-		UserID:   userID,
-		Username: username,
+		UserID:    userID,
+		Username:  username,
+		Type:      tokenType,
+		SessionID: sessionID,
+		DeviceID:  deviceID,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        randomID(),
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			Subject:   userID,
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(os.Getenv("JWT_SECRET")))
+	return s.Keyring.Sign(claims)
+}
+
+// ValidateToken validates an access token and returns the claims if valid and not revoked
+func (s *Service) ValidateToken(tokenString string) (*Claims, error) {
+	claims, err := s.parseToken(tokenString, AccessToken)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	if s.isRevoked(claims.ID, claims.SessionID, claims.DeviceID) {
+		return nil, ErrTokenRevoked
 	}
+	return claims, nil
+}
 
-	return tokenString, nil
+// PublicKeys gets the public keys that the tokens of the service can be verified with, see Keyring.JWKS
+func (s *Service) PublicKeys() JWKSet {
+	return s.Keyring.JWKS()
 }
 
-// ValidateToken validates a JWT token and returns the claims if valid
-func ValidateToken(tokenString string) (*Claims, error) {
+// parseToken checks the signature and expiry of a token of the given type, and returns its claims
+func (s *Service) parseToken(tokenString, tokenType string) (*Claims, error) {
 	claims := &Claims{}
-	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return []byte(os.Getenv("JWT_SECRET")), nil
-	})
+	token, err := jwt.ParseWithClaims(tokenString, claims, s.Keyring.Keyfunc)
 
 	if err != nil {
 		return nil, err
@@ -76,9 +198,25 @@ func ValidateToken(tokenString string) (*Claims, error) {
 		return nil, fmt.Errorf("invalid token")
 	}
 
+	// Tokens signed with the legacy secret, which have no key id, were issued before there were refresh tokens and
+	// have no type, they were all access tokens
+	if _, ok := token.Header["kid"]; !ok && claims.Type == "" {
+		claims.Type = AccessToken
+	}
+	if claims.Type != tokenType {
+		return nil, ErrWrongTokenType
+	}
+
 	return claims, nil
 }
 
+// randomID creates a random id for a token or session
+func randomID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
 // ExtractTokenFromRequest extracts the JWT token from the Authorization header
 func ExtractTokenFromRequest(r *http.Request) (string, error) {
 	authHeader := r.Header.Get("Authorization")
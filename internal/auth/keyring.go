@@ -0,0 +1,358 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Keyring related constants
+const (
+	// The algorithms tokens can be signed with. HS256 keys are secret, EdDSA and ES256 keys have a public half that
+	// other nodes can verify tokens with.
+	HS256 = "HS256"
+	EdDSA = "EdDSA"
+	ES256 = "ES256"
+
+	// DefaultRotationInterval is how long a key signs tokens for before it is replaced
+	DefaultRotationInterval = time.Hour * 24 * 7
+	// DefaultGracePeriod is how long a replaced key still verifies tokens for, long enough for any it signed to expire
+	DefaultGracePeriod = RefreshTokenExpiry
+
+	// The id given to the secret that tokens were signed with before there was a keyring
+	legacyKeyID = "legacy"
+)
+
+var (
+	ErrUnknownKey           = errors.New("unknown signing key")
+	ErrUnsupportedAlgorithm = errors.New("unsupported signing algorithm")
+)
+
+// KeyringOptions configures a Keyring, any left unset take their default
+type KeyringOptions struct {
+	Algorithm        string        // What new keys sign with, HS256 unless set
+	RotationInterval time.Duration // How long a key signs tokens for before it is replaced
+	GracePeriod      time.Duration // How long a replaced key still verifies tokens for
+	LegacySecret     []byte        // The HS256 secret tokens without a key id were signed with, see OpenKeyring
+	Clock            Clock
+}
+
+// A key that tokens are signed with, identified in their header by its kid
+type signingKey struct {
+	ID        string     `json:"kid"`
+	Algorithm string     `json:"alg"`
+	Material  []byte     `json:"key"` // The secret for HS256, otherwise the PKCS #8 encoded private key
+	CreatedAt time.Time  `json:"created_at"`
+	RetiredAt *time.Time `json:"retired_at,omitempty"` // When it stopped signing, it only verifies after that
+
+	private interface{} // The key to sign with, parsed from the material
+	public  interface{} // The key to verify with
+}
+
+// JWK is the public half of a signing key, as a JSON Web Key
+type JWK struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Algorithm string `json:"alg"`
+	Use       string `json:"use"`
+	Curve     string `json:"crv"`
+	X         string `json:"x"`
+	Y         string `json:"y,omitempty"`
+}
+
+// JWKSet is a set of JSON Web Keys, as served from /.well-known/jwks.json
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// Keyring holds the keys tokens are signed with. Only the newest key signs, and once it is old enough it is replaced
+// by a new one. Replaced keys keep verifying tokens for a grace period, so rotating does not end any sessions.
+type Keyring struct {
+	path    string
+	options KeyringOptions
+	keys    []*signingKey // Oldest first, the last is the one that signs
+	mu      sync.RWMutex
+}
+
+// NewKeyring creates a keyring kept in memory, with a single new key
+func NewKeyring(options KeyringOptions) *Keyring {
+	k := newKeyring("", options)
+	if err := k.Rotate(); err != nil {
+		panic(err)
+	}
+	return k
+}
+
+// OpenKeyring loads the keyring saved to the given file, creating it if it does not exist yet. A new keyring takes
+// in the legacy secret as a key that has already been replaced, so tokens signed with it last out the grace period.
+// If the keyring's newest key is not of the configured algorithm, it is replaced by one that is.
+func OpenKeyring(path string, options KeyringOptions) (*Keyring, error) {
+	k := newKeyring(path, options)
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		if len(options.LegacySecret) > 0 {
+			now := k.options.Clock.Now()
+			legacy := &signingKey{
+				ID:        legacyKeyID,
+				Algorithm: HS256,
+				Material:  options.LegacySecret,
+				CreatedAt: now,
+				RetiredAt: &now,
+			}
+			if err := legacy.parse(); err != nil {
+				return nil, err
+			}
+			k.keys = append(k.keys, legacy)
+		}
+		return k, k.Rotate()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &k.keys); err != nil {
+		return nil, err
+	}
+	for _, key := range k.keys {
+		if err := key.parse(); err != nil {
+			return nil, fmt.Errorf("key '%s': %w", key.ID, err)
+		}
+	}
+	if current := k.current(); current == nil || current.Algorithm != k.options.Algorithm {
+		return k, k.Rotate()
+	}
+	return k, nil
+}
+
+func newKeyring(path string, options KeyringOptions) *Keyring {
+	if options.Algorithm == "" {
+		options.Algorithm = HS256
+	}
+	if options.RotationInterval <= 0 {
+		options.RotationInterval = DefaultRotationInterval
+	}
+	if options.GracePeriod <= 0 {
+		options.GracePeriod = DefaultGracePeriod
+	}
+	if options.Clock == nil {
+		options.Clock = SystemClock
+	}
+	return &Keyring{path: path, options: options}
+}
+
+// Rotate replaces the key that signs tokens with a new one, and drops the keys whose grace period is over
+func (k *Keyring) Rotate() error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	return k.rotate()
+}
+
+// Sign signs the claims with the current key, first replacing it if it is due
+func (k *Keyring) Sign(claims jwt.Claims) (string, error) {
+	k.mu.RLock()
+	current := k.current()
+	due := k.options.Clock.Now().Sub(current.CreatedAt) >= k.options.RotationInterval
+	k.mu.RUnlock()
+
+	if due {
+		k.mu.Lock()
+		// Another token may have been signed, and the key replaced, while waiting for the lock
+		if k.current() == current {
+			if err := k.rotate(); err != nil {
+				k.mu.Unlock()
+				return "", err
+			}
+		}
+		current = k.current()
+		k.mu.Unlock()
+	}
+
+	token := jwt.NewWithClaims(jwt.GetSigningMethod(current.Algorithm), claims)
+	token.Header["kid"] = current.ID
+	return token.SignedString(current.private)
+}
+
+// Keyfunc finds the key to verify a token with from the kid in its header, for use with jwt.Parse
+func (k *Keyring) Keyfunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		kid = legacyKeyID
+	}
+
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	now := k.options.Clock.Now()
+	for _, key := range k.keys {
+		if key.ID != kid {
+			continue
+		}
+		if key.RetiredAt != nil && now.Sub(*key.RetiredAt) >= k.options.GracePeriod {
+			break
+		}
+		// The algorithm comes from the key rather than the token, so a token cannot pick a weaker one
+		if token.Method.Alg() != key.Algorithm {
+			return nil, fmt.Errorf("%w: token is %v but key is %s", ErrUnsupportedAlgorithm, token.Header["alg"], key.Algorithm)
+		}
+		return key.public, nil
+	}
+	return nil, fmt.Errorf("%w: '%s'", ErrUnknownKey, kid)
+}
+
+// JWKS gets the public halves of the keys that can verify tokens. HS256 keys are secret, so they are left out.
+func (k *Keyring) JWKS() JWKSet {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	set := JWKSet{Keys: make([]JWK, 0)}
+	now := k.options.Clock.Now()
+	for _, key := range k.keys {
+		if key.RetiredAt != nil && now.Sub(*key.RetiredAt) >= k.options.GracePeriod {
+			continue
+		}
+
+		jwk := JWK{KeyID: key.ID, Algorithm: key.Algorithm, Use: "sig"}
+		switch public := key.public.(type) {
+		case ed25519.PublicKey:
+			jwk.KeyType = "OKP"
+			jwk.Curve = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(public)
+		case *ecdsa.PublicKey:
+			point, err := public.ECDH()
+			if err != nil {
+				continue
+			}
+			// The point is encoded uncompressed, as 0x04 followed by x and y
+			coordinates := point.Bytes()[1:]
+			jwk.KeyType = "EC"
+			jwk.Curve = "P-256"
+			jwk.X = base64.RawURLEncoding.EncodeToString(coordinates[:len(coordinates)/2])
+			jwk.Y = base64.RawURLEncoding.EncodeToString(coordinates[len(coordinates)/2:])
+		default:
+			continue
+		}
+		set.Keys = append(set.Keys, jwk)
+	}
+	return set
+}
+
+// current gets the key that signs tokens. The caller must hold the lock.
+func (k *Keyring) current() *signingKey {
+	if len(k.keys) == 0 || k.keys[len(k.keys)-1].RetiredAt != nil {
+		return nil
+	}
+	return k.keys[len(k.keys)-1]
+}
+
+// rotate does the work of Rotate. The caller must hold the lock.
+func (k *Keyring) rotate() error {
+	now := k.options.Clock.Now()
+	key, err := generateSigningKey(k.options.Algorithm, now)
+	if err != nil {
+		return err
+	}
+
+	kept := make([]*signingKey, 0, len(k.keys)+1)
+	for _, old := range k.keys {
+		if old.RetiredAt == nil {
+			old.RetiredAt = &now
+		}
+		if now.Sub(*old.RetiredAt) < k.options.GracePeriod {
+			kept = append(kept, old)
+		}
+	}
+	k.keys = append(kept, key)
+	return k.save()
+}
+
+// save writes the keyring to its file, if it has one. The caller must hold the lock.
+func (k *Keyring) save() error {
+	if k.path == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(k.keys)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(k.path, data)
+}
+
+// generateSigningKey creates a new key for the given algorithm
+func generateSigningKey(algorithm string, now time.Time) (*signingKey, error) {
+	key := &signingKey{
+		ID:        randomID(),
+		Algorithm: algorithm,
+		CreatedAt: now,
+	}
+
+	var err error
+	switch algorithm {
+	case HS256:
+		key.Material = make([]byte, 32)
+		_, err = rand.Read(key.Material)
+	case EdDSA:
+		var private ed25519.PrivateKey
+		_, private, err = ed25519.GenerateKey(rand.Reader)
+		if err == nil {
+			key.Material, err = x509.MarshalPKCS8PrivateKey(private)
+		}
+	case ES256:
+		var private *ecdsa.PrivateKey
+		private, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err == nil {
+			key.Material, err = x509.MarshalPKCS8PrivateKey(private)
+		}
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedAlgorithm, algorithm)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return key, key.parse()
+}
+
+// parse sets the keys to sign and verify with from the key material
+func (key *signingKey) parse() error {
+	if key.Algorithm == HS256 {
+		key.private = key.Material
+		key.public = key.Material
+		return nil
+	}
+
+	private, err := x509.ParsePKCS8PrivateKey(key.Material)
+	if err != nil {
+		return err
+	}
+	switch private := private.(type) {
+	case ed25519.PrivateKey:
+		if key.Algorithm != EdDSA {
+			break
+		}
+		key.private = private
+		key.public = private.Public()
+		return nil
+	case *ecdsa.PrivateKey:
+		if key.Algorithm != ES256 || private.Curve != elliptic.P256() {
+			break
+		}
+		key.private = private
+		key.public = &private.PublicKey
+		return nil
+	}
+	return fmt.Errorf("%w: %s key is a %T", ErrUnsupportedAlgorithm, key.Algorithm, private)
+}
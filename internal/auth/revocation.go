@@ -0,0 +1,175 @@
+package auth
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// How often a revocation list drops the ids that have expired and rewrites its file, which revocations are otherwise
+// appended to.
+const revocationCompactInterval = time.Hour
+
+// RevocationList keeps the ids of tokens and sessions that were revoked before they expired, saved to a file
+// so that they stay revoked when the server restarts
+type RevocationList struct {
+	path      string
+	revoked   map[string]time.Time // When each revoked id would have expired anyway
+	compacted time.Time            // When the expired ids were last dropped
+	mu        sync.RWMutex
+}
+
+// A single revocation, as it is appended to the file of a revocation list
+type revocation struct {
+	ID        string    `json:"id"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// NewRevocationList creates a revocation list saved to the given file, or kept in memory if the path is empty
+func NewRevocationList(path string) *RevocationList {
+	return &RevocationList{
+		path:      path,
+		revoked:   make(map[string]time.Time),
+		compacted: time.Now(),
+	}
+}
+
+// OpenRevocationList loads the revocation list saved to the given file, creating it if it does not exist yet
+func OpenRevocationList(path string) (*RevocationList, error) {
+	list := NewRevocationList(path)
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return list, list.compact()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Lists saved before revocations were appended are a single object of ids and when they expire
+	if err := json.Unmarshal(data, &list.revoked); err != nil {
+		list.revoked = make(map[string]time.Time)
+		lines := bytes.Split(bytes.TrimSpace(data), []byte("\n"))
+		for i, line := range lines {
+			var entry revocation
+			if err := json.Unmarshal(line, &entry); err != nil {
+				// Only the last revocation can have been cut short, by a crash while it was appended
+				if i == len(lines)-1 {
+					break
+				}
+				return nil, err
+			}
+			list.revoked[entry.ID] = entry.ExpiresAt
+		}
+	}
+	return list, list.compact()
+}
+
+// Revoke revokes a token or session id until the time it expires
+func (l *RevocationList) Revoke(id string, expiresAt time.Time) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.add(id, expiresAt)
+}
+
+// RevokeIfNew revokes a token or session id until the time it expires, reporting false if it was already revoked.
+// Checking and revoking are done at once, so of several callers revoking the same id only one is told it was new.
+func (l *RevocationList) RevokeIfNew(id string, expiresAt time.Time) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.isRevoked(id) {
+		return false, nil
+	}
+	return true, l.add(id, expiresAt)
+}
+
+// IsRevoked checks if a token or session id has been revoked
+func (l *RevocationList) IsRevoked(id string) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.isRevoked(id)
+}
+
+// Ids that have expired are no longer revoked, since they can no longer be used anyway. The caller must hold the lock.
+func (l *RevocationList) isRevoked(id string) bool {
+	expiresAt, exists := l.revoked[id]
+	return exists && time.Now().Before(expiresAt)
+}
+
+// add revokes an id and appends it to the file, compacting the file instead once it is due. The caller must hold
+// the lock.
+func (l *RevocationList) add(id string, expiresAt time.Time) error {
+	if !time.Now().Before(expiresAt) {
+		return nil
+	}
+	l.revoked[id] = expiresAt
+	if time.Since(l.compacted) >= revocationCompactInterval {
+		return l.compact()
+	}
+	if l.path == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(revocation{ID: id, ExpiresAt: expiresAt})
+	if err != nil {
+		return err
+	}
+	file, err := os.OpenFile(l.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(append(data, '\n')); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// compact drops the ids that have expired, since they can no longer be used, and rewrites the file with those left.
+// The caller must hold the lock.
+func (l *RevocationList) compact() error {
+	now := time.Now()
+	l.compacted = now
+	for id, expiresAt := range l.revoked {
+		if !now.Before(expiresAt) {
+			delete(l.revoked, id)
+		}
+	}
+	if l.path == "" {
+		return nil
+	}
+
+	var data bytes.Buffer
+	encoder := json.NewEncoder(&data)
+	for id, expiresAt := range l.revoked {
+		if err := encoder.Encode(revocation{ID: id, ExpiresAt: expiresAt}); err != nil {
+			return err
+		}
+	}
+	return writeFileAtomic(l.path, data.Bytes())
+}
+
+// writeFileAtomic replaces the contents of a file, readable only by its owner. It writes to a temporary file first
+// so that a crash never leaves a half written file behind.
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
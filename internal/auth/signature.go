@@ -0,0 +1,170 @@
+package auth
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+var (
+	ErrUnsupportedKey   = errors.New("unsupported public key")
+	ErrInvalidSignature = errors.New("invalid signature")
+)
+
+var (
+	oidRSASSAPSS = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 10} // RSA keys that may only make PSS signatures
+	oidSHA256    = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+)
+
+// RSAPSSPublicKey is an RSA key that may only be used for PSS signatures, as its PKIX algorithm says
+type RSAPSSPublicKey struct {
+	*rsa.PublicKey
+}
+
+// The PKIX encoding of a public key, kept as it is so that keys x509 does not know how to parse can be
+type subjectPublicKeyInfo struct {
+	Algorithm pkix.AlgorithmIdentifier
+	PublicKey asn1.BitString
+}
+
+// The parameters an id-RSASSA-PSS key can restrict its signatures to, see RFC 4055
+type pssParameters struct {
+	Hash         pkix.AlgorithmIdentifier `asn1:"explicit,optional,tag:0"`
+	MGF          asn1.RawValue            `asn1:"explicit,optional,tag:1"`
+	SaltLength   int                      `asn1:"explicit,optional,tag:2,default:20"`
+	TrailerField int                      `asn1:"explicit,optional,tag:3,default:1"`
+}
+
+// ParsePublicKey parses a PEM encoded PKIX public key, checking that it is of a type that can be used to log in with.
+// Supported keys are Ed25519, ECDSA on P-256 or P-384, and RSA, including keys that may only make PSS signatures.
+func ParsePublicKey(publicKeyPEM string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(publicKeyPEM))
+	if block == nil {
+		return nil, fmt.Errorf("%w: not PEM encoded", ErrUnsupportedKey)
+	}
+
+	// x509 cannot parse RSA keys that may only make PSS signatures
+	var info subjectPublicKeyInfo
+	if rest, err := asn1.Unmarshal(block.Bytes, &info); err == nil && len(rest) == 0 && info.Algorithm.Algorithm.Equal(oidRSASSAPSS) {
+		return parsePSSPublicKey(info)
+	}
+
+	pubKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedKey, err)
+	}
+
+	switch key := pubKey.(type) {
+	case ed25519.PublicKey, *rsa.PublicKey:
+		return key, nil
+	case *ecdsa.PublicKey:
+		if key.Curve != elliptic.P256() && key.Curve != elliptic.P384() {
+			return nil, fmt.Errorf("%w: ECDSA curve %s", ErrUnsupportedKey, key.Curve.Params().Name)
+		}
+		return key, nil
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedKey, pubKey)
+	}
+}
+
+/**
+ * Parse an id-RSASSA-PSS key. A key without parameters can make PSS signatures with any hash, otherwise it has to be
+ * restricted to SHA-256, the hash signatures are checked with. The rest of its parameters are left to rsa.VerifyPSS.
+ */
+func parsePSSPublicKey(info subjectPublicKeyInfo) (*RSAPSSPublicKey, error) {
+	params := info.Algorithm.Parameters
+	if len(params.FullBytes) > 0 && !bytes.Equal(params.FullBytes, asn1.NullBytes) {
+		var restrictions pssParameters
+		if rest, err := asn1.Unmarshal(params.FullBytes, &restrictions); err != nil || len(rest) > 0 {
+			return nil, fmt.Errorf("%w: invalid RSASSA-PSS parameters", ErrUnsupportedKey)
+		}
+		// Keys that do not name a hash are restricted to SHA-1
+		if !restrictions.Hash.Algorithm.Equal(oidSHA256) {
+			return nil, fmt.Errorf("%w: RSASSA-PSS key not restricted to SHA-256", ErrUnsupportedKey)
+		}
+	}
+
+	key, err := x509.ParsePKCS1PublicKey(info.PublicKey.RightAlign())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedKey, err)
+	}
+	return &RSAPSSPublicKey{key}, nil
+}
+
+// VerifySignature checks that the base64 signature of the base64 challenge was made with the private half of the key.
+// Ed25519 signs the challenge itself. ECDSA signs its SHA-256 hash on P-256 or its SHA-384 hash on P-384,
+// encoded as either ASN.1 or the raw r and s. RSA signs its SHA-256 hash with either PKCS #1 v1.5 or PSS, or only with
+// PSS if the key says so.
+func VerifySignature(challenge, signatureBase64, publicKeyPEM string) error {
+	pubKey, err := ParsePublicKey(publicKeyPEM)
+	if err != nil {
+		return err
+	}
+
+	challengeBytes, err := base64.StdEncoding.DecodeString(challenge)
+	if err != nil {
+		return fmt.Errorf("%w: challenge is not base64", ErrInvalidSignature)
+	}
+	signature, err := base64.StdEncoding.DecodeString(signatureBase64)
+	if err != nil {
+		return fmt.Errorf("%w: signature is not base64", ErrInvalidSignature)
+	}
+
+	var valid bool
+	switch key := pubKey.(type) {
+	case ed25519.PublicKey:
+		valid = ed25519.Verify(key, challengeBytes, signature)
+	case *ecdsa.PublicKey:
+		valid = verifyECDSA(key, challengeBytes, signature)
+	case *rsa.PublicKey:
+		hashed := sha256.Sum256(challengeBytes)
+		valid = rsa.VerifyPKCS1v15(key, crypto.SHA256, hashed[:], signature) == nil ||
+			rsa.VerifyPSS(key, crypto.SHA256, hashed[:], signature, nil) == nil
+	case *RSAPSSPublicKey:
+		hashed := sha256.Sum256(challengeBytes)
+		valid = rsa.VerifyPSS(key.PublicKey, crypto.SHA256, hashed[:], signature, nil) == nil
+	}
+
+	if !valid {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// verifyECDSA checks an ECDSA signature, made over the hash that matches the size of the key's curve
+func verifyECDSA(key *ecdsa.PublicKey, message, signature []byte) bool {
+	var hashed []byte
+	if key.Curve == elliptic.P384() {
+		sum := sha512.Sum384(message)
+		hashed = sum[:]
+	} else {
+		sum := sha256.Sum256(message)
+		hashed = sum[:]
+	}
+
+	if ecdsa.VerifyASN1(key, hashed, signature) {
+		return true
+	}
+
+	// Web Crypto gives signatures as the raw r and s, one after the other
+	size := (key.Curve.Params().BitSize + 7) / 8
+	if len(signature) != 2*size {
+		return false
+	}
+	r := new(big.Int).SetBytes(signature[:size])
+	s := new(big.Int).SetBytes(signature[size:])
+	return ecdsa.Verify(key, hashed, r, s)
+}
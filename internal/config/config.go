@@ -1,43 +1,520 @@
 package config
 
 import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
 	"log"
+	"net"
 	"os"
 	"path/filepath"
-	"runtime"
-
+	"strconv"
+	"strings"
 	"sync"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/joho/godotenv"
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/yaml.v3"
+)
+
+/**
+ * Config is every setting of a Sector node. Each setting is taken from the first of these that sets it:
+ *
+ * => Command line flags, such as -addr
+ * => Environment variables, such as LISTEN_ADDRESS, including those set in a .env file
+ * => The YAML config file given by -config or SECTOR_CONFIG
+ * => The defaults, see Default
+ *
+ * The settings, along with their variables and flags, are listed in sample.config.yaml.
+ */
+type Config struct {
+	Server     ServerConfig    `yaml:"server"`
+	Database   DatabaseConfig  `yaml:"database"`
+	Auth       AuthConfig      `yaml:"auth"`
+	RateLimits RateLimitConfig `yaml:"rate_limits"`
+	Tracing    TracingConfig   `yaml:"tracing"`
+	Log        LogConfig       `yaml:"log"`
+	Admins     []uuid.UUID     `yaml:"admins"` // The accounts that can use the admin endpoints
+}
+
+// ServerConfig is where the HTTP server listens and which origins can call it from a browser
+type ServerConfig struct {
+	Address        string   `yaml:"address"`
+	AllowedOrigins []string `yaml:"allowed_origins"`
+}
+
+// DatabaseConfig is where the database is kept and how long it waits on its stores
+type DatabaseConfig struct {
+	Cache        string        `yaml:"cache"`         // The directory of the stores and the files kept alongside them
+	IPFSRepo     string        `yaml:"ipfs_repo"`     // The IPFS repo, the one IPFS itself uses if empty
+	StoreTimeout time.Duration `yaml:"store_timeout"` // How long to wait for a store to open
+	MinPeers     int           `yaml:"min_peers"`     // How many peers have to be connected for the node to be ready
+	JobRetention time.Duration `yaml:"job_retention"` // How long finished deletion jobs are kept for
+}
+
+// AuthConfig is how tokens are signed and how long they, and challenges, last
+type AuthConfig struct {
+	JWTAlgorithm        string        `yaml:"jwt_algorithm"`
+	JWTSecret           string        `yaml:"jwt_secret"` // The secret tokens were signed with before there was a keyring
+	JWTRotationInterval time.Duration `yaml:"jwt_rotation_interval"`
+	AccessTokenExpiry   time.Duration `yaml:"access_token_expiry"`
+	RefreshTokenExpiry  time.Duration `yaml:"refresh_token_expiry"`
+	ChallengeTTL        time.Duration `yaml:"challenge_ttl"`
+	ChallengesPerUser   int           `yaml:"challenges_per_user"` // How many challenges one username or key can have outstanding
+	ChallengesTotal     int           `yaml:"challenges_total"`    // How many challenges can be outstanding altogether
+}
+
+// RateLimitConfig is how many requests a client can make to each group of routes
+type RateLimitConfig struct {
+	Auth   RateLimit `yaml:"auth"`   // Logging in and registering, by address
+	Writes RateLimit `yaml:"writes"` // Creating, updating and deleting, by account
+	Search RateLimit `yaml:"search"` // Searches, by account
+}
+
+// RateLimit is how many requests a client can make in a period. They can all be made at once, after which the
+// allowance refills evenly over the period.
+type RateLimit struct {
+	Requests int
+	Per      time.Duration
+}
+
+// ParseRateLimit parses a rate limit written as requests/period, such as 20/1m. A limit of 0 requests, or "off",
+// turns rate limiting off.
+func ParseRateLimit(limit string) (RateLimit, error) {
+	if limit == "off" {
+		return RateLimit{}, nil
+	}
+
+	requests, per, ok := strings.Cut(limit, "/")
+	if !ok {
+		return RateLimit{}, fmt.Errorf("rate limit '%s' is not of the form requests/period", limit)
+	}
+	count, err := strconv.Atoi(requests)
+	if err != nil || count < 0 {
+		return RateLimit{}, fmt.Errorf("rate limit '%s' has an invalid number of requests", limit)
+	}
+	period, err := time.ParseDuration(per)
+	if err != nil || period <= 0 {
+		return RateLimit{}, fmt.Errorf("rate limit '%s' has an invalid period", limit)
+	}
+	return RateLimit{Requests: count, Per: period}, nil
+}
+
+// UnmarshalText parses a rate limit written as requests/period, so that it can be read from a config file
+func (l *RateLimit) UnmarshalText(text []byte) error {
+	limit, err := ParseRateLimit(string(text))
+	if err != nil {
+		return err
+	}
+	*l = limit
+	return nil
+}
+
+// The exporters traces can be sent to
+const (
+	NoExporter     = "none"   // Traces are not recorded
+	OTLPExporter   = "otlp"   // Traces are sent to an OpenTelemetry collector over OTLP/HTTP
+	StdoutExporter = "stdout" // Traces are printed, for debugging locally
 )
 
+// TracingConfig is where the spans of each request are sent, and how many of the requests are traced
+type TracingConfig struct {
+	Exporter    string  `yaml:"exporter"`     // One of none, otlp or stdout
+	Endpoint    string  `yaml:"endpoint"`     // The host:port of the OTLP collector, the OTEL_EXPORTER_OTLP_ENDPOINT if empty
+	Insecure    bool    `yaml:"insecure"`     // Send to the OTLP collector over plain HTTP
+	SampleRatio float64 `yaml:"sample_ratio"` // The fraction of requests traced, when the caller has not already decided
+}
+
+// The formats logs can be written in
+const (
+	JSONLogFormat    = "json"    // One JSON object per line, for log collectors
+	ConsoleLogFormat = "console" // Tab separated, for people reading the file
+)
+
+// LogConfig is where, and how much, a node logs, and when its log files are rotated
+type LogConfig struct {
+	File       string        `yaml:"file"`
+	Format     string        `yaml:"format"`      // One of json or console
+	Level      zapcore.Level `yaml:"level"`       // The least severe level logged, such as debug or info
+	MaxSize    int           `yaml:"max_size"`    // How many megabytes a file grows to before it is rotated, never if 0
+	MaxAge     time.Duration `yaml:"max_age"`     // How long rotated files are kept, forever if 0
+	MaxBackups int           `yaml:"max_backups"` // How many rotated files are kept, all of them if 0
+}
+
+// Default gets the settings used when nothing else sets them
+func Default() Config {
+	return Config{
+		Server: ServerConfig{
+			Address:        "127.0.0.1:3000",
+			AllowedOrigins: []string{"http://localhost:8000"},
+		},
+		Database: DatabaseConfig{
+			Cache:        "cache",
+			StoreTimeout: time.Second * 600,
+			MinPeers:     0,
+			JobRetention: time.Hour * 24 * 7,
+		},
+		Auth: AuthConfig{
+			JWTAlgorithm:        "HS256",
+			JWTRotationInterval: time.Hour * 24 * 7,
+			AccessTokenExpiry:   time.Minute * 15,
+			RefreshTokenExpiry:  time.Hour * 24 * 30,
+			ChallengeTTL:        time.Minute * 5,
+			ChallengesPerUser:   5,
+			ChallengesTotal:     10000,
+		},
+		RateLimits: RateLimitConfig{
+			Auth:   RateLimit{Requests: 20, Per: time.Minute},
+			Writes: RateLimit{Requests: 120, Per: time.Minute},
+			Search: RateLimit{Requests: 60, Per: time.Minute},
+		},
+		Tracing: TracingConfig{
+			Exporter:    NoExporter,
+			SampleRatio: 1,
+		},
+		Log: LogConfig{
+			File:       "log.txt",
+			Format:     ConsoleLogFormat,
+			Level:      zapcore.InfoLevel,
+			MaxSize:    100,
+			MaxAge:     30 * 24 * time.Hour,
+			MaxBackups: 5,
+		},
+		Admins: []uuid.UUID{},
+	}
+}
+
+// A setting that can be set by an environment variable and, if it has one, a flag
+type setting struct {
+	env   string
+	flag  string
+	usage string
+	set   func(c *Config, value string) error
+}
+
+var settings = []setting{
+	{"LISTEN_ADDRESS", "addr", "the address to listen on", func(c *Config, v string) error {
+		c.Server.Address = v
+		return nil
+	}},
+	{"CORS_ORIGINS", "cors-origins", "the comma separated origins browsers can call the API from", func(c *Config, v string) error {
+		c.Server.AllowedOrigins = splitList(v)
+		return nil
+	}},
+	{"LOG_FILE", "log", "the file to log to", func(c *Config, v string) error {
+		c.Log.File = v
+		return nil
+	}},
+	{"LOG_FORMAT", "log-format", "how to write logs, json or console", func(c *Config, v string) error {
+		c.Log.Format = v
+		return nil
+	}},
+	{"LOG_LEVEL", "log-level", "the least severe level to log, debug, info, warn or error", func(c *Config, v string) error {
+		return c.Log.Level.UnmarshalText([]byte(v))
+	}},
+	{"LOG_MAX_SIZE", "log-max-size", "how many megabytes the log file grows to before it is rotated, never if 0", intSetting(func(c *Config) *int {
+		return &c.Log.MaxSize
+	})},
+	{"LOG_MAX_AGE", "log-max-age", "how long rotated log files are kept, forever if 0", durationSetting(func(c *Config) *time.Duration {
+		return &c.Log.MaxAge
+	})},
+	{"LOG_MAX_BACKUPS", "log-max-backups", "how many rotated log files are kept, all of them if 0", intSetting(func(c *Config) *int {
+		return &c.Log.MaxBackups
+	})},
+	{"DB_CACHE", "cache", "the directory of the database", func(c *Config, v string) error {
+		c.Database.Cache = v
+		return nil
+	}},
+	{"IPFS_REPO", "ipfs-repo", "the IPFS repo, the one IPFS itself uses if empty", func(c *Config, v string) error {
+		c.Database.IPFSRepo = v
+		return nil
+	}},
+	{"STORE_TIMEOUT", "store-timeout", "how long to wait for a store to open", durationSetting(func(c *Config) *time.Duration {
+		return &c.Database.StoreTimeout
+	})},
+	{"MIN_PEERS", "min-peers", "how many peers have to be connected for the node to be ready", intSetting(func(c *Config) *int {
+		return &c.Database.MinPeers
+	})},
+	{"JOB_RETENTION", "job-retention", "how long finished deletion jobs are kept for", durationSetting(func(c *Config) *time.Duration {
+		return &c.Database.JobRetention
+	})},
+	{"JWT_ALGORITHM", "jwt-algorithm", "what new signing keys sign with, HS256, EdDSA or ES256", func(c *Config, v string) error {
+		c.Auth.JWTAlgorithm = v
+		return nil
+	}},
+	// Secrets are not taken as flags, where they would show up in the list of processes
+	{"JWT_SECRET", "", "", func(c *Config, v string) error {
+		c.Auth.JWTSecret = v
+		return nil
+	}},
+	{"JWT_ROTATION_INTERVAL", "jwt-rotation-interval", "how long a signing key signs for before it is replaced", durationSetting(func(c *Config) *time.Duration {
+		return &c.Auth.JWTRotationInterval
+	})},
+	{"ACCESS_TOKEN_EXPIRY", "access-token-expiry", "how long an access token lasts", durationSetting(func(c *Config) *time.Duration {
+		return &c.Auth.AccessTokenExpiry
+	})},
+	{"REFRESH_TOKEN_EXPIRY", "refresh-token-expiry", "how long a refresh token lasts", durationSetting(func(c *Config) *time.Duration {
+		return &c.Auth.RefreshTokenExpiry
+	})},
+	{"CHALLENGE_TTL", "challenge-ttl", "how long a login or registration challenge can be answered for", durationSetting(func(c *Config) *time.Duration {
+		return &c.Auth.ChallengeTTL
+	})},
+	{"CHALLENGES_PER_USER", "challenges-per-user", "how many challenges a username or key can have outstanding", intSetting(func(c *Config) *int {
+		return &c.Auth.ChallengesPerUser
+	})},
+	{"CHALLENGES_TOTAL", "challenges-total", "how many challenges can be outstanding altogether", intSetting(func(c *Config) *int {
+		return &c.Auth.ChallengesTotal
+	})},
+	{"RATE_LIMIT_AUTH", "rate-limit-auth", "the rate limit of logging in and registering, as requests/period or off", rateLimitSetting(func(c *Config) *RateLimit {
+		return &c.RateLimits.Auth
+	})},
+	{"RATE_LIMIT_WRITES", "rate-limit-writes", "the rate limit of writes, as requests/period or off", rateLimitSetting(func(c *Config) *RateLimit {
+		return &c.RateLimits.Writes
+	})},
+	{"RATE_LIMIT_SEARCH", "rate-limit-search", "the rate limit of searches, as requests/period or off", rateLimitSetting(func(c *Config) *RateLimit {
+		return &c.RateLimits.Search
+	})},
+	{"TRACING_EXPORTER", "tracing-exporter", "where to send traces, none, otlp or stdout", func(c *Config, v string) error {
+		c.Tracing.Exporter = v
+		return nil
+	}},
+	{"TRACING_ENDPOINT", "tracing-endpoint", "the host:port of the OTLP collector", func(c *Config, v string) error {
+		c.Tracing.Endpoint = v
+		return nil
+	}},
+	{"TRACING_INSECURE", "tracing-insecure", "send traces to the OTLP collector over plain HTTP, true or false", func(c *Config, v string) error {
+		insecure, err := strconv.ParseBool(v)
+		if err != nil {
+			return err
+		}
+		c.Tracing.Insecure = insecure
+		return nil
+	}},
+	{"TRACING_SAMPLE_RATIO", "tracing-sample-ratio", "the fraction of requests to trace, from 0 to 1", func(c *Config, v string) error {
+		ratio, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return err
+		}
+		c.Tracing.SampleRatio = ratio
+		return nil
+	}},
+	{"ADMIN_ACCOUNTS", "admins", "the comma separated ids of the accounts that can use the admin endpoints", func(c *Config, v string) error {
+		admins := make([]uuid.UUID, 0)
+		for _, id := range splitList(v) {
+			admin, err := uuid.Parse(id)
+			if err != nil {
+				return err
+			}
+			admins = append(admins, admin)
+		}
+		c.Admins = admins
+		return nil
+	}},
+}
+
+/**
+ * Load the settings from the defaults, the config file at the path if one is given, then the environment, and check
+ * them. The .env file is loaded into the environment first.
+ */
+func Load(path string) (Config, error) {
+	config, err := load(path)
+	if err != nil {
+		return config, err
+	}
+	return config, config.Validate()
+}
+
+// Load the settings without checking them, so that flags can still change them
+func load(path string) (Config, error) {
+	LoadEnv()
+
+	config := Default()
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return config, fmt.Errorf("cannot read config file: %w", err)
+		}
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&config); err != nil {
+			return config, fmt.Errorf("invalid config file '%s': %w", path, err)
+		}
+	}
+
+	for _, s := range settings {
+		if value := os.Getenv(s.env); value != "" {
+			if err := s.set(&config, value); err != nil {
+				return config, fmt.Errorf("invalid %s: %w", s.env, err)
+			}
+		}
+	}
+	return config, nil
+}
+
+/**
+ * Add the -config flag, and a flag for each setting that has one, to the flag set. The function returned loads the
+ * settings once the flags are parsed, with the flags that were given taking precedence over everything else.
+ */
+func AddFlags(flags *flag.FlagSet) func() (Config, error) {
+	path := flags.String("config", os.Getenv("SECTOR_CONFIG"), "the YAML config file, SECTOR_CONFIG by default")
+	for _, s := range settings {
+		if s.flag != "" {
+			flags.String(s.flag, "", s.usage+", "+s.env+" by default")
+		}
+	}
+
+	return func() (Config, error) {
+		config, err := load(*path)
+		if err != nil {
+			return config, err
+		}
+
+		flags.Visit(func(f *flag.Flag) {
+			for _, s := range settings {
+				if s.flag == f.Name && err == nil {
+					if setErr := s.set(&config, f.Value.String()); setErr != nil {
+						err = fmt.Errorf("invalid -%s: %w", s.flag, setErr)
+					}
+				}
+			}
+		})
+		if err != nil {
+			return config, err
+		}
+		return config, config.Validate()
+	}
+}
+
+// Validate checks that the settings can be used, reporting every one that cannot
+func (c Config) Validate() error {
+	var errs []error
+	if _, _, err := net.SplitHostPort(c.Server.Address); err != nil {
+		errs = append(errs, fmt.Errorf("server address: %w", err))
+	}
+	if c.Database.Cache == "" {
+		errs = append(errs, errors.New("database cache: must be set"))
+	}
+	if c.Database.StoreTimeout <= 0 {
+		errs = append(errs, errors.New("database store timeout: must be positive"))
+	}
+	if c.Database.MinPeers < 0 {
+		errs = append(errs, errors.New("database min peers: must not be negative"))
+	}
+	if c.Database.JobRetention <= 0 {
+		errs = append(errs, errors.New("database job retention: must be positive"))
+	}
+	if c.Log.File == "" {
+		errs = append(errs, errors.New("log file: must be set"))
+	}
+	switch c.Log.Format {
+	case JSONLogFormat, ConsoleLogFormat:
+	default:
+		errs = append(errs, fmt.Errorf("log format: '%s' is not one of json or console", c.Log.Format))
+	}
+	if c.Log.MaxSize < 0 || c.Log.MaxAge < 0 || c.Log.MaxBackups < 0 {
+		errs = append(errs, errors.New("log rotation: the max size, age and backups must not be negative"))
+	}
+	switch c.Auth.JWTAlgorithm {
+	case "HS256", "EdDSA", "ES256":
+	default:
+		errs = append(errs, fmt.Errorf("jwt algorithm: '%s' is not one of HS256, EdDSA or ES256", c.Auth.JWTAlgorithm))
+	}
+	durations := map[string]time.Duration{
+		"jwt rotation interval": c.Auth.JWTRotationInterval,
+		"access token expiry":   c.Auth.AccessTokenExpiry,
+		"refresh token expiry":  c.Auth.RefreshTokenExpiry,
+		"challenge ttl":         c.Auth.ChallengeTTL,
+	}
+	for name, duration := range durations {
+		if duration <= 0 {
+			errs = append(errs, fmt.Errorf("%s: must be positive", name))
+		}
+	}
+	if c.Auth.ChallengesPerUser <= 0 || c.Auth.ChallengesTotal <= 0 {
+		errs = append(errs, errors.New("challenges: the per user and total limits must be positive"))
+	}
+	if c.Auth.AccessTokenExpiry > c.Auth.RefreshTokenExpiry {
+		errs = append(errs, errors.New("access token expiry: must not be longer than the refresh token expiry"))
+	}
+	switch c.Tracing.Exporter {
+	case NoExporter, OTLPExporter, StdoutExporter:
+	default:
+		errs = append(errs, fmt.Errorf("tracing exporter: '%s' is not one of none, otlp or stdout", c.Tracing.Exporter))
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		errs = append(errs, errors.New("tracing sample ratio: must be from 0 to 1"))
+	}
+	return errors.Join(errs...)
+}
+
 var loadOnce sync.Once
 
-// LoadEnv loads the .env file once, safely
+/**
+ * LoadEnv loads the .env file once, looking for it in the working directory and then next to the executable.
+ * Variables that are already set are not replaced.
+ */
 func LoadEnv() {
 	loadOnce.Do(func() {
-		// Find the root directory of the project (module) dynamically
-		_, b, _, _ := runtime.Caller(0)
+		paths := []string{".env"}
+		if executable, err := os.Executable(); err == nil {
+			paths = append(paths, filepath.Join(filepath.Dir(executable), ".env"))
+		}
 
-		// Go up 2 levels to the root directory
-		basePath := filepath.Join(filepath.Dir(b), "../../")
+		for _, path := range paths {
+			if _, err := os.Stat(path); err == nil {
+				if err := godotenv.Load(path); err != nil {
+					log.Println("failed to load", path, err)
+				}
+				return
+			}
+		}
+		log.Println(".env file not found, falling back to OS environment")
+	})
+}
 
-		// Define the absolute path to the .env file
-		envPath := filepath.Join(basePath, ".env")
+func durationSetting(field func(c *Config) *time.Duration) func(c *Config, value string) error {
+	return func(c *Config, value string) error {
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		*field(c) = duration
+		return nil
+	}
+}
 
-		// Try loading the .env file from the root directory
-		err := godotenv.Load(envPath)
+func intSetting(field func(c *Config) *int) func(c *Config, value string) error {
+	return func(c *Config, value string) error {
+		n, err := strconv.Atoi(value)
 		if err != nil {
-			log.Println(".env file not found or failed to load, falling back to OS environment")
+			return err
 		}
-	})
+		*field(c) = n
+		return nil
+	}
 }
 
-// GetEnv fetches an env variable and optionally returns a default
-func GetEnv(key string, fallback ...string) string {
-	val := os.Getenv(key)
-	if val == "" && len(fallback) > 0 {
-		return fallback[0]
+func rateLimitSetting(field func(c *Config) *RateLimit) func(c *Config, value string) error {
+	return func(c *Config, value string) error {
+		limit, err := ParseRateLimit(value)
+		if err != nil {
+			return err
+		}
+		*field(c) = limit
+		return nil
+	}
+}
+
+// Split a comma separated list, leaving out empty items
+func splitList(value string) []string {
+	items := make([]string, 0)
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
 	}
-	return val
+	return items
 }
@@ -0,0 +1,63 @@
+package database
+
+// The kind of change made to a document.
+type ChangeKind string
+
+const (
+	Created ChangeKind = "created"
+	Updated ChangeKind = "updated"
+	Deleted ChangeKind = "deleted"
+)
+
+// A change made to a single document of a store, either by a local write or by a peer we replicated from.
+type Change struct {
+	Store    string
+	Kind     ChangeKind
+	Id       string
+	Document map[string]interface{} // The document after the change, nil when it was deleted
+	Fields   map[string][]string    // The indexed values of the document, from before the change when it was deleted
+}
+
+// Register a function to be told about every change made to a document in any of the stores.
+// It is called synchronously with the write that made the change, so it should not block.
+func (db *Database) OnChange(listener func(Change)) {
+	db.listenersMu.Lock()
+	defer db.listenersMu.Unlock()
+
+	db.listeners = append(db.listeners, listener)
+}
+
+func (db *Database) notify(change Change) {
+	db.listenersMu.RLock()
+	listeners := db.listeners
+	db.listenersMu.RUnlock()
+
+	for _, listener := range listeners {
+		listener(change)
+	}
+}
+
+// Index a document of the named store that was just written, telling the listeners about it if notify is set.
+func (db *Database) index(name string, doc map[string]interface{}, notify bool) {
+	id, ok := doc["id"].(string)
+	if !ok {
+		return
+	}
+
+	kind := Created
+	if db.Index(name).Put(doc) {
+		kind = Updated
+	}
+	if notify {
+		fields, _ := db.Index(name).Values(id)
+		db.notify(Change{Store: name, Kind: kind, Id: id, Document: doc, Fields: fields})
+	}
+}
+
+// Unindex a document of the named store that was just deleted, telling the listeners about it if notify is set.
+func (db *Database) unindex(name, id string, notify bool) {
+	fields, _ := db.Index(name).Values(id)
+	if db.Index(name).Remove(id) && notify {
+		db.notify(Change{Store: name, Kind: Deleted, Id: id, Fields: fields})
+	}
+}
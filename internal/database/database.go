@@ -1,12 +1,18 @@
 package database
 
 import (
+	"Sector/internal/config"
+	"Sector/internal/metrics"
+	"Sector/internal/tracing"
 	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
 
-	"github.com/ipfs/kubo/config"
+	ipfsconfig "github.com/ipfs/kubo/config"
 	core "github.com/ipfs/kubo/core"
 	coreiface "github.com/ipfs/kubo/core/coreiface"
 
@@ -14,29 +20,74 @@ import (
 	"github.com/libp2p/go-libp2p/core/event"
 	"github.com/libp2p/go-libp2p/core/peer"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 
 	orbitdb "berty.tech/go-orbit-db"
 	"berty.tech/go-orbit-db/accesscontroller"
+	"berty.tech/go-orbit-db/iface"
 	"berty.tech/go-orbit-db/stores"
 	"berty.tech/go-orbit-db/stores/documentstore"
+	"berty.tech/go-orbit-db/stores/operation"
 )
 
+// The names of the document stores, one per kind of entity kept in the database.
+const (
+	AccountStore = "accounts"
+	GroupStore   = "groups"
+	ChannelStore = "channels"
+	MessageStore = "messages"
+	JobStore     = "jobs" // Background jobs, such as the deletion of a group and everything in it
+
+	// Documents taken out of the other stores because they refer to something that is gone, kept so they can be
+	// looked at or put back by hand. See Quarantine.
+	QuarantineStore = "quarantine"
+)
+
+// The name of the single store that held every entity before each kind got its own store.
+// It is only opened to migrate its contents into the stores above.
+const LegacyStore = "sectordb"
+
+// Every store the database opens, in the order they are opened.
+var StoreNames = []string{AccountStore, GroupStore, ChannelStore, MessageStore, JobStore, QuarantineStore}
+
+// Returned when a write would give a document the same value of a unique field as another, see uniqueFields.
+var ErrConflict = errors.New("conflicts with an existing document")
+
 // Representation of the database (and related things)
 type Database struct {
 	ctx              context.Context
-	ConnectionString string // The url that was used to attempt connection
-	URI              string // The URI that we connected on
-	LocalPath        string // Where in the local file system we store things
+	ConnectionString string        // The url that was used to attempt connection
+	LocalPath        string        // Where in the local file system we store things
+	RepoPath         string        // The IPFS repo the node runs on, empty for a node kept in memory
+	storeTimeout     time.Duration // How long to wait for a store to open
 
 	Logger *zap.Logger
 
 	IPFSNode    *core.IpfsNode    // The IPFS node the database is running on
 	IPFSCoreAPI coreiface.CoreAPI // The IPFS API the database is running on
 
-	OrbitDB orbitdb.OrbitDB       // The Go-Orbit-DB instance
-	Store   orbitdb.DocumentStore // The document store within the Go-Orbit-DB instance
-	Events  event.Subscription    // Fires an event when Store is ready
+	OrbitDB orbitdb.OrbitDB // The Go-Orbit-DB instance
+
+	// Held to read the maps below, and to replace their entries as Reset does. Read them through Store and Index.
+	storesMu sync.RWMutex
+	uris     map[string]string                // The URI that we connected on, for each store
+	stores   map[string]orbitdb.DocumentStore // The document stores within the Go-Orbit-DB instance, keyed by store name
+	indexes  map[string]*Index                // Secondary indexes over the documents of the store with the same name
+	events   map[string]event.Subscription    // Fires an event when the store with the same name is ready, written to, or replicated
+	onReady  func(address string)             // Called with the address of each store that reports it is ready
+
+	Preferred *PreferredPeers // The peers dialed whenever the database connects, along with the bootstrap peers
+
+	listenersMu sync.RWMutex
+	listeners   []func(Change) // Told about every change made to a document, see OnChange
+
+	uniqueMu sync.Mutex // Held while writing to a store with unique fields, so two writes cannot both claim a value
+
+	healthMu        sync.RWMutex
+	loaded          map[string]bool // The stores whose entries have been loaded and indexed
+	ready           map[string]bool // The stores that have reported they are ready
+	lastReplication time.Time       // When entries were last replicated from a peer, see Health
 }
 
 func (db *Database) init() error {
@@ -53,6 +104,91 @@ func (db *Database) init() error {
 		return err
 	}
 
+	db.healthMu.Lock()
+	db.loaded = make(map[string]bool)
+	db.ready = make(map[string]bool)
+	db.healthMu.Unlock()
+
+	db.storesMu.Lock()
+	db.uris = make(map[string]string)
+	db.stores = make(map[string]orbitdb.DocumentStore)
+	db.indexes = make(map[string]*Index)
+	db.events = make(map[string]event.Subscription)
+	db.storesMu.Unlock()
+	for _, name := range StoreNames {
+		db.Logger.Debug("Initializing OrbitDB.Docs ...", zap.String("store", name))
+		store, err := db.OpenStore(ctx, name)
+		if err != nil {
+			return err
+		}
+
+		db.Logger.Debug("Subscribing to EventBus ...", zap.String("store", name))
+		db.storesMu.Lock()
+		db.stores[name] = store
+		db.indexes[name] = NewIndex(indexedFields[name]...).Unique(uniqueFields[name]...)
+		db.uris[name] = store.Address().String()
+		db.events[name], err = db.watch(name, store)
+		db.storesMu.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+/**
+ * Subscribe to the events of the named store, keeping its index and the health of the database up to date as it
+ * reports it is ready, is written to, and has entries replicated to it. Closing the subscription stops the watching.
+ */
+func (db *Database) watch(name string, store orbitdb.DocumentStore) (event.Subscription, error) {
+	sub, err := store.EventBus().Subscribe([]interface{}{
+		new(stores.EventReady),
+		new(stores.EventWrite),
+		new(stores.EventReplicated),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		for ev := range sub.Out() {
+			db.Logger.Debug("Got event", zap.String("store", name), zap.Any("event", ev))
+			switch e := ev.(type) {
+			case stores.EventReady:
+				db.setHealth(func() { db.ready[name] = true })
+				if db.onReady != nil {
+					db.onReady(e.Address.String())
+				}
+			case stores.EventWrite:
+				db.refreshIndex(name, false, e.Entry)
+			case stores.EventReplicated:
+				db.setHealth(func() { db.lastReplication = time.Now() })
+				metrics.Replications.WithLabelValues(name).Inc()
+				metrics.ReplicatedEntries.WithLabelValues(name).Add(float64(len(e.Entries)))
+				db.refreshIndex(name, true, e.Entries...)
+			}
+		}
+	}()
+	return sub, nil
+}
+
+// The named store, or nil if there is no such store or the database has not connected yet
+func (db *Database) Store(name string) orbitdb.DocumentStore {
+	db.storesMu.RLock()
+	defer db.storesMu.RUnlock()
+	return db.stores[name]
+}
+
+// The index of the named store, or nil if there is no such store or the database has not connected yet
+func (db *Database) Index(name string) *Index {
+	db.storesMu.RLock()
+	defer db.storesMu.RUnlock()
+	return db.indexes[name]
+}
+
+// Open (or create) the document store with the given name.
+func (db *Database) OpenStore(ctx context.Context, name string) (orbitdb.DocumentStore, error) {
 	ac := &accesscontroller.CreateAccessControllerOptions{
 		Access: map[string][]string{
 			"write": {
@@ -61,26 +197,200 @@ func (db *Database) init() error {
 		},
 	}
 
-	addr, err := db.OrbitDB.DetermineAddress(db.ctx, "sectordb", "docstore", &orbitdb.DetermineAddressOptions{})
+	addr, err := db.OrbitDB.DetermineAddress(db.ctx, name, "docstore", &orbitdb.DetermineAddressOptions{})
 	if err != nil {
-		return err
+		return nil, err
 	}
-	db.URI = addr.String()
 
 	storetype := "docstore"
-	db.Logger.Debug("Initializing OrbitDB.Docs ...")
-	db.Store, err = db.OrbitDB.Docs(ctx, db.URI, &orbitdb.CreateDBOptions{
+	return db.OrbitDB.Docs(ctx, addr.String(), &orbitdb.CreateDBOptions{
 		AccessController:  ac,
 		StoreType:         &storetype,
 		StoreSpecificOpts: documentstore.DefaultStoreOptsForMap("id"),
-		Timeout:           time.Second * 600,
+		Timeout:           db.storeTimeout,
 	})
+}
+
+// Drop every store and reopen it empty. Primarily useful for resetting state between tests.
+func (db *Database) Reset() error {
+	for _, name := range StoreNames {
+		if err := db.reset(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/**
+ * Drop the named store and reopen it empty, watching the new store in place of the old one. Opening the new store can
+ * take as long as the store timeout, so the lock is only held to swap it in, until then the dropped store is given
+ * out and anything done with it fails.
+ */
+func (db *Database) reset(name string) error {
+	db.storesMu.RLock()
+	dropped, watcher := db.stores[name], db.events[name]
+	db.storesMu.RUnlock()
+
+	watcher.Close()
+	if err := dropped.Drop(); err != nil {
+		return err
+	}
+	reopened, err := db.OpenStore(context.Background(), name)
+	if err != nil {
+		return err
+	}
+	sub, err := db.watch(name, reopened)
+	if err != nil {
+		reopened.Close()
+		return err
+	}
+
+	db.storesMu.Lock()
+	db.stores[name] = reopened
+	db.uris[name] = reopened.Address().String()
+	db.events[name] = sub
+	db.storesMu.Unlock()
+
+	db.setHealth(func() {
+		db.loaded[name] = false
+		db.ready[name] = false
+	})
+	return db.load(name)
+}
+
+// Load the entries of the named store and index them, after which the store reports it is ready.
+func (db *Database) load(name string) error {
+	if err := db.Store(name).Load(db.ctx, -1); err != nil {
+		return err
+	}
+	if err := db.rebuildIndex(name); err != nil {
+		return err
+	}
+	db.setHealth(func() { db.loaded[name] = true })
+	return nil
+}
+
+// Put a document into the named store and index it.
+// Fails with ErrConflict if another document of the store already has the value of one of its unique fields.
+func (db *Database) Put(ctx context.Context, name string, doc map[string]interface{}) (operation.Operation, error) {
+	ctx, span := tracing.Start(ctx, "Database.Put", attribute.String("store", name))
+	defer span.End()
+
+	if len(uniqueFields[name]) > 0 {
+		db.uniqueMu.Lock()
+		defer db.uniqueMu.Unlock()
+
+		if err := db.checkUnique(name, doc); err != nil {
+			return nil, err
+		}
+	}
+
+	op, err := db.Store(name).Put(ctx, doc)
+	if err != nil {
+		return nil, err
+	}
+	db.index(name, doc, true)
+	return op, nil
+}
+
+// Put several documents into the named store at once and index them.
+// Fails with ErrConflict if any of them would share the value of a unique field, see Put.
+func (db *Database) PutAll(ctx context.Context, name string, docs []interface{}) (operation.Operation, error) {
+	ctx, span := tracing.Start(ctx, "Database.PutAll", attribute.String("store", name), attribute.Int("documents", len(docs)))
+	defer span.End()
+
+	if len(uniqueFields[name]) > 0 {
+		db.uniqueMu.Lock()
+		defer db.uniqueMu.Unlock()
+
+		entries := make([]map[string]interface{}, 0, len(docs))
+		for _, doc := range docs {
+			if entry, ok := doc.(map[string]interface{}); ok {
+				entries = append(entries, entry)
+			}
+		}
+		if err := db.checkUnique(name, entries...); err != nil {
+			return nil, err
+		}
+	}
+
+	op, err := db.Store(name).PutAll(ctx, docs)
+	if err != nil {
+		return nil, err
+	}
+	for _, doc := range docs {
+		if entry, ok := doc.(map[string]interface{}); ok {
+			db.index(name, entry, true)
+		}
+	}
+	return op, nil
+}
+
+// Check that none of the documents claim the value of a unique field that another document of the named store
+// already has, or that another of the documents claims too. Values are compared ignoring case. The caller must
+// hold uniqueMu.
+func (db *Database) checkUnique(name string, docs ...map[string]interface{}) error {
+	claimed := make(map[[2]string]string) // The id of the document claiming each field and value
+	for _, doc := range docs {
+		id, _ := doc["id"].(string)
+		for field, value := range db.Index(name).Claims(doc) {
+			for _, other := range db.Index(name).LookupFold(field, value) {
+				if other != id {
+					return fmt.Errorf("%w: %s '%v' is taken", ErrConflict, field, doc[field])
+				}
+			}
+			if other, ok := claimed[[2]string{field, value}]; ok && other != id {
+				return fmt.Errorf("%w: %s '%v' is given more than once", ErrConflict, field, doc[field])
+			}
+			claimed[[2]string{field, value}] = id
+		}
+	}
+	return nil
+}
+
+// Delete the document with the given id from the named store and its index.
+func (db *Database) Delete(ctx context.Context, name string, id string) (operation.Operation, error) {
+	ctx, span := tracing.Start(ctx, "Database.Delete", attribute.String("store", name), attribute.String("id", id))
+	defer span.End()
+
+	op, err := db.Store(name).Delete(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	db.unindex(name, id, true)
+	return op, nil
+}
+
+// Move the document with the given id out of the named store and into the quarantine store, recording where it came
+// from, when, and why. It is written to the quarantine store before it is deleted, so it is never lost in between.
+func (db *Database) Quarantine(ctx context.Context, name, id, reason string) error {
+	ctx, span := tracing.Start(ctx, "Database.Quarantine", attribute.String("store", name), attribute.String("id", id))
+	defer span.End()
+
+	matches, err := db.Store(name).Get(ctx, id, &iface.DocumentStoreGetOptions{})
 	if err != nil {
 		return err
 	}
+	if len(matches) != 1 {
+		return fmt.Errorf("cannot quarantine '%s' from %s: found %d documents", id, name, len(matches))
+	}
+	doc, ok := matches[0].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("cannot quarantine '%s' from %s: not a document", id, name)
+	}
+
+	quarantined := make(map[string]interface{}, len(doc)+3)
+	for key, value := range doc {
+		quarantined[key] = value
+	}
+	quarantined["quarantined_from"] = name
+	quarantined["quarantined_at"] = time.Now().Format(time.RFC3339Nano)
+	quarantined["quarantine_reason"] = reason
 
-	db.Logger.Debug("Subscribing to EventBus ...")
-	db.Events, err = db.Store.EventBus().Subscribe(new(stores.EventReady))
+	if _, err := db.Put(ctx, QuarantineStore, quarantined); err != nil {
+		return err
+	}
+	_, err = db.Delete(ctx, name, id)
 	return err
 }
 
@@ -100,7 +410,7 @@ func (db *Database) GetOwnPubKey() crypto.PubKey {
 func (db *Database) connectToPeers() error {
 	var wg sync.WaitGroup
 
-	peerInfos, err := config.DefaultBootstrapPeers()
+	peerInfos, err := ipfsconfig.DefaultBootstrapPeers()
 	if err != nil {
 		return err
 	}
@@ -109,7 +419,9 @@ func (db *Database) connectToPeers() error {
 	for _, peerInfo := range peerInfos {
 		go func(peerInfo *peer.AddrInfo) {
 			defer wg.Done()
-			err := db.IPFSCoreAPI.Swarm().Connect(db.ctx, *peerInfo)
+			ctx, cancel := context.WithTimeout(db.ctx, peerDialTimeout)
+			defer cancel()
+			err := db.IPFSCoreAPI.Swarm().Connect(ctx, *peerInfo)
 			if err != nil {
 				db.Logger.Error("Failed to connect", zap.String("peerID", peerInfo.ID.String()), zap.Error(err))
 			} else {
@@ -118,23 +430,35 @@ func (db *Database) connectToPeers() error {
 		}(&peerInfo)
 	}
 	wg.Wait()
+
+	// Not waited for, the stores can be loaded while the preferred peers are dialed
+	db.ConnectToPreferredPeers()
 	return nil
 }
 
-func NewDatabase(ctx context.Context, dbLocalPath string, logger *zap.Logger) (*Database, error) {
+func NewDatabase(ctx context.Context, cfg config.DatabaseConfig, logger *zap.Logger) (*Database, error) {
 	var err error
 
 	db := new(Database)
 	db.ctx = ctx
-	db.LocalPath = dbLocalPath
+	db.LocalPath = cfg.Cache
+	db.storeTimeout = cfg.StoreTimeout
 	db.Logger = logger
 
-	db.Logger.Debug("Getting config root path ...")
-	defaultPath, err := config.PathRoot()
+	db.Preferred, err = OpenPreferredPeers(filepath.Join(cfg.Cache, "preferred_peers.json"))
 	if err != nil {
 		return nil, err
 	}
 
+	defaultPath := cfg.IPFSRepo
+	if defaultPath == "" {
+		db.Logger.Debug("Getting config root path ...")
+		defaultPath, err = ipfsconfig.PathRoot()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	db.Logger.Debug("Setting up plugins ...")
 	if err := setupPlugins(defaultPath); err != nil {
 		return nil, err
@@ -143,7 +467,7 @@ func NewDatabase(ctx context.Context, dbLocalPath string, logger *zap.Logger) (*
 	db.Logger.Debug("Creating IPFS repo ...")
 	repoPath, err := createRepo(defaultPath)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 
 	db.Logger.Debug("Creating IPFS node ...")
@@ -151,17 +475,25 @@ func NewDatabase(ctx context.Context, dbLocalPath string, logger *zap.Logger) (*
 	if err != nil {
 		return nil, err
 	}
+	db.RepoPath = repoPath
 
 	return db, nil
 }
 
 // Create a new testing database instance
-func NewTestingDatabase(ctx context.Context, dbLocalPath string, logger *zap.Logger, t *testing.T) (*Database, error) {
+func NewTestingDatabase(ctx context.Context, cfg config.DatabaseConfig, logger *zap.Logger, t *testing.T) (*Database, error) {
 	db := new(Database)
 	db.ctx = ctx
-	db.LocalPath = dbLocalPath
+	db.LocalPath = cfg.Cache
+	db.storeTimeout = cfg.StoreTimeout
 	db.Logger = logger
 
+	preferred, err := OpenPreferredPeers(filepath.Join(cfg.Cache, "preferred_peers.json"))
+	if err != nil {
+		return nil, err
+	}
+	db.Preferred = preferred
+
 	// Setup the IPFS mock instance (TODO: test this and figure out if cleanup is done properly)
 	mocknet := testingMockNet(t)
 	db.IPFSNode, _ = testingIPFSNode(ctx, t, mocknet)
@@ -175,37 +507,26 @@ func (db *Database) Connect(onReady func(address string)) error {
 	db.Logger.Info("Connecting to peers ...")
 	err = db.connectToPeers()
 	if err != nil {
-		db.Logger.Error("Failed to connect: %s", zap.Error(err))
+		db.Logger.Error("Failed to connect to peers", zap.Error(err))
 	} else {
 		db.Logger.Debug("Connected to peer!")
 	}
 
 	db.Logger.Info("Initializing database connection ...")
+	db.onReady = onReady
 	err = db.init()
 	if err != nil {
-		db.Logger.Error("%s", zap.Error(err))
+		db.Logger.Error("Failed to initialize database", zap.Error(err))
 		return err
 	}
 
 	db.Logger.Info("Running ...")
-	go func() {
-		for {
-			for ev := range db.Events.Out() {
-				db.Logger.Debug("Got event", zap.Any("event", ev))
-				switch ev.(type) {
-				case stores.EventReady:
-					db.URI = db.Store.Address().String()
-					onReady(db.URI)
-					continue
-				}
-			}
+	for _, name := range StoreNames {
+		err = db.load(name)
+		if err != nil {
+			db.Logger.Error("Failed to load store", zap.String("store", name), zap.Error(err))
+			return err
 		}
-	}()
-
-	err = db.Store.Load(db.ctx, -1)
-	if err != nil {
-		db.Logger.Error("%s", zap.Error(err))
-		return err
 	}
 
 	db.Logger.Debug("Connect done")
@@ -213,7 +534,13 @@ func (db *Database) Connect(onReady func(address string)) error {
 }
 
 func (db *Database) Disconnect() {
-	db.Events.Close()
-	db.Store.Close()
+	db.storesMu.Lock()
+	for _, sub := range db.events {
+		sub.Close()
+	}
+	for _, store := range db.stores {
+		store.Close()
+	}
+	db.storesMu.Unlock()
 	db.OrbitDB.Close()
 }
@@ -0,0 +1,73 @@
+package database
+
+import (
+	"context"
+	"maps"
+	"time"
+)
+
+// StoreHealth is the state of one of the stores of the database
+type StoreHealth struct {
+	Name      string
+	Address   string
+	Loaded    bool // Its entries have been loaded and indexed by Connect
+	Ready     bool // It has reported that it is ready
+	Documents int
+}
+
+// Health is the state of the database and of the IPFS node under it, see Database.Health
+type Health struct {
+	Stores          []StoreHealth
+	Online          bool // The IPFS node has networking
+	Peers           int
+	RepoPath        string
+	RepoSize        uint64
+	RepoErr         error     // Why the size of the repo could not be read, if it could not
+	LastReplication time.Time // Zero if nothing has been replicated since the node started
+}
+
+// Get the state of the stores, the IPFS node and its repo, and when entries were last replicated from a peer.
+func (db *Database) Health(ctx context.Context) Health {
+	db.storesMu.RLock()
+	uris := maps.Clone(db.uris)
+	db.storesMu.RUnlock()
+
+	db.healthMu.RLock()
+	health := Health{
+		Stores:          make([]StoreHealth, 0, len(StoreNames)),
+		RepoPath:        db.RepoPath,
+		LastReplication: db.lastReplication,
+	}
+	for _, name := range StoreNames {
+		store := StoreHealth{
+			Name:   name,
+			Loaded: db.loaded[name],
+			Ready:  db.ready[name],
+		}
+		// The stores are only opened, and indexed, once connected
+		store.Address = uris[name]
+		if idx := db.Index(name); idx != nil {
+			store.Documents = idx.Len()
+		}
+		health.Stores = append(health.Stores, store)
+	}
+	db.healthMu.RUnlock()
+
+	if db.IPFSNode != nil {
+		health.Online = db.IPFSNode.IsOnline
+		if db.IPFSNode.PeerHost != nil {
+			health.Peers = len(db.IPFSNode.PeerHost.Network().Peers())
+		}
+		if db.IPFSNode.Repo != nil {
+			health.RepoSize, health.RepoErr = db.IPFSNode.Repo.GetStorageUsage(ctx)
+		}
+	}
+	return health
+}
+
+// Change the state reported by Health
+func (db *Database) setHealth(change func()) {
+	db.healthMu.Lock()
+	defer db.healthMu.Unlock()
+	change()
+}
@@ -0,0 +1,403 @@
+package database
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	ipfslog "berty.tech/go-ipfs-log"
+	"berty.tech/go-orbit-db/iface"
+	"berty.tech/go-orbit-db/stores/operation"
+	"go.uber.org/zap"
+)
+
+// The fields of the documents in each store that are indexed, besides the id and creation time.
+var indexedFields = map[string][]string{
+	AccountStore:    {"username"},
+	GroupStore:      {"members"},
+	ChannelStore:    {"group"},
+	MessageStore:    {"channel", "author"},
+	JobStore:        {"item", "status", "job"},
+	QuarantineStore: {"quarantined_from"},
+}
+
+// The fields of the documents in each store that no two documents may share, ignoring case.
+var uniqueFields = map[string][]string{
+	AccountStore: {"username"},
+}
+
+// An in-memory secondary index over the documents of a single store, so that lookups by id,
+// by an indexed field, or by creation time do not have to scan every document in the store.
+type Index struct {
+	mu      sync.RWMutex
+	fields  []string
+	entries map[string]indexEntry                     // The indexed values of each document, keyed by id
+	lookup  map[string]map[string]map[string]struct{} // field -> value -> ids of documents with that value
+	created []createdEntry                            // Ids of documents sorted by their creation time
+	unique  map[string]map[string]map[string]struct{} // field -> case folded value -> ids, for fields that must be unique
+}
+
+// The values a single document was indexed under, kept so the document can be unindexed later.
+type indexEntry struct {
+	values    map[string][]string
+	unique    map[string]string // The case folded value of each unique field
+	createdAt *time.Time
+}
+
+type createdEntry struct {
+	at time.Time
+	id string
+}
+
+// Create a new empty index over the given fields.
+func NewIndex(fields ...string) *Index {
+	idx := &Index{
+		fields:  fields,
+		entries: make(map[string]indexEntry),
+		lookup:  make(map[string]map[string]map[string]struct{}),
+		unique:  make(map[string]map[string]map[string]struct{}),
+	}
+	for _, field := range fields {
+		idx.lookup[field] = make(map[string]map[string]struct{})
+	}
+	return idx
+}
+
+// Also index the given fields by their case folded value, so that documents sharing one can be found.
+// Returns the index so that it can be chained onto NewIndex.
+func (idx *Index) Unique(fields ...string) *Index {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	for _, field := range fields {
+		idx.unique[field] = make(map[string]map[string]struct{})
+	}
+	return idx
+}
+
+// Values of unique fields are compared without regard to case.
+func foldValue(value string) string {
+	return strings.ToLower(value)
+}
+
+// Add a document to the index, replacing whatever was indexed for its id before.
+// Reports whether a document with the same id was already indexed.
+func (idx *Index) Put(doc map[string]interface{}) bool {
+	id, ok := doc["id"].(string)
+	if !ok {
+		return false
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	replaced := idx.remove(id)
+
+	entry := indexEntry{values: make(map[string][]string), unique: make(map[string]string)}
+	for field, ids := range idx.unique {
+		if value, ok := doc[field].(string); ok {
+			folded := foldValue(value)
+			entry.unique[field] = folded
+			if ids[folded] == nil {
+				ids[folded] = make(map[string]struct{})
+			}
+			ids[folded][id] = struct{}{}
+		}
+	}
+	for _, field := range idx.fields {
+		values := IndexValues(doc[field])
+		entry.values[field] = values
+		for _, value := range values {
+			if idx.lookup[field][value] == nil {
+				idx.lookup[field][value] = make(map[string]struct{})
+			}
+			idx.lookup[field][value][id] = struct{}{}
+		}
+	}
+
+	if createdAt, ok := doc["created_at"].(string); ok {
+		if at, err := time.Parse(time.RFC3339, createdAt); err == nil {
+			entry.createdAt = &at
+			i := sort.Search(len(idx.created), func(i int) bool {
+				return idx.created[i].at.After(at)
+			})
+			idx.created = append(idx.created, createdEntry{})
+			copy(idx.created[i+1:], idx.created[i:])
+			idx.created[i] = createdEntry{at: at, id: id}
+		}
+	}
+
+	idx.entries[id] = entry
+	return replaced
+}
+
+// Remove the document with the given id from the index. Reports whether it was indexed.
+func (idx *Index) Remove(id string) bool {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	return idx.remove(id)
+}
+
+func (idx *Index) remove(id string) bool {
+	entry, ok := idx.entries[id]
+	if !ok {
+		return false
+	}
+
+	for field, values := range entry.values {
+		for _, value := range values {
+			delete(idx.lookup[field][value], id)
+			if len(idx.lookup[field][value]) == 0 {
+				delete(idx.lookup[field], value)
+			}
+		}
+	}
+	for field, folded := range entry.unique {
+		delete(idx.unique[field][folded], id)
+		if len(idx.unique[field][folded]) == 0 {
+			delete(idx.unique[field], folded)
+		}
+	}
+
+	if entry.createdAt != nil {
+		i := sort.Search(len(idx.created), func(i int) bool {
+			return !idx.created[i].at.Before(*entry.createdAt)
+		})
+		for ; i < len(idx.created) && idx.created[i].at.Equal(*entry.createdAt); i++ {
+			if idx.created[i].id == id {
+				idx.created = append(idx.created[:i], idx.created[i+1:]...)
+				break
+			}
+		}
+	}
+
+	delete(idx.entries, id)
+	return true
+}
+
+// Remove every document from the index.
+func (idx *Index) Clear() {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.entries = make(map[string]indexEntry)
+	idx.created = nil
+	for _, field := range idx.fields {
+		idx.lookup[field] = make(map[string]map[string]struct{})
+	}
+	for field := range idx.unique {
+		idx.unique[field] = make(map[string]map[string]struct{})
+	}
+}
+
+// Check if the document with the given id is in the index.
+func (idx *Index) Has(id string) bool {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	_, ok := idx.entries[id]
+	return ok
+}
+
+// Get the values the document with the given id is indexed under, keyed by field.
+func (idx *Index) Values(id string) (map[string][]string, bool) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	entry, ok := idx.entries[id]
+	if !ok {
+		return nil, false
+	}
+	values := make(map[string][]string, len(entry.values))
+	for field, v := range entry.values {
+		values[field] = append([]string(nil), v...)
+	}
+	return values, true
+}
+
+// Check if the given field is indexed.
+func (idx *Index) Indexes(field string) bool {
+	for _, f := range idx.fields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// Get the ids of every document in the index, in no particular order.
+func (idx *Index) IDs() []string {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	ids := make([]string, 0, len(idx.entries))
+	for id := range idx.entries {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// The number of documents in the index.
+func (idx *Index) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	return len(idx.entries)
+}
+
+// Get the ids of the documents whose field has any of the given values.
+func (idx *Index) Lookup(field string, values ...string) []string {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	ids := make([]string, 0)
+	seen := make(map[string]struct{})
+	for _, value := range values {
+		for id := range idx.lookup[field][value] {
+			if _, ok := seen[id]; !ok {
+				seen[id] = struct{}{}
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
+// Get the ids of the documents whose unique field has the given value, ignoring case.
+func (idx *Index) LookupFold(field, value string) []string {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	ids := make([]string, 0)
+	for id := range idx.unique[field][foldValue(value)] {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// Get the case folded values of the document's unique fields that it does not already hold, keyed by field.
+// A document keeps a value it was indexed with even if another document has it too, which can happen for
+// documents written before the field was unique or replicated from a peer that had not seen the other.
+func (idx *Index) Claims(doc map[string]interface{}) map[string]string {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	id, _ := doc["id"].(string)
+	held := idx.entries[id].unique
+
+	claims := make(map[string]string)
+	for field := range idx.unique {
+		if value, ok := doc[field].(string); ok && held[field] != foldValue(value) {
+			claims[field] = foldValue(value)
+		}
+	}
+	return claims
+}
+
+// Get the ids of the documents created strictly after from and strictly before until, oldest first.
+// A nil bound leaves that side of the range open.
+func (idx *Index) Range(from, until *time.Time) []string {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	start := 0
+	if from != nil {
+		start = sort.Search(len(idx.created), func(i int) bool {
+			return idx.created[i].at.After(*from)
+		})
+	}
+	end := len(idx.created)
+	if until != nil {
+		end = sort.Search(len(idx.created), func(i int) bool {
+			return !idx.created[i].at.Before(*until)
+		})
+	}
+
+	ids := make([]string, 0)
+	for i := start; i < end; i++ {
+		ids = append(ids, idx.created[i].id)
+	}
+	return ids
+}
+
+// Convert a document or filter value into the strings it is indexed under.
+func IndexValues(value interface{}) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, elem := range v {
+			if s, ok := elem.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
+}
+
+// Rebuild the index of the named store from every document currently in the store.
+func (db *Database) rebuildIndex(name string) error {
+	docs, err := db.Store(name).Query(db.ctx, func(doc interface{}) (bool, error) {
+		return true, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	db.Index(name).Clear()
+	for _, doc := range docs {
+		if entry, ok := doc.(map[string]interface{}); ok {
+			db.Index(name).Put(entry)
+		}
+	}
+	return nil
+}
+
+// Update the index of the named store for every document touched by the given log entries.
+// The documents are re-read from the store, since replicated entries can arrive out of order.
+// Listeners are only told about the changes if notify is set, local writes tell them when they are made.
+func (db *Database) refreshIndex(name string, notify bool, entries ...ipfslog.Entry) {
+	for _, entry := range entries {
+		op, err := operation.ParseOperation(entry)
+		if err != nil {
+			db.Logger.Warn("Cannot parse operation to index", zap.String("store", name), zap.Error(err))
+			continue
+		}
+
+		switch op.GetOperation() {
+		case "PUT", "DEL":
+			if op.GetKey() != nil {
+				db.refreshDocument(name, *op.GetKey(), notify)
+			}
+		case "PUTALL":
+			for _, raw := range op.GetDocs() {
+				var doc map[string]interface{}
+				if err := json.Unmarshal(raw, &doc); err != nil {
+					continue
+				}
+				if id, ok := doc["id"].(string); ok {
+					db.refreshDocument(name, id, notify)
+				}
+			}
+		}
+	}
+}
+
+// Update the index of the named store to match the current state of a single document.
+func (db *Database) refreshDocument(name, id string, notify bool) {
+	matches, err := db.Store(name).Get(db.ctx, id, &iface.DocumentStoreGetOptions{})
+	if err != nil || len(matches) == 0 {
+		db.unindex(name, id, notify)
+		return
+	}
+	if doc, ok := matches[0].(map[string]interface{}); ok {
+		db.index(name, doc, notify)
+	}
+}
@@ -0,0 +1,39 @@
+package database
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	storeSizeDesc = prometheus.NewDesc("sector_store_documents", "Documents in each store.", []string{"store"}, nil)
+	peersDesc     = prometheus.NewDesc("sector_ipfs_peers", "Peers the IPFS node is connected to.", nil, nil)
+)
+
+// The metrics of a database, read from it whenever they are collected
+type collector struct {
+	db *Database
+}
+
+// Collector gets the metrics of the database: the number of documents in each store and the number of peers
+func (db *Database) Collector() prometheus.Collector {
+	return &collector{db: db}
+}
+
+func (c *collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- storeSizeDesc
+	ch <- peersDesc
+}
+
+func (c *collector) Collect(ch chan<- prometheus.Metric) {
+	// The stores are only indexed once connected
+	for _, name := range StoreNames {
+		if idx := c.db.Index(name); idx != nil {
+			ch <- prometheus.MustNewConstMetric(storeSizeDesc, prometheus.GaugeValue, float64(idx.Len()), name)
+		}
+	}
+
+	if c.db.IPFSNode != nil && c.db.IPFSNode.PeerHost != nil {
+		peers := len(c.db.IPFSNode.PeerHost.Network().Peers())
+		ch <- prometheus.MustNewConstMetric(peersDesc, prometheus.GaugeValue, float64(peers))
+	}
+}
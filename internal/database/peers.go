@@ -0,0 +1,237 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	coreiface "github.com/ipfs/kubo/core/coreiface"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"go.uber.org/zap"
+)
+
+// How long dialing a peer as the node starts may take, so that one that cannot be reached does not hold up the rest
+const peerDialTimeout = 30 * time.Second
+
+// Returned when an address is not a multiaddr ending in the id of a peer, such as /ip4/10.0.0.2/tcp/4001/p2p/12D3Koo...
+var ErrInvalidAddress = errors.New("not a multiaddr with a peer id")
+
+// Returned when disconnecting from a peer, or an address of one, that the node is not connected to
+var ErrNotConnected = errors.New("not connected to peer")
+
+// PeerInfo is a peer the IPFS node is connected to
+type PeerInfo struct {
+	ID        string
+	Address   string        // The multiaddr the connection is on
+	Direction string        // Whether the peer dialed us, inbound, or we dialed it, outbound
+	Latency   time.Duration // The last known round trip time, 0 if it is not known yet
+	Protocols []string      // The protocols the peer supports
+	Preferred bool          // Whether it is one of the preferred peers
+}
+
+// NodeInfo is how other peers can reach this node
+type NodeInfo struct {
+	ID              string
+	ListenAddresses []string // The multiaddrs the node listens on
+	Addresses       []string // The multiaddrs other peers can dial the node on, ending in its id
+}
+
+/**
+ * PreferredPeers are the peers the node dials whenever it starts, along with the bootstrap peers, saved to a file so
+ * that a handful of known nodes can always find each other.
+ */
+type PreferredPeers struct {
+	path      string
+	addresses []string
+	mu        sync.RWMutex
+}
+
+// Load the preferred peers saved to the given file, or keep them in memory if the path is empty
+func OpenPreferredPeers(path string) (*PreferredPeers, error) {
+	peers := &PreferredPeers{path: path, addresses: make([]string, 0)}
+	if path == "" {
+		return peers, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return peers, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &peers.addresses); err != nil {
+		return nil, fmt.Errorf("invalid preferred peers file '%s': %w", path, err)
+	}
+	return peers, nil
+}
+
+// The addresses of the preferred peers
+func (p *PreferredPeers) Addresses() []string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return slices.Clone(p.addresses)
+}
+
+// Replace the preferred peers and save them, if every address is a multiaddr with a peer id
+func (p *PreferredPeers) Set(addresses []string) error {
+	for _, address := range addresses {
+		if _, err := parsePeerAddress(address); err != nil {
+			return err
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.addresses = slices.Compact(slices.Sorted(slices.Values(addresses)))
+	if p.path == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(p.addresses)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(p.path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(p.path, data, 0644)
+}
+
+// The ids of the preferred peers
+func (p *PreferredPeers) IDs() []peer.ID {
+	ids := make([]peer.ID, 0)
+	for _, address := range p.Addresses() {
+		if info, err := parsePeerAddress(address); err == nil {
+			ids = append(ids, info.ID)
+		}
+	}
+	return ids
+}
+
+// Get the peers the IPFS node is connected to
+func (db *Database) Peers(ctx context.Context) ([]PeerInfo, error) {
+	conns, err := db.IPFSCoreAPI.Swarm().Peers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	preferred := db.Preferred.IDs()
+	peers := make([]PeerInfo, 0, len(conns))
+	for _, conn := range conns {
+		info := PeerInfo{
+			ID:        conn.ID().String(),
+			Address:   conn.Address().String(),
+			Direction: directionName(conn.Direction()),
+			Protocols: make([]string, 0),
+			Preferred: slices.Contains(preferred, conn.ID()),
+		}
+		if latency, err := conn.Latency(); err == nil {
+			info.Latency = latency
+		}
+		if db.IPFSNode.PeerHost != nil {
+			if protocols, err := db.IPFSNode.PeerHost.Peerstore().GetProtocols(conn.ID()); err == nil {
+				for _, protocol := range protocols {
+					info.Protocols = append(info.Protocols, string(protocol))
+				}
+				slices.Sort(info.Protocols)
+			}
+		}
+		peers = append(peers, info)
+	}
+	return peers, nil
+}
+
+// Dial the peer at the address, which has to end in its id
+func (db *Database) ConnectPeer(ctx context.Context, address string) error {
+	info, err := parsePeerAddress(address)
+	if err != nil {
+		return err
+	}
+	return db.IPFSCoreAPI.Swarm().Connect(ctx, *info)
+}
+
+// Close the connection on the address, or every connection to the peer if the address is only its id, /p2p/12D3Koo...
+func (db *Database) DisconnectPeer(ctx context.Context, address string) error {
+	if _, err := parsePeerAddress(address); err != nil {
+		return err
+	}
+	addr, _ := ma.NewMultiaddr(address)
+
+	err := db.IPFSCoreAPI.Swarm().Disconnect(ctx, addr)
+	if errors.Is(err, coreiface.ErrNotConnected) || errors.Is(err, coreiface.ErrConnNotFound) {
+		return ErrNotConnected
+	}
+	return err
+}
+
+// Get the id of this node and the addresses it can be reached on
+func (db *Database) Self() NodeInfo {
+	node := NodeInfo{
+		ListenAddresses: make([]string, 0),
+		Addresses:       make([]string, 0),
+	}
+	host := db.IPFSNode.PeerHost
+	if host == nil {
+		return node
+	}
+
+	node.ID = host.ID().String()
+	for _, addr := range host.Network().ListenAddresses() {
+		node.ListenAddresses = append(node.ListenAddresses, addr.String())
+	}
+	addrs, err := peer.AddrInfoToP2pAddrs(&peer.AddrInfo{ID: host.ID(), Addrs: host.Addrs()})
+	if err == nil {
+		for _, addr := range addrs {
+			node.Addresses = append(node.Addresses, addr.String())
+		}
+	}
+	return node
+}
+
+// Dial the preferred peers in the background, logging the ones that cannot be reached within peerDialTimeout rather
+// than failing. The node dials them as it connects, and again whenever they are set.
+func (db *Database) ConnectToPreferredPeers() {
+	for _, address := range db.Preferred.Addresses() {
+		go func(address string) {
+			ctx, cancel := context.WithTimeout(db.ctx, peerDialTimeout)
+			defer cancel()
+			if err := db.ConnectPeer(ctx, address); err != nil {
+				db.Logger.Warn("Failed to connect to preferred peer", zap.String("address", address), zap.Error(err))
+			} else {
+				db.Logger.Info("Connected to preferred peer", zap.String("address", address))
+			}
+		}(address)
+	}
+}
+
+func parsePeerAddress(address string) (*peer.AddrInfo, error) {
+	addr, err := ma.NewMultiaddr(address)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidAddress, err)
+	}
+	info, err := peer.AddrInfoFromP2pAddr(addr)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidAddress, err)
+	}
+	return info, nil
+}
+
+func directionName(direction network.Direction) string {
+	switch direction {
+	case network.DirInbound:
+		return "inbound"
+	case network.DirOutbound:
+		return "outbound"
+	default:
+		return "unknown"
+	}
+}
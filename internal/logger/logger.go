@@ -1,32 +1,52 @@
 package logger
 
 import (
-	"net/url"
+	"Sector/internal/config"
+	"context"
+	"fmt"
 	"os"
-	"runtime"
 
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 )
 
-// Create a new logger that will log to the given file.
-func NewLogger(filename string) (*zap.Logger, error) {
-	if runtime.GOOS == "windows" {
-		zap.RegisterSink("winfile", func(u *url.URL) (zap.Sink, error) {
-			return os.OpenFile(u.Path[1:], os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
-		})
+// Create a new logger that will log to the file in the config, rotating it as it grows.
+func NewLogger(cfg config.LogConfig) (*zap.Logger, error) {
+	encoderConfig := zap.NewProductionEncoderConfig()
+	encoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+	encoderConfig.EncodeDuration = zapcore.StringDurationEncoder
+
+	var encoder zapcore.Encoder
+	switch cfg.Format {
+	case config.JSONLogFormat:
+		encoder = zapcore.NewJSONEncoder(encoderConfig)
+	case config.ConsoleLogFormat:
+		encoderConfig.EncodeLevel = zapcore.CapitalLevelEncoder
+		encoder = zapcore.NewConsoleEncoder(encoderConfig)
+	default:
+		return nil, fmt.Errorf("unknown log format '%s'", cfg.Format)
 	}
 
-	cfg := zap.NewDevelopmentConfig()
-	if runtime.GOOS == "windows" {
-		cfg.OutputPaths = []string{
-			"stdout",
-			"winfile:///" + filename,
-		}
-	} else {
-		cfg.OutputPaths = []string{
-			filename,
-		}
+	file, err := openRotatingFile(cfg.File, int64(cfg.MaxSize)*1024*1024, cfg.MaxAge, cfg.MaxBackups)
+	if err != nil {
+		return nil, err
 	}
 
-	return cfg.Build()
+	core := zapcore.NewCore(encoder, file, cfg.Level)
+	return zap.New(core, zap.AddCaller(), zap.AddStacktrace(zapcore.ErrorLevel), zap.ErrorOutput(zapcore.Lock(os.Stderr))), nil
+}
+
+type contextKey struct{}
+
+// Give the context a logger, such as one that notes the request being served, for FromContext to find.
+func WithContext(ctx context.Context, logger *zap.Logger) context.Context {
+	return context.WithValue(ctx, contextKey{}, logger)
+}
+
+// Get the logger of the context, or the fallback if it does not have one.
+func FromContext(ctx context.Context, fallback *zap.Logger) *zap.Logger {
+	if logger, ok := ctx.Value(contextKey{}).(*zap.Logger); ok {
+		return logger
+	}
+	return fallback
 }
@@ -0,0 +1,140 @@
+package logger
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Rotated files are named after the file they were, with the time they were rotated before the extension
+const backupTimeFormat = "2006-01-02T15-04-05.000"
+
+/**
+ * A log file that is moved aside, and a new one started, whenever the next write would take it past its maximum size.
+ * The files moved aside are removed once they are older than the maximum age, or once there are more of them than
+ * the maximum number of backups, newest kept first.
+ */
+type rotatingFile struct {
+	mu         sync.Mutex
+	path       string
+	maxSize    int64
+	maxAge     time.Duration
+	maxBackups int
+
+	file *os.File
+	size int64
+}
+
+// Open the log file at the path, appending to it if it is already there, and remove any backups that have expired.
+func openRotatingFile(path string, maxSize int64, maxAge time.Duration, maxBackups int) (*rotatingFile, error) {
+	f := &rotatingFile{
+		path:       path,
+		maxSize:    maxSize,
+		maxAge:     maxAge,
+		maxBackups: maxBackups,
+	}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	f.prune()
+	return f, nil
+}
+
+func (f *rotatingFile) open() error {
+	if err := os.MkdirAll(filepath.Dir(f.path), 0755); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(f.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	f.file = file
+	f.size = info.Size()
+	return nil
+}
+
+// Write implements zapcore.WriteSyncer.
+func (f *rotatingFile) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.maxSize > 0 && f.size > 0 && f.size+int64(len(p)) > f.maxSize {
+		if err := f.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+	return n, err
+}
+
+// Sync implements zapcore.WriteSyncer.
+func (f *rotatingFile) Sync() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.file.Sync()
+}
+
+// Move the file aside and start a new one in its place
+func (f *rotatingFile) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return err
+	}
+
+	ext := filepath.Ext(f.path)
+	backup := strings.TrimSuffix(f.path, ext) + "-" + time.Now().Format(backupTimeFormat) + ext
+	if err := os.Rename(f.path, backup); err != nil {
+		return err
+	}
+	if err := f.open(); err != nil {
+		return err
+	}
+
+	f.prune()
+	return nil
+}
+
+// Remove the backups that are too old, or too many. Backups that cannot be removed are left for the next rotation.
+func (f *rotatingFile) prune() {
+	if f.maxAge <= 0 && f.maxBackups <= 0 {
+		return
+	}
+
+	ext := filepath.Ext(f.path)
+	prefix := strings.TrimSuffix(f.path, ext) + "-"
+	matches, err := filepath.Glob(prefix + "*" + ext)
+	if err != nil {
+		return
+	}
+
+	type backup struct {
+		path    string
+		rotated time.Time
+	}
+	backups := make([]backup, 0, len(matches))
+	for _, match := range matches {
+		stamp := strings.TrimSuffix(strings.TrimPrefix(match, prefix), ext)
+		if rotated, err := time.ParseInLocation(backupTimeFormat, stamp, time.Local); err == nil {
+			backups = append(backups, backup{match, rotated})
+		}
+	}
+	slices.SortFunc(backups, func(a, b backup) int { return b.rotated.Compare(a.rotated) })
+
+	for i, b := range backups {
+		tooMany := f.maxBackups > 0 && i >= f.maxBackups
+		tooOld := f.maxAge > 0 && time.Since(b.rotated) > f.maxAge
+		if tooMany || tooOld {
+			os.Remove(b.path)
+		}
+	}
+}
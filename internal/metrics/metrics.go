@@ -0,0 +1,83 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
+
+// Registry holds the metrics of the node that are not tied to a database, served at /metrics
+var Registry = prometheus.NewRegistry()
+
+var (
+	// HTTPRequests counts the requests handled, by the OpenAPI operation they were for and the status they got
+	HTTPRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "sector",
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "Requests handled, by operation, method and status code.",
+	}, []string{"operation", "method", "code"})
+
+	// HTTPDuration is how long requests took to handle, by the OpenAPI operation they were for
+	HTTPDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "sector",
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "How long requests took to handle, by operation and method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation", "method"})
+
+	// SearchDuration is how long searches of a store took
+	SearchDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "sector",
+		Subsystem: "search",
+		Name:      "duration_seconds",
+		Help:      "How long searches took, by store.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"store"})
+
+	// SearchScanned is how many documents searches of a store had to check against their filter
+	SearchScanned = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "sector",
+		Subsystem: "search",
+		Name:      "documents_scanned",
+		Help:      "How many documents searches checked against their filter, by store.",
+		Buckets:   prometheus.ExponentialBuckets(1, 4, 10),
+	}, []string{"store"})
+
+	// Replications counts the times a store replicated entries from a peer
+	Replications = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "sector",
+		Subsystem: "orbitdb",
+		Name:      "replications_total",
+		Help:      "Replication events, by store.",
+	}, []string{"store"})
+
+	// ReplicatedEntries counts the log entries stores replicated from peers
+	ReplicatedEntries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "sector",
+		Subsystem: "orbitdb",
+		Name:      "replicated_entries_total",
+		Help:      "Log entries replicated from peers, by store.",
+	}, []string{"store"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		HTTPRequests,
+		HTTPDuration,
+		SearchDuration,
+		SearchScanned,
+		Replications,
+		ReplicatedEntries,
+	)
+}
+
+// ObserveSearch records a search of a store, how long it took and how many documents it checked
+func ObserveSearch(store string, duration time.Duration, scanned int) {
+	SearchDuration.WithLabelValues(store).Observe(duration.Seconds())
+	SearchScanned.WithLabelValues(store).Observe(float64(scanned))
+}
@@ -14,8 +14,8 @@ const (
 	ContextKeyUser contextKey = "user"
 )
 
-// NewAuthenticator returns the AuthenticationFunc used by oapi-codegen to do authentication checking of JWT tokens using the auth module we made.
-func NewAuthenticator() func(ctx context.Context, input *openapi3filter.AuthenticationInput) error {
+// NewAuthenticator returns the AuthenticationFunc used by oapi-codegen to do authentication checking of JWT tokens using the given auth service.
+func NewAuthenticator(tokens *auth.Service) func(ctx context.Context, input *openapi3filter.AuthenticationInput) error {
 	return func(ctx context.Context, input *openapi3filter.AuthenticationInput) error {
 		// Extract the request
 		req := input.RequestValidationInput.Request
@@ -27,7 +27,7 @@ func NewAuthenticator() func(ctx context.Context, input *openapi3filter.Authenti
 		}
 
 		// Validate token
-		claims, err := auth.ValidateToken(tokenStr)
+		claims, err := tokens.ValidateToken(tokenStr)
 		if err != nil {
 			return errors.New("unauthorized: invalid token")
 		}
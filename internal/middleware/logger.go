@@ -1,33 +1,66 @@
 package middleware
 
 import (
-	"log/slog"
+	"Sector/internal/logger"
 	"net/http"
-	"strings"
 
 	"github.com/felixge/httpsnoop"
+	"github.com/google/uuid"
 	"github.com/gorilla/mux"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
-func RequestLogger(logger *zap.Logger) mux.MiddlewareFunc {
+// The header a request is identified by, taken from the client if it sent one and sent back in the response
+const RequestIDHeader = "X-Request-ID"
+
+// The longest request id taken from a client, longer ones are replaced rather than written to the logs
+const maxRequestIDLength = 128
+
+/**
+ * RequestLogger gives each request an id, and the handlers a logger that notes it along with the trace the request is
+ * part of, see logger.FromContext. Once the request has been handled, it is logged with its status and duration.
+ */
+func RequestLogger(base *zap.Logger) mux.MiddlewareFunc {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			id := r.Header.Get(RequestIDHeader)
+			if !validRequestID(id) {
+				id = uuid.NewString()
+			}
+			w.Header().Set(RequestIDHeader, id)
+
+			fields := []zap.Field{zap.String("request_id", id)}
+			if span := trace.SpanContextFromContext(r.Context()); span.HasTraceID() {
+				fields = append(fields, zap.String("trace_id", span.TraceID().String()))
+			}
+			requestLogger := base.With(fields...)
+
 			// Gathers metrics from the upstream handlers
-			metrics := httpsnoop.CaptureMetrics(next, w, r)
-
-			logger.Info(
-				strings.Join([]string{
-					"Handled Request: ",
-					slog.String("method", r.Method).Value.String(),
-					slog.String("uri", r.URL.RequestURI()).Value.String(),
-					slog.String("user_agent", r.Header.Get("User-Agent")).Value.String(),
-					slog.String("ip", r.RemoteAddr).Value.String(),
-					slog.Int("code", metrics.Code).Value.String(),
-					slog.Int64("bytes", metrics.Written).Value.String(),
-					slog.Duration("request_time", metrics.Duration).Value.String(),
-				}, ""),
+			metrics := httpsnoop.CaptureMetrics(next, w, r.WithContext(logger.WithContext(r.Context(), requestLogger)))
+
+			requestLogger.Info("Handled request",
+				zap.String("method", r.Method),
+				zap.String("uri", r.URL.RequestURI()),
+				zap.String("user_agent", r.Header.Get("User-Agent")),
+				zap.String("ip", r.RemoteAddr),
+				zap.Int("code", metrics.Code),
+				zap.Int64("bytes", metrics.Written),
+				zap.Duration("request_time", metrics.Duration),
 			)
 		})
 	}
 }
+
+// Only take ids from clients that are short and printable, so they cannot forge or flood log lines
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < '!' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"Sector/internal/metrics"
+	"net/http"
+	"strconv"
+
+	"github.com/felixge/httpsnoop"
+	"github.com/gorilla/mux"
+)
+
+// Metrics counts requests and records how long they take, labelled with the operation the given function names them by
+func Metrics(operation func(*http.Request) string) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			captured := httpsnoop.CaptureMetrics(next, w, r)
+
+			name := operation(r)
+			metrics.HTTPRequests.WithLabelValues(name, r.Method, strconv.Itoa(captured.Code)).Inc()
+			metrics.HTTPDuration.WithLabelValues(name, r.Method).Observe(captured.Duration.Seconds())
+		})
+	}
+}
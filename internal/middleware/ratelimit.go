@@ -0,0 +1,169 @@
+package middleware
+
+import (
+	"Sector/internal/auth"
+	"Sector/internal/config"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+// RateLimiter limits how often each client can make requests, with a token bucket per client
+type RateLimiter struct {
+	// ErrorHandler writes the response to a request that is refused, http.Error unless set
+	ErrorHandler func(w http.ResponseWriter, message string, statusCode int)
+
+	limit     config.RateLimit
+	clock     auth.Clock
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	mu        sync.Mutex
+}
+
+type bucket struct {
+	tokens  float64 // How many requests can be made right now
+	updated time.Time
+}
+
+// NewRateLimiter creates a rate limiter, telling the time with the clock if one is given
+func NewRateLimiter(limit config.RateLimit, clock auth.Clock) *RateLimiter {
+	if clock == nil {
+		clock = auth.SystemClock
+	}
+	return &RateLimiter{
+		ErrorHandler: http.Error,
+		limit:        limit,
+		clock:        clock,
+		buckets:      make(map[string]*bucket),
+		lastSweep:    clock.Now(),
+	}
+}
+
+// Allow takes a request from the client's allowance. If it has none left, it reports how long until it will.
+func (l *RateLimiter) Allow(client string) (bool, time.Duration) {
+	if l.limit.Requests <= 0 {
+		return true, 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.clock.Now()
+	l.sweep(now)
+
+	b, ok := l.buckets[client]
+	if !ok {
+		b = &bucket{tokens: float64(l.limit.Requests), updated: now}
+		l.buckets[client] = b
+	}
+	b.tokens = l.refill(b, now)
+	b.updated = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+	return false, time.Duration((1 - b.tokens) / l.rate())
+}
+
+// Limit rate limits requests by the client the key function gives them, refusing them with 429 once it is out of
+// allowance. Requests given an empty key are not limited.
+func (l *RateLimiter) Limit(key func(*http.Request) string) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if l.refuse(w, key(r)) {
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// LimitRouteGroups rate limits searches and writes by the client the key function gives them, each with a limiter of
+// their own. Other requests are not limited.
+func LimitRouteGroups(search, writes *RateLimiter, key func(*http.Request) string) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var limiter *RateLimiter
+			switch {
+			case strings.HasSuffix(r.URL.Path, "/search"):
+				limiter = search
+			case r.Method == http.MethodPost, r.Method == http.MethodPut, r.Method == http.MethodPatch, r.Method == http.MethodDelete:
+				limiter = writes
+			}
+
+			if limiter != nil && limiter.refuse(w, key(r)) {
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// ClientIP keys requests by the address they were made from
+func ClientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// CallerID keys requests by the account that made them, or by address for those that are not authenticated
+func CallerID(r *http.Request) string {
+	if claims, ok := r.Context().Value(ContextKeyUser).(*auth.Claims); ok {
+		return UserKey(claims.UserID)
+	}
+	return "ip:" + ClientIP(r)
+}
+
+// UserKey is the key CallerID gives the requests of an account, for limiting what it does outside of a request
+func UserKey(userID string) string {
+	return "user:" + userID
+}
+
+// refuse takes a request from the client's allowance, responding with 429 and reporting true if it has none left
+func (l *RateLimiter) refuse(w http.ResponseWriter, client string) bool {
+	if client == "" {
+		return false
+	}
+	allowed, retryAfter := l.Allow(client)
+	if allowed {
+		return false
+	}
+
+	w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	l.ErrorHandler(w, "Too many requests, please try again later.", http.StatusTooManyRequests)
+	return true
+}
+
+// rate is how many requests are added to an allowance per nanosecond
+func (l *RateLimiter) rate() float64 {
+	return float64(l.limit.Requests) / float64(l.limit.Per)
+}
+
+// refill gets how many requests are in a bucket's allowance now. The caller must hold the lock.
+func (l *RateLimiter) refill(b *bucket, now time.Time) float64 {
+	return math.Min(float64(l.limit.Requests), b.tokens+float64(now.Sub(b.updated))*l.rate())
+}
+
+// sweep drops the buckets that have refilled completely, since they are the same as a new one, once every period.
+// The caller must hold the lock.
+func (l *RateLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < l.limit.Per {
+		return
+	}
+	l.lastSweep = now
+
+	for client, b := range l.buckets {
+		if l.refill(b, now) >= float64(l.limit.Requests) {
+			delete(l.buckets, client)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+// Package tracing records OpenTelemetry spans for each request, from the handler down to the stores it reads and writes.
+package tracing
+
+import (
+	"Sector/internal/config"
+	"context"
+	"net/http"
+	"os"
+
+	"github.com/felixge/httpsnoop"
+	"github.com/gorilla/mux"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// The name spans are recorded under, and that the node reports itself as
+const (
+	tracerName  = "Sector"
+	serviceName = "sector"
+)
+
+/**
+ * Send the spans recorded from now on to the exporter in the config, returning a function that sends any spans still
+ * waiting and stops the exporter. Nothing is recorded with the none exporter, but the trace context of incoming
+ * requests is still passed on.
+ */
+func Setup(ctx context.Context, cfg config.TracingConfig) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch cfg.Exporter {
+	case config.OTLPExporter:
+		options := make([]otlptracehttp.Option, 0)
+		if cfg.Endpoint != "" {
+			options = append(options, otlptracehttp.WithEndpoint(cfg.Endpoint))
+		}
+		if cfg.Insecure {
+			options = append(options, otlptracehttp.WithInsecure())
+		}
+		exporter, err = otlptracehttp.New(ctx, options...)
+	case config.StdoutExporter:
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout), stdouttrace.WithPrettyPrint())
+	default:
+		return func(context.Context) error { return nil }, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", serviceName))),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// Start a span, as a child of the span in the context if there is one
+func Start(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+/**
+ * Middleware starts a span for each request, named by the operation it is for, and continues the trace of the caller
+ * if the request carries one. Handlers get the span through the context of the request, so the spans they start are
+ * part of it.
+ */
+func Middleware(operation func(*http.Request) string) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+			ctx, span := otel.Tracer(tracerName).Start(ctx, operation(r),
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					attribute.String("http.request.method", r.Method),
+					attribute.String("url.path", r.URL.Path),
+				),
+			)
+			defer span.End()
+
+			captured := httpsnoop.CaptureMetrics(next, w, r.WithContext(ctx))
+			span.SetAttributes(attribute.Int("http.response.status_code", captured.Code))
+			if captured.Code >= http.StatusInternalServerError {
+				span.SetStatus(codes.Error, http.StatusText(captured.Code))
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"Sector/internal/config"
 	"embed"
+	"os"
 
 	"github.com/wailsapp/wails/v2"
 	"github.com/wailsapp/wails/v2/pkg/options"
@@ -12,19 +13,30 @@ import (
 var assets embed.FS
 
 func main() {
-	config.LoadEnv()
+	// Run a command such as "integrity" without starting the app
+	if runCommand(os.Args[1:]) {
+		return
+	}
+
+	// The app takes no flags, it is configured by its config file and the environment
+	cfg, err := config.Load(os.Getenv("SECTOR_CONFIG"))
+	if err != nil {
+		println("Error:", err.Error())
+		os.Exit(1)
+	}
 
 	// Create an instance of the app structure
-	app := NewApp()
+	app := NewApp(cfg)
 
 	// Create application with options
-	err := wails.Run(&options.App{
+	err = wails.Run(&options.App{
 		Title: "Sector",
 		AssetServer: &assetserver.Options{
 			Assets: assets,
 		},
 		BackgroundColour: &options.RGBA{R: 255, G: 255, B: 255, A: 1},
 		OnStartup:        app.startup,
+		OnShutdown:       app.shutdown,
 		Bind: []interface{}{
 			app,
 		},
@@ -3,7 +3,6 @@ package v1Test
 import (
 	"Sector/internal/api"
 	v1 "Sector/internal/api/v1"
-	"Sector/internal/config"
 	"Sector/internal/database"
 	"context"
 	"crypto"
@@ -27,14 +26,13 @@ import (
 
 // setupAuthSuite sets up a test environment for authentication tests
 func setupAuthSuite(t *testing.T) (*httptest.Server, *v1.SectorAPI, v1.Account, *rsa.PrivateKey, func()) {
-	config.LoadEnv()
-
 	// Create a test instance of the API
 	tmpDir, clean := database.TestingTempDir(t, "sectordb_auth_cache_test")
+	cfg := testConfig(tmpDir)
 
 	router := mux.NewRouter().StrictSlash(true)
-	testSectorAPI := v1.NewTestingSector(context.Background(), "log_test.txt", tmpDir, t)
-	api.AddV1SectorAPIToRouter(router, testSectorAPI)
+	testSectorAPI := v1.NewTestingSector(context.Background(), cfg, t)
+	api.AddV1SectorAPIToRouter(router, testSectorAPI, cfg.RateLimits)
 
 	server := httptest.NewServer(router)
 
@@ -63,7 +61,7 @@ func setupAuthSuite(t *testing.T) (*httptest.Server, *v1.SectorAPI, v1.Account,
 	}
 
 	// Add user to database
-	_, err = testSectorAPI.DB.Store.Put(context.Background(), v1.StructToMap(testUser))
+	_, err = testSectorAPI.DB.Put(context.Background(), database.AccountStore, v1.ToDocument(testUser))
 	require.NoError(t, err)
 
 	return server, testSectorAPI, testUser, privateKey, func() {
@@ -4,10 +4,13 @@ package v1Test
 import (
 	"Sector/internal/api"
 	v1 "Sector/internal/api/v1"
+	"Sector/internal/auth"
 	"Sector/internal/config"
 	"Sector/internal/database"
+	"bufio"
 	"context"
 	"crypto"
+	"crypto/ed25519"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/sha256"
@@ -16,16 +19,18 @@ import (
 	"encoding/json"
 	"encoding/pem"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
-	"berty.tech/go-orbit-db/iface"
-	"berty.tech/go-orbit-db/stores/documentstore"
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
 	"github.com/oapi-codegen/runtime/types"
 	"github.com/stretchr/testify/require"
 )
@@ -41,13 +46,15 @@ type TestAuth struct {
 // initializing the API server, and setting up HTTP test server.
 // Returns the HTTP test server, API instance, a TestAuth object with authentication data, and a cleanup function.
 func setupSuite(t *testing.T) (*httptest.Server, *v1.SectorAPI, *TestAuth, func(t *testing.T)) {
-	config.LoadEnv()
-
 	tmpDir, clean := database.TestingTempDir(t, "sectordb_cache_test")
+	cfg := testConfig(tmpDir)
+
+	// The suite makes far more requests than any client should, rate limiting is tested on a router of its own
+	cfg.RateLimits = config.RateLimitConfig{}
 
 	router := mux.NewRouter().StrictSlash(true)
-	testSectorAPI := v1.NewTestingSector(context.Background(), "log_test.txt", tmpDir, t)
-	api.AddV1SectorAPIToRouter(router, testSectorAPI)
+	testSectorAPI := v1.NewTestingSector(context.Background(), cfg, t)
+	api.AddV1SectorAPIToRouter(router, testSectorAPI, cfg.RateLimits)
 
 	server := httptest.NewServer(router)
 
@@ -62,6 +69,14 @@ func setupSuite(t *testing.T) (*httptest.Server, *v1.SectorAPI, *TestAuth, func(
 	}
 }
 
+// testConfig gets the config the suites run with, keeping the database in the given directory
+func testConfig(dir string) config.Config {
+	cfg := config.Default()
+	cfg.Log.File = "log_test.txt"
+	cfg.Database.Cache = dir
+	return cfg
+}
+
 // setupTestAuth creates a test user with keys and obtains a JWT token
 func setupTestAuth(t *testing.T, serverURL string, api *v1.SectorAPI) (*TestAuth, error) {
 	// Generate key pair
@@ -94,7 +109,7 @@ func setupTestAuth(t *testing.T, serverURL string, api *v1.SectorAPI) (*TestAuth
 	}
 
 	// Save user to database
-	_, err = api.DB.Store.Put(context.Background(), v1.StructToMap(testUser))
+	_, err = api.DB.Put(context.Background(), database.AccountStore, v1.ToDocument(testUser))
 	if err != nil {
 		return nil, fmt.Errorf("failed to save test user: %v", err)
 	}
@@ -177,15 +192,80 @@ func authRequestEditor(token string) v1.RequestEditorFn {
 	}
 }
 
+// authAs creates a request editor authenticated as the given account, with a token issued by the given API
+func authAs(t *testing.T, api *v1.SectorAPI, account v1.Account) v1.RequestEditorFn {
+	token, err := api.Auth.GenerateToken(account.Id.String(), account.Username)
+	require.NoError(t, err)
+	return authRequestEditor(token)
+}
+
+// readEvent reads the next event from a server-sent event stream, skipping keep-alive comments
+func readEvent(t *testing.T, stream *bufio.Reader) v1.Event {
+	var data string
+	for {
+		line, err := stream.ReadString('\n')
+		require.NoError(t, err)
+		line = strings.TrimRight(line, "\n")
+
+		if strings.HasPrefix(line, "data: ") {
+			data = strings.TrimPrefix(line, "data: ")
+		} else if line == "" && data != "" {
+			var event v1.Event
+			require.NoError(t, json.Unmarshal([]byte(data), &event))
+			return event
+		}
+	}
+}
+
+// signRegistration gets the challenge to create an account with the given username and key, and signs it
+func signRegistration(t *testing.T, client *v1.ClientWithResponses, username string, privateKey *rsa.PrivateKey) string {
+	pubKeyBytes, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	require.NoError(t, err)
+	pubKeyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PUBLIC KEY", Bytes: pubKeyBytes})
+
+	challengeResp, err := client.GetRegistrationChallengeWithResponse(context.Background(), v1.GetRegistrationChallengeJSONRequestBody{
+		Username: username,
+		Pubkey:   string(pubKeyPEM),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 200, challengeResp.StatusCode())
+
+	challengeBytes, err := base64.StdEncoding.DecodeString(challengeResp.JSON200.Challenge)
+	require.NoError(t, err)
+	hashed := sha256.Sum256(challengeBytes)
+	signature, err := rsa.SignPKCS1v15(rand.Reader, privateKey, crypto.SHA256, hashed[:])
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(signature)
+}
+
 // stringPtr is a helper function to convert a string to a string pointer
+// Wait for a job to finish, returning how it ended up
+func waitForJob(t *testing.T, client *v1.ClientWithResponses, job *v1.Job, auth v1.RequestEditorFn) v1.Job {
+	require.NotNil(t, job)
+
+	var finished v1.Job
+	require.Eventually(t, func() bool {
+		response, err := client.GetJobByIDWithResponse(context.Background(), job.Id, auth)
+		require.NoError(t, err)
+		require.Equal(t, 200, response.StatusCode())
+		finished = *response.JSON200
+		return finished.Status == v1.Done || finished.Status == v1.Failed
+	}, 10*time.Second, 20*time.Millisecond)
+	return finished
+}
+
 func stringPtr(s string) *string {
 	return &s
 }
 
 // setupTest loads a standard set of test data into the database for testing.
 // Creates accounts, groups, channels, and messages with various attributes.
+// The caller is made the owner of every group, and is put back in the database since the cleanup removes it.
 // Returns the created entries and a cleanup function.
-func setupTest(t *testing.T, api v1.SectorAPI) ([]interface{}, func(t *testing.T)) {
+func setupTest(t *testing.T, api *v1.SectorAPI, caller v1.Account) ([]interface{}, func(t *testing.T)) {
+	_, err := api.DB.Put(context.Background(), database.AccountStore, v1.ToDocument(caller))
+	require.NoError(t, err)
+
 	now := time.Now()
 	then := now.AddDate(0, 0, -7)
 
@@ -232,35 +312,40 @@ func setupTest(t *testing.T, api v1.SectorAPI) ([]interface{}, func(t *testing.T
 			CreatedAt:   &then,
 			Name:        "Test Group 1",
 			Description: "A group for unit testing.",
-			Members:     []types.UUID{},
+			Members:     []types.UUID{caller.Id},
+			Roles:       &map[string]v1.GroupRole{caller.Id.String(): v1.Owner},
 		},
 		v1.Group{
 			Id:          uuid.New(),
 			CreatedAt:   &now,
 			Name:        "Test Group 2",
 			Description: "Another unit testing group.",
-			Members:     []types.UUID{},
+			Members:     []types.UUID{caller.Id},
+			Roles:       &map[string]v1.GroupRole{caller.Id.String(): v1.Owner},
 		},
 		v1.Group{
 			Id:          uuid.New(),
 			CreatedAt:   &now,
 			Name:        "Test Group 3",
 			Description: "A third group for unit testing.",
-			Members:     []types.UUID{},
+			Members:     []types.UUID{caller.Id},
+			Roles:       &map[string]v1.GroupRole{caller.Id.String(): v1.Owner},
 		},
 		v1.Group{
 			Id:          uuid.New(),
 			CreatedAt:   &then,
 			Name:        "Advanced Test Group 1",
 			Description: "For advanced testing.",
-			Members:     []types.UUID{},
+			Members:     []types.UUID{caller.Id},
+			Roles:       &map[string]v1.GroupRole{caller.Id.String(): v1.Owner},
 		},
 		v1.Group{
 			Id:          uuid.New(),
 			CreatedAt:   &now,
 			Name:        "Advanced Test Group 2",
 			Description: "For advanced testing.",
-			Members:     []types.UUID{},
+			Members:     []types.UUID{caller.Id},
+			Roles:       &map[string]v1.GroupRole{caller.Id.String(): v1.Owner},
 		},
 	}
 
@@ -275,6 +360,11 @@ func setupTest(t *testing.T, api v1.SectorAPI) ([]interface{}, func(t *testing.T
 	account2ID := entries[1].(v1.Account).Id
 	account3ID := entries[2].(v1.Account).Id
 
+	// The first group also has the authors of its messages as members
+	group1 := entries[5].(v1.Group)
+	group1.Members = append(group1.Members, account1ID, account2ID)
+	entries[5] = group1
+
 	// Create test channels
 	entries = append(entries, v1.Channel{
 		CreatedAt:   &now,
@@ -350,25 +440,33 @@ func setupTest(t *testing.T, api v1.SectorAPI) ([]interface{}, func(t *testing.T
 		Pinned:    false,
 	})
 
-	// Convert all entries to maps for storage
-	result := make([]interface{}, len(entries))
-	for i, v := range entries {
-		result[i] = v1.StructToMap(v)
+	// Convert all entries to maps for storage, grouped by the store they belong in
+	result := make(map[string][]interface{})
+	for _, v := range entries {
+		var name string
+		switch v.(type) {
+		case v1.Account:
+			name = database.AccountStore
+		case v1.Group:
+			name = database.GroupStore
+		case v1.Channel:
+			name = database.ChannelStore
+		case v1.Message:
+			name = database.MessageStore
+		}
+		result[name] = append(result[name], v1.ToDocument(v))
 	}
 
 	// Add all entries to the database
-	_, err := api.DB.Store.PutAll(context.Background(), result)
-	require.NoError(t, err)
+	for name, items := range result {
+		_, err := api.DB.PutAll(context.Background(), name, items)
+		require.NoError(t, err)
+	}
 
 	// Return entries and a cleanup function
 	return entries, func(t *testing.T) {
-		err := api.DB.Store.Drop()
-		require.NoError(t, err)
-		store, err := api.DB.OrbitDB.Docs(context.Background(), api.DB.Store.Address().String(), &iface.CreateDBOptions{
-			StoreSpecificOpts: documentstore.DefaultStoreOptsForMap("id"),
-		})
+		err := api.DB.Reset()
 		require.NoError(t, err)
-		api.DB.Store = store
 	}
 }
 
@@ -397,18 +495,86 @@ func TestSectorV1(t *testing.T) {
 	})
 
 	t.Run("Get Health", func(t *testing.T) {
-		response, err := testClient.GetHealthWithResponse(context.Background(), authEditor)
-		if err != nil {
-			panic(err)
-		}
+		// Kept as the liveness check for older clients
+		response, err := testClient.GetHealthWithResponse(context.Background())
+		require.NoError(t, err)
 		require.Equal(t, 200, response.StatusCode())
+		require.Equal(t, v1.Ok, response.JSON200.Status)
+	})
+
+	t.Run("Get Liveness", func(t *testing.T) {
+		response, err := testClient.GetLivenessWithResponse(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, 200, response.StatusCode())
+		require.Equal(t, v1.Ok, response.JSON200.Status)
+	})
+
+	t.Run("Get Readiness", func(t *testing.T) {
+		// Every store has been loaded by the time the API is created
+		response, err := testClient.GetReadinessWithResponse(context.Background())
+		require.NoError(t, err)
+		readiness := response.JSON200
+		if response.StatusCode() == 503 {
+			readiness = response.JSON503
+		}
+		require.NotNil(t, readiness)
+		require.Len(t, readiness.Stores, len(database.StoreNames))
+		for _, store := range readiness.Stores {
+			require.True(t, store.Loaded, store.Name)
+			require.NotEmpty(t, store.Address, store.Name)
+		}
+
+		// Needing more peers than are connected makes the node unready, and says why
+		minPeers := sectorAPI.MinPeers
+		sectorAPI.MinPeers = readiness.Peers + 1
+		defer func() { sectorAPI.MinPeers = minPeers }()
+		response, err = testClient.GetReadinessWithResponse(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, 503, response.StatusCode())
+		require.False(t, response.JSON503.Ready)
+		require.Contains(t, response.JSON503.Problems, fmt.Sprintf("%d peers connected, %d needed", readiness.Peers, readiness.Peers+1))
+	})
+
+	t.Run("While Connecting", func(t *testing.T) {
+		served := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) })
+		serve := func(api *v1.SectorAPI, path string) int {
+			recorder := httptest.NewRecorder()
+			api.WhileConnecting(served).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+			return recorder.Code
+		}
+
+		// Until the stores are loaded only the health checks are served
+		connecting := &v1.SectorAPI{Logger: sectorAPI.Logger, DB: sectorAPI.DB}
+		require.Equal(t, 200, serve(connecting, "/v1/api/health/ready"))
+		require.Equal(t, 200, serve(connecting, "/v1/api/health/live"))
+		require.Equal(t, 503, serve(connecting, "/v1/api/group/"))
+		require.Equal(t, 503, serve(connecting, "/v1/ws"))
+
+		require.Equal(t, 200, serve(sectorAPI, "/v1/api/group/"))
+	})
+
+	t.Run("Get Metrics", func(t *testing.T) {
+		_, err := testClient.GetLivenessWithResponse(context.Background())
+		require.NoError(t, err)
+
+		response, err := server.Client().Get(server.URL + "/metrics")
+		require.NoError(t, err)
+		defer response.Body.Close()
+		require.Equal(t, 200, response.StatusCode)
+		body, err := io.ReadAll(response.Body)
+		require.NoError(t, err)
+
+		// Requests are counted by the operation they were for, alongside the metrics of the database
+		require.Contains(t, string(body), `sector_http_requests_total{code="200",method="GET",operation="GetLiveness"}`)
+		require.Contains(t, string(body), `sector_store_documents{store="accounts"}`)
+		require.Contains(t, string(body), "sector_ipfs_peers")
 	})
 
 	// Test Account API endpoints
 	t.Run("Account", func(t *testing.T) {
 		// Test account creation
 		t.Run("Create Account", func(t *testing.T) {
-			_, teardown := setupTest(t, *sectorAPI)
+			_, teardown := setupTest(t, sectorAPI, testAuth.Account)
 			defer teardown(t)
 
 			// Generate new key pair for this test account
@@ -432,8 +598,22 @@ func TestSectorV1(t *testing.T) {
 				Pubkey:     string(pubKeyPEM),
 			}
 
-			// Test successful creation
-			response, err := testClient.PutAccountWithResponse(context.Background(), body, authEditor)
+			// Test the key has to sign a challenge issued for it
+			response, err := testClient.PutAccountWithResponse(context.Background(), body)
+			require.NoError(t, err)
+			require.Equal(t, 400, response.StatusCode())
+
+			otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+			require.NoError(t, err)
+			signRegistration(t, testClient, body.Username, privateKey) // Issues the challenge for the key, but signs it with another
+			body.Signature = signRegistration(t, testClient, body.Username, otherKey)
+			response, err = testClient.PutAccountWithResponse(context.Background(), body)
+			require.NoError(t, err)
+			require.Equal(t, 401, response.StatusCode())
+
+			// Test successful creation, which needs no token
+			body.Signature = signRegistration(t, testClient, body.Username, privateKey)
+			response, err = testClient.PutAccountWithResponse(context.Background(), body)
 			require.NoError(t, err)
 			require.Equal(t, 201, response.StatusCode())
 
@@ -446,16 +626,54 @@ func TestSectorV1(t *testing.T) {
 			require.Equal(t, body.ProfilePic, createdAccount.ProfilePic)
 			require.Equal(t, body.Pubkey, createdAccount.Pubkey)
 
+			// Test the challenge can only be used once
+			body.Id = uuid.New()
+			response, err = testClient.PutAccountWithResponse(context.Background(), body)
+			require.NoError(t, err)
+			require.Equal(t, 400, response.StatusCode())
+
 			// Test duplicate ID error case
+			body.Id = createdAccount.Id
 			body.Username = "Updated Username!"
-			response, err = testClient.PutAccountWithResponse(context.Background(), body, authEditor)
+			body.Signature = signRegistration(t, testClient, body.Username, privateKey)
+			response, err = testClient.PutAccountWithResponse(context.Background(), body)
+			require.NoError(t, err)
+			require.Equal(t, 409, response.StatusCode())
+			require.Equal(t, v1.Conflict, response.ApplicationproblemJSON409.Code)
+
+			// Test usernames are unique ignoring case, both when the challenge is issued and when the account is created
+			challengeResp, err := testClient.GetRegistrationChallengeWithResponse(context.Background(), v1.GetRegistrationChallengeJSONRequestBody{
+				Username: "createACCOUNT",
+				Pubkey:   body.Pubkey,
+			})
 			require.NoError(t, err)
-			require.Equal(t, 500, response.StatusCode())
+			require.Equal(t, 409, challengeResp.StatusCode())
+
+			body.Id = uuid.New()
+			body.Username = "Taken Later"
+			body.Signature = signRegistration(t, testClient, body.Username, privateKey)
+			_, err = sectorAPI.DB.Put(context.Background(), database.AccountStore, v1.ToDocument(v1.Account{
+				Id:       uuid.New(),
+				Username: "TAKEN LATER",
+				Pubkey:   body.Pubkey,
+			}))
+			require.NoError(t, err)
+			response, err = testClient.PutAccountWithResponse(context.Background(), body)
+			require.NoError(t, err)
+			require.Equal(t, 409, response.StatusCode())
+			require.Equal(t, v1.UsernameTaken, response.ApplicationproblemJSON409.Code)
+
+			// Test unsupported public key error case
+			body.Id = uuid.New()
+			body.Pubkey = "not a public key"
+			response, err = testClient.PutAccountWithResponse(context.Background(), body)
+			require.NoError(t, err)
+			require.Equal(t, 400, response.StatusCode())
 		})
 
 		// Test account update
 		t.Run("Update Account By Id", func(t *testing.T) {
-			entries, teardown := setupTest(t, *sectorAPI)
+			entries, teardown := setupTest(t, sectorAPI, testAuth.Account)
 			defer teardown(t)
 
 			selectedAccount := entries[0].(v1.Account)
@@ -464,8 +682,20 @@ func TestSectorV1(t *testing.T) {
 			body := v1.UpdateAccountByIDJSONRequestBody{
 				Username: &(newUsername),
 			}
+
+			// Test that only the owner can update the account
 			response, err := testClient.UpdateAccountByIDWithResponse(context.Background(), selectedAccount.Id, body, authEditor)
 			require.NoError(t, err)
+			require.Equal(t, 403, response.StatusCode())
+
+			// Test that the username cannot be changed to one another account has, ignoring case
+			taken := strings.ToLower(entries[2].(v1.Account).Username)
+			response, err = testClient.UpdateAccountByIDWithResponse(context.Background(), selectedAccount.Id, v1.UpdateAccountByIDJSONRequestBody{Username: &taken}, authAs(t, sectorAPI, selectedAccount))
+			require.NoError(t, err)
+			require.Equal(t, 409, response.StatusCode())
+
+			response, err = testClient.UpdateAccountByIDWithResponse(context.Background(), selectedAccount.Id, body, authAs(t, sectorAPI, selectedAccount))
+			require.NoError(t, err)
 			require.Equal(t, 201, response.StatusCode())
 
 			var updatedAccount v1.Account
@@ -487,25 +717,148 @@ func TestSectorV1(t *testing.T) {
 
 		// Test account deletion
 		t.Run("Delete Account By Id", func(t *testing.T) {
-			entries, teardown := setupTest(t, *sectorAPI)
+			entries, teardown := setupTest(t, sectorAPI, testAuth.Account)
 			defer teardown(t)
 
 			selectedAccount := entries[0].(v1.Account)
 
-			// Test successful deletion
+			// Test that only the owner can delete the account
 			response, err := testClient.DeleteAccountByIDWithResponse(context.Background(), selectedAccount.Id, authEditor)
 			require.NoError(t, err)
+			require.Equal(t, 403, response.StatusCode())
+
+			// Test successful deletion
+			response, err = testClient.DeleteAccountByIDWithResponse(context.Background(), selectedAccount.Id, authAs(t, sectorAPI, selectedAccount))
+			require.NoError(t, err)
 			require.Equal(t, 204, response.StatusCode())
 
 			// Test deletion of non-existent account
-			response, err = testClient.DeleteAccountByIDWithResponse(context.Background(), selectedAccount.Id, authEditor)
+			response, err = testClient.DeleteAccountByIDWithResponse(context.Background(), selectedAccount.Id, authAs(t, sectorAPI, selectedAccount))
 			require.NoError(t, err)
-			require.Equal(t, 500, response.StatusCode())
+			require.Equal(t, 404, response.StatusCode())
+		})
+
+		// Test adding, listing and revoking device keys
+		t.Run("Device Keys", func(t *testing.T) {
+			_, teardown := setupTest(t, sectorAPI, testAuth.Account)
+			defer teardown(t)
+
+			account := testAuth.Account
+			keysResp, err := testClient.ListDeviceKeysWithResponse(context.Background(), account.Id, authEditor)
+			require.NoError(t, err)
+			require.Equal(t, 200, keysResp.StatusCode())
+			require.Len(t, *keysResp.JSON200, 1)
+			require.Equal(t, account.Pubkey, (*keysResp.JSON200)[0].Pubkey)
+
+			// The challenge issued for the new key has to be signed by an existing key and by the new key
+			publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+			require.NoError(t, err)
+			pubKeyBytes, err := x509.MarshalPKIXPublicKey(publicKey)
+			require.NoError(t, err)
+			pubKeyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubKeyBytes}))
+
+			deviceChallengeResp, err := testClient.GetDeviceChallengeWithResponse(context.Background(), account.Id, v1.GetDeviceChallengeJSONRequestBody{Pubkey: pubKeyPEM}, authEditor)
+			require.NoError(t, err)
+			require.Equal(t, 200, deviceChallengeResp.StatusCode())
+			deviceChallenge, err := base64.StdEncoding.DecodeString(deviceChallengeResp.JSON200.Challenge)
+			require.NoError(t, err)
+
+			keySignature := base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, deviceChallenge))
+			newKey := v1.AddDeviceKeyJSONRequestBody{
+				Name:         "Laptop",
+				Pubkey:       pubKeyPEM,
+				Signature:    keySignature,
+				KeySignature: keySignature,
+			}
+			addResp, err := testClient.AddDeviceKeyWithResponse(context.Background(), account.Id, newKey, authEditor)
+			require.NoError(t, err)
+			require.Equal(t, 403, addResp.StatusCode())
+
+			hashed := sha256.Sum256(deviceChallenge)
+			signature, err := rsa.SignPKCS1v15(rand.Reader, testAuth.PrivateKey, crypto.SHA256, hashed[:])
+			require.NoError(t, err)
+			newKey.Signature = base64.StdEncoding.EncodeToString(signature)
+			newKey.KeySignature = newKey.Signature
+			addResp, err = testClient.AddDeviceKeyWithResponse(context.Background(), account.Id, newKey, authEditor)
+			require.NoError(t, err)
+			require.Equal(t, 403, addResp.StatusCode())
+
+			newKey.KeySignature = keySignature
+			addResp, err = testClient.AddDeviceKeyWithResponse(context.Background(), account.Id, newKey, authEditor)
+			require.NoError(t, err)
+			require.Equal(t, 201, addResp.StatusCode())
+			device := addResp.JSON201
+			require.Equal(t, "Laptop", device.Name)
+
+			// The key cannot be added again
+			addResp, err = testClient.AddDeviceKeyWithResponse(context.Background(), account.Id, newKey, authEditor)
+			require.NoError(t, err)
+			require.Equal(t, 409, addResp.StatusCode())
+
+			// Nor does trying to add a key that is already there use up its challenge
+			deviceChallengeResp, err = testClient.GetDeviceChallengeWithResponse(context.Background(), account.Id, v1.GetDeviceChallengeJSONRequestBody{Pubkey: account.Pubkey}, authEditor)
+			require.NoError(t, err)
+			require.Equal(t, 200, deviceChallengeResp.StatusCode())
+			deviceChallenge, err = base64.StdEncoding.DecodeString(deviceChallengeResp.JSON200.Challenge)
+			require.NoError(t, err)
+			hashed = sha256.Sum256(deviceChallenge)
+			signature, err = rsa.SignPKCS1v15(rand.Reader, testAuth.PrivateKey, crypto.SHA256, hashed[:])
+			require.NoError(t, err)
+			existingKey := v1.AddDeviceKeyJSONRequestBody{
+				Name:         "Desktop",
+				Pubkey:       account.Pubkey,
+				Signature:    base64.StdEncoding.EncodeToString(signature),
+				KeySignature: base64.StdEncoding.EncodeToString(signature),
+			}
+			for range 2 {
+				addResp, err = testClient.AddDeviceKeyWithResponse(context.Background(), account.Id, existingKey, authEditor)
+				require.NoError(t, err)
+				require.Equal(t, 409, addResp.StatusCode())
+			}
+
+			// Either key can now be used to log in, and the token records which one was
+			challengeResp, err := testClient.GetChallengeWithResponse(context.Background(), &v1.GetChallengeParams{Username: account.Username})
+			require.NoError(t, err)
+			var challengeData map[string]string
+			require.NoError(t, json.Unmarshal(challengeResp.Body, &challengeData))
+			challenge, err := base64.StdEncoding.DecodeString(challengeData["challenge"])
+			require.NoError(t, err)
+
+			signatureB64 := base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, challenge))
+			loginResp, err := testClient.LoginWithResponse(context.Background(), v1.LoginJSONRequestBody{Username: &account.Username, Signature: &signatureB64})
+			require.NoError(t, err)
+			require.Equal(t, 200, loginResp.StatusCode())
+			claims, err := sectorAPI.Auth.ValidateToken(loginResp.JSON200.Token)
+			require.NoError(t, err)
+			require.Equal(t, device.Id.String(), claims.DeviceID)
+
+			keysResp, err = testClient.ListDeviceKeysWithResponse(context.Background(), account.Id, authEditor)
+			require.NoError(t, err)
+			require.Len(t, *keysResp.JSON200, 2)
+			require.NotNil(t, (*keysResp.JSON200)[1].LastUsedAt)
+
+			// Revoking the key signs out the sessions it was used for
+			laptop := authRequestEditor(loginResp.JSON200.Token)
+			revokeResp, err := testClient.RevokeDeviceKeyWithResponse(context.Background(), account.Id, device.Id, authEditor)
+			require.NoError(t, err)
+			require.Equal(t, 204, revokeResp.StatusCode())
+
+			accessResp, err := testClient.GetAccountByIDWithResponse(context.Background(), account.Id, laptop)
+			require.NoError(t, err)
+			require.Equal(t, 401, accessResp.StatusCode())
+
+			// The last key of an account cannot be revoked
+			keysResp, err = testClient.ListDeviceKeysWithResponse(context.Background(), account.Id, authEditor)
+			require.NoError(t, err)
+			require.Len(t, *keysResp.JSON200, 1)
+			revokeResp, err = testClient.RevokeDeviceKeyWithResponse(context.Background(), account.Id, (*keysResp.JSON200)[0].Id, authEditor)
+			require.NoError(t, err)
+			require.Equal(t, 400, revokeResp.StatusCode())
 		})
 
 		// Test account retrieval
 		t.Run("Get By Id", func(t *testing.T) {
-			entries, teardown := setupTest(t, *sectorAPI)
+			entries, teardown := setupTest(t, sectorAPI, testAuth.Account)
 			defer teardown(t)
 
 			selectedAccount := entries[0].(v1.Account)
@@ -522,13 +875,21 @@ func TestSectorV1(t *testing.T) {
 			require.Equal(t, selectedAccount.Username, fetchedAccount.Username)
 			require.Equal(t, selectedAccount.ProfilePic, fetchedAccount.ProfilePic)
 			require.Equal(t, selectedAccount.Pubkey, fetchedAccount.Pubkey)
+
+			// Test a missing account is not found, with a code to tell it apart from other errors
+			response, err = testClient.GetAccountByIDWithResponse(context.Background(), uuid.New(), authEditor)
+			require.NoError(t, err)
+			require.Equal(t, 404, response.StatusCode())
+			require.Equal(t, "application/problem+json", response.HTTPResponse.Header.Get("Content-Type"))
+			require.Equal(t, v1.NotFound, response.ApplicationproblemJSONDefault.Code)
+			require.Equal(t, 404, response.ApplicationproblemJSONDefault.Status)
 		})
 
 		// Test account search functionality
 		t.Run("Search Accounts", func(t *testing.T) {
 			// Test search by ID
 			t.Run("By Id", func(t *testing.T) {
-				entries, teardown := setupTest(t, *sectorAPI)
+				entries, teardown := setupTest(t, sectorAPI, testAuth.Account)
 				defer teardown(t)
 
 				var ids = []types.UUID{entries[0].(v1.Account).Id}
@@ -539,15 +900,15 @@ func TestSectorV1(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, 200, result.StatusCode())
 
-				var queryResult []v1.Account
+				var queryResult v1.AccountPage
 				err = json.Unmarshal(result.Body, &queryResult)
 				require.NoError(t, err)
-				require.Equal(t, 1, len(queryResult))
+				require.Equal(t, 1, len(queryResult.Items))
 			})
 
 			// Test search by creation date
 			t.Run("By creation time", func(t *testing.T) {
-				_, teardown := setupTest(t, *sectorAPI)
+				_, teardown := setupTest(t, sectorAPI, testAuth.Account)
 				defer teardown(t)
 
 				// Create time range for filtering - going back 10 days to 5 days ago
@@ -562,17 +923,17 @@ func TestSectorV1(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, 200, result.StatusCode())
 
-				var queryResult []v1.Account
+				var queryResult v1.AccountPage
 				err = json.Unmarshal(result.Body, &queryResult)
 				require.NoError(t, err)
-				require.Equal(t, 3, len(queryResult))
+				require.Equal(t, 3, len(queryResult.Items))
 
 				// Verify that all returned accounts have timestamps within the specified range
-				for i := 0; i < len(queryResult); i++ {
+				for i := 0; i < len(queryResult.Items); i++ {
 					// Skip validation for test-generated data that might not have valid timestamps
 					// or just verify the generated timestamps are in the expected format
-					if queryResult[i].CreatedAt != nil {
-						createdTime := *queryResult[i].CreatedAt
+					if queryResult.Items[i].CreatedAt != nil {
+						createdTime := *queryResult.Items[i].CreatedAt
 
 						// Either the time is within our range, or it was artificially created for the test
 						if createdTime.After(timeStart) && createdTime.Before(timeEnd) {
@@ -591,7 +952,7 @@ func TestSectorV1(t *testing.T) {
 
 			// Test search by username
 			t.Run("By username", func(t *testing.T) {
-				_, teardown := setupTest(t, *sectorAPI)
+				_, teardown := setupTest(t, sectorAPI, testAuth.Account)
 				defer teardown(t)
 
 				var username = "Doe"
@@ -602,12 +963,12 @@ func TestSectorV1(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, 200, result.StatusCode())
 
-				var queryResult []v1.Account
+				var queryResult v1.AccountPage
 				err = json.Unmarshal(result.Body, &queryResult)
 				require.NoError(t, err)
-				require.Equal(t, 2, len(queryResult))
-				for i := 0; i < len(queryResult); i++ {
-					require.True(t, strings.Contains(queryResult[i].Username, username))
+				require.Equal(t, 2, len(queryResult.Items))
+				for i := 0; i < len(queryResult.Items); i++ {
+					require.True(t, strings.Contains(queryResult.Items[i].Username, username))
 				}
 			})
 		})
@@ -617,7 +978,7 @@ func TestSectorV1(t *testing.T) {
 	t.Run("Group", func(t *testing.T) {
 		// Test group creation
 		t.Run("Create Group", func(t *testing.T) {
-			_, teardown := setupTest(t, *sectorAPI)
+			_, teardown := setupTest(t, sectorAPI, testAuth.Account)
 			defer teardown(t)
 
 			body := v1.PutGroupJSONRequestBody{
@@ -637,12 +998,37 @@ func TestSectorV1(t *testing.T) {
 			require.Equal(t, body.Id, createdGroup.Id)
 			require.NotNil(t, createdGroup.CreatedAt)
 			require.Equal(t, body.Description, createdGroup.Description)
-			require.Equal(t, body.Members, createdGroup.Members)
+			require.Equal(t, []types.UUID{testAuth.Account.Id}, createdGroup.Members)
+			require.Equal(t, &map[string]v1.GroupRole{testAuth.Account.Id.String(): v1.Owner}, createdGroup.Roles)
+		})
+
+		// Test that groups cannot be created with members that have no account
+		t.Run("Create Group With Missing Member", func(t *testing.T) {
+			entries, teardown := setupTest(t, sectorAPI, testAuth.Account)
+			defer teardown(t)
+
+			body := v1.PutGroupJSONRequestBody{
+				Id:      uuid.New(),
+				Name:    "Haunted Group",
+				Members: []types.UUID{entries[2].(v1.Account).Id, uuid.New()},
+			}
+			response, err := testClient.PutGroupWithResponse(context.Background(), body, authEditor)
+			require.NoError(t, err)
+			require.Equal(t, 404, response.StatusCode())
+			require.Equal(t, v1.NotFound, response.ApplicationproblemJSONDefault.Code)
+			require.False(t, sectorAPI.DB.Index(database.GroupStore).Has(body.Id.String()))
+
+			// Accounts that exist are fine
+			body.Members = body.Members[:1]
+			response, err = testClient.PutGroupWithResponse(context.Background(), body, authEditor)
+			require.NoError(t, err)
+			require.Equal(t, 201, response.StatusCode())
+			require.ElementsMatch(t, []types.UUID{entries[2].(v1.Account).Id, testAuth.Account.Id}, response.JSON201.Members)
 		})
 
 		// Test group update
 		t.Run("Update Group By Id", func(t *testing.T) {
-			entries, teardown := setupTest(t, *sectorAPI)
+			entries, teardown := setupTest(t, sectorAPI, testAuth.Account)
 			defer teardown(t)
 
 			selectedGroup := entries[8].(v1.Group)
@@ -674,25 +1060,143 @@ func TestSectorV1(t *testing.T) {
 
 		// Test group deletion
 		t.Run("Delete Group By Id", func(t *testing.T) {
-			entries, teardown := setupTest(t, *sectorAPI)
+			entries, teardown := setupTest(t, sectorAPI, testAuth.Account)
 			defer teardown(t)
 
 			selectedGroup := entries[5].(v1.Group)
 
-			// Test successful deletion
+			// Test successful deletion, which carries on in the background
+			channels := sectorAPI.DB.Index(database.ChannelStore).Lookup("group", selectedGroup.Id.String())
+			require.NotEmpty(t, channels)
 			response, err := testClient.DeleteGroupByIDWithResponse(context.Background(), selectedGroup.Id, authEditor)
 			require.NoError(t, err)
-			require.Equal(t, 204, response.StatusCode())
+			require.Equal(t, 202, response.StatusCode())
+			require.Equal(t, "/v1/api/jobs/"+response.JSON202.Id.String(), response.HTTPResponse.Header.Get("Location"))
+			require.Equal(t, selectedGroup.Id, response.JSON202.Item)
+
+			// The group goes along with its channels and their messages
+			job := waitForJob(t, testClient, response.JSON202, authEditor)
+			require.Equal(t, v1.Done, job.Status)
+			require.Equal(t, job.Total, job.Deleted)
+			require.Greater(t, job.Total, len(channels))
+			require.Empty(t, sectorAPI.DB.Index(database.ChannelStore).Lookup("group", selectedGroup.Id.String()))
+			require.Empty(t, sectorAPI.DB.Index(database.MessageStore).Lookup("channel", channels...))
+
+			// Only whoever started a job can follow it
+			jobResponse, err := testClient.GetJobByIDWithResponse(context.Background(), job.Id, authAs(t, sectorAPI, entries[0].(v1.Account)))
+			require.NoError(t, err)
+			require.Equal(t, 403, jobResponse.StatusCode())
 
 			// Test deletion of non-existent group
 			response, err = testClient.DeleteGroupByIDWithResponse(context.Background(), selectedGroup.Id, authEditor)
 			require.NoError(t, err)
-			require.Equal(t, 500, response.StatusCode())
+			require.Equal(t, 404, response.StatusCode())
+		})
+
+		// Test a deletion cut short by a restart is carried on
+		t.Run("Resume Group Deletion", func(t *testing.T) {
+			entries, teardown := setupTest(t, sectorAPI, testAuth.Account)
+			defer teardown(t)
+
+			selectedGroup := entries[5].(v1.Group)
+			now := time.Now()
+			job := v1.Job{
+				Id:          uuid.New(),
+				Kind:        "group",
+				Item:        selectedGroup.Id,
+				Status:      v1.Running,
+				RequestedBy: testAuth.Account.Id,
+				CreatedAt:   now,
+				UpdatedAt:   now,
+			}
+			doc := v1.StructToMap(job)
+			doc["node"] = sectorAPI.DB.GetOwnID()
+			_, err := sectorAPI.DB.Put(context.Background(), database.JobStore, doc)
+			require.NoError(t, err)
+
+			// Nothing can be added to the group while it is being deleted
+			newChannel := v1.PutChannelJSONRequestBody{Id: uuid.New(), Name: "Too Late", Group: selectedGroup.Id}
+			channelResponse, err := testClient.PutChannelWithResponse(context.Background(), selectedGroup.Id, newChannel, authEditor)
+			require.NoError(t, err)
+			require.Equal(t, 409, channelResponse.StatusCode())
+
+			require.NoError(t, sectorAPI.Jobs.Resume())
+			require.Equal(t, v1.Done, waitForJob(t, testClient, &job, authEditor).Status)
+
+			response, err := testClient.GetGroupByIDWithResponse(context.Background(), selectedGroup.Id, authEditor)
+			require.NoError(t, err)
+			require.Equal(t, 404, response.StatusCode())
+		})
+
+		// Test a deletion that fails puts back everything it deleted
+		t.Run("Restore Failed Group Deletion", func(t *testing.T) {
+			entries, teardown := setupTest(t, sectorAPI, testAuth.Account)
+			defer teardown(t)
+
+			selectedGroup := entries[5].(v1.Group)
+			channels := sectorAPI.DB.Index(database.ChannelStore).Lookup("group", selectedGroup.Id.String())
+			messages := sectorAPI.DB.Index(database.MessageStore).Lookup("channel", channels...)
+			require.NotEmpty(t, messages)
+
+			// A message the index knows of, but the store does not, cannot be deleted
+			phantom := uuid.New().String()
+			sectorAPI.DB.Index(database.MessageStore).Put(map[string]interface{}{"id": phantom, "channel": channels[0]})
+
+			response, err := testClient.DeleteGroupByIDWithResponse(context.Background(), selectedGroup.Id, authEditor)
+			require.NoError(t, err)
+			require.Equal(t, 202, response.StatusCode())
+			job := waitForJob(t, testClient, response.JSON202, authEditor)
+			require.Equal(t, v1.Failed, job.Status)
+			require.NotNil(t, job.Error)
+			require.Equal(t, job.Deleted, job.Restored)
+
+			// The group is left as it was, and the copies of what was deleted are dropped
+			groupResponse, err := testClient.GetGroupByIDWithResponse(context.Background(), selectedGroup.Id, authEditor)
+			require.NoError(t, err)
+			require.Equal(t, 200, groupResponse.StatusCode())
+			require.ElementsMatch(t, channels, sectorAPI.DB.Index(database.ChannelStore).Lookup("group", selectedGroup.Id.String()))
+			require.ElementsMatch(t, append(messages, phantom), sectorAPI.DB.Index(database.MessageStore).Lookup("channel", channels...))
+			require.Empty(t, sectorAPI.DB.Index(database.JobStore).Lookup("job", job.Id.String()))
+		})
+
+		// Test finished jobs are only kept for the retention period
+		t.Run("Prune Finished Jobs", func(t *testing.T) {
+			_, teardown := setupTest(t, sectorAPI, testAuth.Account)
+			defer teardown(t)
+
+			now := time.Now()
+			then := now.AddDate(0, -1, 0)
+			old := v1.Job{
+				Id:          uuid.New(),
+				Kind:        "group",
+				Item:        uuid.New(),
+				Status:      v1.Done,
+				RequestedBy: testAuth.Account.Id,
+				CreatedAt:   then,
+				UpdatedAt:   then,
+			}
+			recent := old
+			recent.Id = uuid.New()
+			recent.UpdatedAt = now
+			for _, job := range []v1.Job{old, recent} {
+				doc := v1.StructToMap(job)
+				doc["node"] = sectorAPI.DB.GetOwnID()
+				_, err := sectorAPI.DB.Put(context.Background(), database.JobStore, doc)
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, sectorAPI.Jobs.Prune())
+			response, err := testClient.GetJobByIDWithResponse(context.Background(), old.Id, authEditor)
+			require.NoError(t, err)
+			require.Equal(t, 404, response.StatusCode())
+			response, err = testClient.GetJobByIDWithResponse(context.Background(), recent.Id, authEditor)
+			require.NoError(t, err)
+			require.Equal(t, 200, response.StatusCode())
 		})
 
 		// Test group retrieval
 		t.Run("Get Group By Id", func(t *testing.T) {
-			entries, teardown := setupTest(t, *sectorAPI)
+			entries, teardown := setupTest(t, sectorAPI, testAuth.Account)
 			defer teardown(t)
 
 			selectedGroup := entries[7].(v1.Group)
@@ -715,7 +1219,7 @@ func TestSectorV1(t *testing.T) {
 		t.Run("Search Groups", func(t *testing.T) {
 			// Test search by ID
 			t.Run("By Id", func(t *testing.T) {
-				entries, teardown := setupTest(t, *sectorAPI)
+				entries, teardown := setupTest(t, sectorAPI, testAuth.Account)
 				defer teardown(t)
 
 				var ids = []types.UUID{entries[6].(v1.Group).Id, entries[9].(v1.Group).Id}
@@ -726,15 +1230,15 @@ func TestSectorV1(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, 200, result.StatusCode())
 
-				var queryResult []v1.Group
+				var queryResult v1.GroupPage
 				err = json.Unmarshal(result.Body, &queryResult)
 				require.NoError(t, err)
-				require.Equal(t, 2, len(queryResult))
+				require.Equal(t, 2, len(queryResult.Items))
 			})
 
 			// Test search by creation date
 			t.Run("By creation time", func(t *testing.T) {
-				_, teardown := setupTest(t, *sectorAPI)
+				_, teardown := setupTest(t, sectorAPI, testAuth.Account)
 				defer teardown(t)
 
 				var timeStart = time.Now().AddDate(0, 0, -10)
@@ -747,15 +1251,15 @@ func TestSectorV1(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, 200, result.StatusCode())
 
-				var queryResult []v1.Group
+				var queryResult v1.GroupPage
 				err = json.Unmarshal(result.Body, &queryResult)
 				require.NoError(t, err)
-				require.Equal(t, 2, len(queryResult))
+				require.Equal(t, 2, len(queryResult.Items))
 			})
 
 			// Test search by name
 			t.Run("By name", func(t *testing.T) {
-				_, teardown := setupTest(t, *sectorAPI)
+				_, teardown := setupTest(t, sectorAPI, testAuth.Account)
 				defer teardown(t)
 
 				searchName := "Advanced"
@@ -766,15 +1270,15 @@ func TestSectorV1(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, 200, result.StatusCode())
 
-				var queryResult []v1.Group
+				var queryResult v1.GroupPage
 				err = json.Unmarshal(result.Body, &queryResult)
 				require.NoError(t, err)
-				require.Equal(t, 2, len(queryResult))
+				require.Equal(t, 2, len(queryResult.Items))
 			})
 
 			// Test search by members
 			t.Run("By members", func(t *testing.T) {
-				entries, teardown := setupTest(t, *sectorAPI)
+				entries, teardown := setupTest(t, sectorAPI, testAuth.Account)
 				defer teardown(t)
 
 				var ids = []types.UUID{entries[2].(v1.Account).Id}
@@ -785,26 +1289,31 @@ func TestSectorV1(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, 200, result.StatusCode())
 
-				var queryResult []v1.Group
+				var queryResult v1.GroupPage
 				err = json.Unmarshal(result.Body, &queryResult)
 				require.NoError(t, err)
-				require.Equal(t, 0, len(queryResult))
+				require.Equal(t, 0, len(queryResult.Items))
 			})
 		})
 
 		// Test adding a member to a group
 		t.Run("Add Member", func(t *testing.T) {
-			entries, teardown := setupTest(t, *sectorAPI)
+			entries, teardown := setupTest(t, sectorAPI, testAuth.Account)
 			defer teardown(t)
 
 			result, err := testClient.AddGroupMemberWithResponse(context.Background(), entries[7].(v1.Group).Id, entries[2].(v1.Account).Id, authEditor)
 			require.NoError(t, err)
 			require.Equal(t, 201, result.StatusCode())
+
+			// Only accounts that exist can be added
+			result, err = testClient.AddGroupMemberWithResponse(context.Background(), entries[7].(v1.Group).Id, uuid.New(), authEditor)
+			require.NoError(t, err)
+			require.Equal(t, 404, result.StatusCode())
 		})
 
 		// Test removing a member from a group
 		t.Run("Remove Member", func(t *testing.T) {
-			entries, teardown := setupTest(t, *sectorAPI)
+			entries, teardown := setupTest(t, sectorAPI, testAuth.Account)
 			defer teardown(t)
 
 			groupID := entries[7].(v1.Group).Id
@@ -827,7 +1336,90 @@ func TestSectorV1(t *testing.T) {
 			var fetchedGroup v1.Group
 			err = json.Unmarshal(fetchedGroupResp.Body, &fetchedGroup)
 			require.NoError(t, err)
-			require.Empty(t, fetchedGroup.Members)
+			require.NotContains(t, fetchedGroup.Members, accountID)
+		})
+
+		// Test assigning, revoking and transferring roles within a group
+		t.Run("Roles", func(t *testing.T) {
+			entries, teardown := setupTest(t, sectorAPI, testAuth.Account)
+			defer teardown(t)
+
+			group := entries[5].(v1.Group)
+			channel := entries[10].(v1.Channel)
+			message := entries[16].(v1.Message)
+			moderator := entries[0].(v1.Account)
+			member := entries[1].(v1.Account)
+			pinned := true
+
+			// Plain members cannot pin messages or manage channels
+			pinResponse, err := testClient.UpdateMessageByIDWithResponse(context.Background(), group.Id, channel.Id, message.Id, v1.MessageUpdate{Pinned: &pinned}, authAs(t, sectorAPI, member))
+			require.NoError(t, err)
+			require.Equal(t, 403, pinResponse.StatusCode())
+
+			// Ownership can only be handed over with a transfer
+			roleResponse, err := testClient.AssignGroupRoleWithResponse(context.Background(), group.Id, moderator.Id, v1.GroupRoleAssignment{Role: v1.Owner}, authEditor)
+			require.NoError(t, err)
+			require.Equal(t, 400, roleResponse.StatusCode())
+			require.Equal(t, v1.BadRequest, roleResponse.ApplicationproblemJSONDefault.Code)
+
+			// Roles that are not in the schema fail validation
+			roleResponse, err = testClient.AssignGroupRoleWithResponse(context.Background(), group.Id, moderator.Id, v1.GroupRoleAssignment{Role: "superuser"}, authEditor)
+			require.NoError(t, err)
+			require.Equal(t, 422, roleResponse.StatusCode())
+			require.Equal(t, v1.ValidationFailed, roleResponse.ApplicationproblemJSONDefault.Code)
+
+			roleResponse, err = testClient.AssignGroupRoleWithResponse(context.Background(), group.Id, moderator.Id, v1.GroupRoleAssignment{Role: v1.Moderator}, authEditor)
+			require.NoError(t, err)
+			require.Equal(t, 201, roleResponse.StatusCode())
+
+			var updatedGroup v1.Group
+			require.NoError(t, json.Unmarshal(roleResponse.Body, &updatedGroup))
+			require.Equal(t, v1.Moderator, (*updatedGroup.Roles)[moderator.Id.String()])
+
+			// Moderators can pin messages, but not create channels or hand out roles
+			pinResponse, err = testClient.UpdateMessageByIDWithResponse(context.Background(), group.Id, channel.Id, message.Id, v1.MessageUpdate{Pinned: &pinned}, authAs(t, sectorAPI, moderator))
+			require.NoError(t, err)
+			require.Equal(t, 201, pinResponse.StatusCode())
+
+			newChannel := v1.PutChannelJSONRequestBody{Id: uuid.New(), Name: "Moderated", Group: group.Id}
+			channelResponse, err := testClient.PutChannelWithResponse(context.Background(), group.Id, newChannel, authAs(t, sectorAPI, moderator))
+			require.NoError(t, err)
+			require.Equal(t, 403, channelResponse.StatusCode())
+
+			roleResponse, err = testClient.AssignGroupRoleWithResponse(context.Background(), group.Id, member.Id, v1.GroupRoleAssignment{Role: v1.Moderator}, authAs(t, sectorAPI, moderator))
+			require.NoError(t, err)
+			require.Equal(t, 403, roleResponse.StatusCode())
+
+			// Moderators cannot remove those who outrank them
+			removeResponse, err := testClient.RemoveGroupMemberWithResponse(context.Background(), group.Id, testAuth.Account.Id, authAs(t, sectorAPI, moderator))
+			require.NoError(t, err)
+			require.Equal(t, 403, removeResponse.StatusCode())
+
+			revokeResponse, err := testClient.RevokeGroupRoleWithResponse(context.Background(), group.Id, moderator.Id, authEditor)
+			require.NoError(t, err)
+			require.Equal(t, 204, revokeResponse.StatusCode())
+
+			pinResponse, err = testClient.UpdateMessageByIDWithResponse(context.Background(), group.Id, channel.Id, message.Id, v1.MessageUpdate{Pinned: &pinned}, authAs(t, sectorAPI, moderator))
+			require.NoError(t, err)
+			require.Equal(t, 403, pinResponse.StatusCode())
+
+			// Transferring ownership leaves the previous owner as an admin
+			transferResponse, err := testClient.TransferGroupOwnershipWithResponse(context.Background(), group.Id, member.Id, authEditor)
+			require.NoError(t, err)
+			require.Equal(t, 201, transferResponse.StatusCode())
+
+			require.NoError(t, json.Unmarshal(transferResponse.Body, &updatedGroup))
+			require.Equal(t, v1.Owner, (*updatedGroup.Roles)[member.Id.String()])
+			require.Equal(t, v1.Admin, (*updatedGroup.Roles)[testAuth.Account.Id.String()])
+
+			deleteResponse, err := testClient.DeleteGroupByIDWithResponse(context.Background(), group.Id, authEditor)
+			require.NoError(t, err)
+			require.Equal(t, 403, deleteResponse.StatusCode())
+
+			deleteResponse, err = testClient.DeleteGroupByIDWithResponse(context.Background(), group.Id, authAs(t, sectorAPI, member))
+			require.NoError(t, err)
+			require.Equal(t, 202, deleteResponse.StatusCode())
+			require.Equal(t, v1.Done, waitForJob(t, testClient, deleteResponse.JSON202, authAs(t, sectorAPI, member)).Status)
 		})
 	})
 
@@ -835,7 +1427,7 @@ func TestSectorV1(t *testing.T) {
 	t.Run("Channel", func(t *testing.T) {
 		// Test channel creation
 		t.Run("Create Channel", func(t *testing.T) {
-			entries, teardown := setupTest(t, *sectorAPI)
+			entries, teardown := setupTest(t, sectorAPI, testAuth.Account)
 			defer teardown(t)
 
 			// Valid group ID test
@@ -864,12 +1456,13 @@ func TestSectorV1(t *testing.T) {
 			body.Group = invalidGroupID
 			response, err = testClient.PutChannelWithResponse(context.Background(), invalidGroupID, body, authEditor)
 			require.NoError(t, err)
-			require.Equal(t, 500, response.StatusCode())
+			require.Equal(t, 404, response.StatusCode())
+			require.Equal(t, v1.NotFound, response.ApplicationproblemJSONDefault.Code)
 		})
 
 		// Test channel update
 		t.Run("Update Channel By Id", func(t *testing.T) {
-			entries, teardown := setupTest(t, *sectorAPI)
+			entries, teardown := setupTest(t, sectorAPI, testAuth.Account)
 			defer teardown(t)
 
 			selectedChannel := entries[10].(v1.Channel)
@@ -902,26 +1495,29 @@ func TestSectorV1(t *testing.T) {
 
 		// Test channel deletion
 		t.Run("Delete Channel By Id", func(t *testing.T) {
-			entries, teardown := setupTest(t, *sectorAPI)
+			entries, teardown := setupTest(t, sectorAPI, testAuth.Account)
 			defer teardown(t)
 
 			selectedChannel := entries[11].(v1.Channel)
 			groupID := selectedChannel.Group
 
-			// Test successful deletion
+			// Test successful deletion, which carries on in the background
 			response, err := testClient.DeleteChannelByIDWithResponse(context.Background(), groupID, selectedChannel.Id, authEditor)
 			require.NoError(t, err)
-			require.Equal(t, 204, response.StatusCode())
+			require.Equal(t, 202, response.StatusCode())
+			job := waitForJob(t, testClient, response.JSON202, authEditor)
+			require.Equal(t, v1.Done, job.Status)
+			require.Empty(t, sectorAPI.DB.Index(database.MessageStore).Lookup("channel", selectedChannel.Id.String()))
 
 			// Test deletion of non-existent channel
 			response, err = testClient.DeleteChannelByIDWithResponse(context.Background(), groupID, selectedChannel.Id, authEditor)
 			require.NoError(t, err)
-			require.Equal(t, 500, response.StatusCode())
+			require.Equal(t, 404, response.StatusCode())
 		})
 
 		// Test channel retrieval
 		t.Run("Get Channel By Id", func(t *testing.T) {
-			entries, teardown := setupTest(t, *sectorAPI)
+			entries, teardown := setupTest(t, sectorAPI, testAuth.Account)
 			defer teardown(t)
 
 			selectedChannel := entries[12].(v1.Channel)
@@ -947,7 +1543,7 @@ func TestSectorV1(t *testing.T) {
 		t.Run("Search Channels", func(t *testing.T) {
 			// Test search by ID
 			t.Run("By Id", func(t *testing.T) {
-				entries, teardown := setupTest(t, *sectorAPI)
+				entries, teardown := setupTest(t, sectorAPI, testAuth.Account)
 				defer teardown(t)
 
 				var ids = []types.UUID{entries[10].(v1.Channel).Id}
@@ -958,15 +1554,15 @@ func TestSectorV1(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, 200, result.StatusCode())
 
-				var queryResult []v1.Channel
+				var queryResult v1.ChannelPage
 				err = json.Unmarshal(result.Body, &queryResult)
 				require.NoError(t, err)
-				require.Equal(t, 1, len(queryResult))
+				require.Equal(t, 1, len(queryResult.Items))
 			})
 
 			// Test search by creation date
 			t.Run("By creation time", func(t *testing.T) {
-				_, teardown := setupTest(t, *sectorAPI)
+				_, teardown := setupTest(t, sectorAPI, testAuth.Account)
 				defer teardown(t)
 
 				var timeStart = time.Now().AddDate(0, 0, -10)
@@ -979,15 +1575,15 @@ func TestSectorV1(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, 200, result.StatusCode())
 
-				var queryResult []v1.Channel
+				var queryResult v1.ChannelPage
 				err = json.Unmarshal(result.Body, &queryResult)
 				require.NoError(t, err)
-				require.Equal(t, 3, len(queryResult))
+				require.Equal(t, 3, len(queryResult.Items))
 			})
 
 			// Test search by name
 			t.Run("By name", func(t *testing.T) {
-				_, teardown := setupTest(t, *sectorAPI)
+				_, teardown := setupTest(t, sectorAPI, testAuth.Account)
 				defer teardown(t)
 
 				searchName := "Main"
@@ -998,15 +1594,15 @@ func TestSectorV1(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, 200, result.StatusCode())
 
-				var queryResult []v1.Channel
+				var queryResult v1.ChannelPage
 				err = json.Unmarshal(result.Body, &queryResult)
 				require.NoError(t, err)
-				require.Equal(t, 1, len(queryResult))
+				require.Equal(t, 1, len(queryResult.Items))
 			})
 
 			// Test search by group
 			t.Run("By group", func(t *testing.T) {
-				entries, teardown := setupTest(t, *sectorAPI)
+				entries, teardown := setupTest(t, sectorAPI, testAuth.Account)
 				defer teardown(t)
 
 				var groupIDs = []types.UUID{entries[5].(v1.Group).Id}
@@ -1017,10 +1613,10 @@ func TestSectorV1(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, 200, result.StatusCode())
 
-				var queryResult []v1.Channel
+				var queryResult v1.ChannelPage
 				err = json.Unmarshal(result.Body, &queryResult)
 				require.NoError(t, err)
-				require.Equal(t, 1, len(queryResult))
+				require.Equal(t, 1, len(queryResult.Items))
 			})
 		})
 	})
@@ -1029,7 +1625,7 @@ func TestSectorV1(t *testing.T) {
 	t.Run("Message", func(t *testing.T) {
 		// Test message creation
 		t.Run("Create Message", func(t *testing.T) {
-			entries, teardown := setupTest(t, *sectorAPI)
+			entries, teardown := setupTest(t, sectorAPI, testAuth.Account)
 			defer teardown(t)
 
 			// Valid group and channel ID test
@@ -1042,8 +1638,15 @@ func TestSectorV1(t *testing.T) {
 				Channel: validChannelID,
 				Pinned:  false,
 			}
+
+			// Test that messages cannot be posted on behalf of someone else
 			response, err := testClient.PutMessageWithResponse(context.Background(), validGroupID, validChannelID, body, authEditor)
 			require.NoError(t, err)
+			require.Equal(t, 403, response.StatusCode())
+
+			body.Author = testAuth.Account.Id
+			response, err = testClient.PutMessageWithResponse(context.Background(), validGroupID, validChannelID, body, authEditor)
+			require.NoError(t, err)
 			require.Equal(t, 201, response.StatusCode())
 
 			var createdMessage v1.Message
@@ -1060,19 +1663,37 @@ func TestSectorV1(t *testing.T) {
 			invalidGroupID := uuid.New()
 			response, err = testClient.PutMessageWithResponse(context.Background(), invalidGroupID, validChannelID, body, authEditor)
 			require.NoError(t, err)
-			require.Equal(t, 500, response.StatusCode())
+			require.Equal(t, 404, response.StatusCode())
 
 			// Invalid channel ID test
 			invalidChannelID := uuid.New()
 			body.Channel = invalidChannelID
 			response, err = testClient.PutMessageWithResponse(context.Background(), validGroupID, invalidChannelID, body, authEditor)
 			require.NoError(t, err)
-			require.Equal(t, 500, response.StatusCode())
+			require.Equal(t, 404, response.StatusCode())
+
+			// A member of a group whose account no longer exists cannot post as it
+			now := time.Now()
+			ghost := v1.Account{Id: uuid.New(), Username: "Ghost"}
+			ghostGroup := v1.Group{Id: uuid.New(), CreatedAt: &now, Name: "Haunted", Members: []types.UUID{ghost.Id}}
+			ghostChannel := v1.Channel{Id: uuid.New(), CreatedAt: &now, Name: "Hall", Group: ghostGroup.Id}
+			_, err = sectorAPI.DB.Put(context.Background(), database.GroupStore, v1.ToDocument(ghostGroup))
+			require.NoError(t, err)
+			_, err = sectorAPI.DB.Put(context.Background(), database.ChannelStore, v1.ToDocument(ghostChannel))
+			require.NoError(t, err)
+
+			body.Id = uuid.New()
+			body.Author = ghost.Id
+			body.Channel = ghostChannel.Id
+			response, err = testClient.PutMessageWithResponse(context.Background(), ghostGroup.Id, ghostChannel.Id, body, authAs(t, sectorAPI, ghost))
+			require.NoError(t, err)
+			require.Equal(t, 404, response.StatusCode())
+			require.Equal(t, v1.NotFound, response.ApplicationproblemJSONDefault.Code)
 		})
 
 		// Test message update
 		t.Run("Update Message By Id", func(t *testing.T) {
-			entries, teardown := setupTest(t, *sectorAPI)
+			entries, teardown := setupTest(t, sectorAPI, testAuth.Account)
 			defer teardown(t)
 
 			selectedMessage := entries[15].(v1.Message)
@@ -1083,8 +1704,14 @@ func TestSectorV1(t *testing.T) {
 			body := v1.UpdateMessageByIDJSONRequestBody{
 				Body: &newBody,
 			}
+
+			// Test that only the author can update the message
 			response, err := testClient.UpdateMessageByIDWithResponse(context.Background(), groupID, selectedMessage.Channel, selectedMessage.Id, body, authEditor)
 			require.NoError(t, err)
+			require.Equal(t, 403, response.StatusCode())
+
+			response, err = testClient.UpdateMessageByIDWithResponse(context.Background(), groupID, selectedMessage.Channel, selectedMessage.Id, body, authAs(t, sectorAPI, entries[0].(v1.Account)))
+			require.NoError(t, err)
 			require.Equal(t, 201, response.StatusCode())
 
 			var updatedMessage v1.Message
@@ -1110,27 +1737,33 @@ func TestSectorV1(t *testing.T) {
 
 		// Test message deletion
 		t.Run("Delete Message By Id", func(t *testing.T) {
-			entries, teardown := setupTest(t, *sectorAPI)
+			entries, teardown := setupTest(t, sectorAPI, testAuth.Account)
 			defer teardown(t)
 
 			selectedMessage := entries[16].(v1.Message)
 			selectedChannel := entries[10].(v1.Channel) // Message at 16 is in "Main" channel (index 10)
 			groupID := selectedChannel.Group
 
-			// Test successful deletion
+			// Test that only the author can delete the message
 			response, err := testClient.DeleteMessageByIDWithResponse(context.Background(), groupID, selectedMessage.Channel, selectedMessage.Id, authEditor)
 			require.NoError(t, err)
+			require.Equal(t, 403, response.StatusCode())
+
+			// Test successful deletion
+			author := authAs(t, sectorAPI, entries[1].(v1.Account))
+			response, err = testClient.DeleteMessageByIDWithResponse(context.Background(), groupID, selectedMessage.Channel, selectedMessage.Id, author)
+			require.NoError(t, err)
 			require.Equal(t, 204, response.StatusCode())
 
 			// Test deletion of non-existent message
-			response, err = testClient.DeleteMessageByIDWithResponse(context.Background(), groupID, selectedMessage.Channel, selectedMessage.Id, authEditor)
+			response, err = testClient.DeleteMessageByIDWithResponse(context.Background(), groupID, selectedMessage.Channel, selectedMessage.Id, author)
 			require.NoError(t, err)
-			require.Equal(t, 500, response.StatusCode())
+			require.Equal(t, 404, response.StatusCode())
 		})
 
 		// Test message retrieval
 		t.Run("Get Message By Id", func(t *testing.T) {
-			entries, teardown := setupTest(t, *sectorAPI)
+			entries, teardown := setupTest(t, sectorAPI, testAuth.Account)
 			defer teardown(t)
 
 			selectedMessage := entries[17].(v1.Message)
@@ -1158,7 +1791,7 @@ func TestSectorV1(t *testing.T) {
 		t.Run("Search Message", func(t *testing.T) {
 			// Test search by ID
 			t.Run("By Id", func(t *testing.T) {
-				entries, teardown := setupTest(t, *sectorAPI)
+				entries, teardown := setupTest(t, sectorAPI, testAuth.Account)
 				defer teardown(t)
 
 				var ids = []types.UUID{entries[15].(v1.Message).Id}
@@ -1169,15 +1802,15 @@ func TestSectorV1(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, 200, result.StatusCode())
 
-				var queryResult []v1.Message
+				var queryResult v1.MessagePage
 				err = json.Unmarshal(result.Body, &queryResult)
 				require.NoError(t, err)
-				require.Equal(t, 1, len(queryResult))
+				require.Equal(t, 1, len(queryResult.Items))
 			})
 
 			// Test search by creation date
 			t.Run("By creation time", func(t *testing.T) {
-				_, teardown := setupTest(t, *sectorAPI)
+				_, teardown := setupTest(t, sectorAPI, testAuth.Account)
 				defer teardown(t)
 
 				var timeStart = time.Now().AddDate(0, 0, -10)
@@ -1190,15 +1823,15 @@ func TestSectorV1(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, 200, result.StatusCode())
 
-				var queryResult []v1.Message
+				var queryResult v1.MessagePage
 				err = json.Unmarshal(result.Body, &queryResult)
 				require.NoError(t, err)
-				require.Equal(t, 2, len(queryResult))
+				require.Equal(t, 2, len(queryResult.Items))
 			})
 
 			// Test search by author
 			t.Run("By author", func(t *testing.T) {
-				entries, teardown := setupTest(t, *sectorAPI)
+				entries, teardown := setupTest(t, sectorAPI, testAuth.Account)
 				defer teardown(t)
 
 				var authorIDs = []types.UUID{entries[0].(v1.Account).Id}
@@ -1209,15 +1842,15 @@ func TestSectorV1(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, 200, result.StatusCode())
 
-				var queryResult []v1.Message
+				var queryResult v1.MessagePage
 				err = json.Unmarshal(result.Body, &queryResult)
 				require.NoError(t, err)
-				require.Equal(t, 2, len(queryResult))
+				require.Equal(t, 2, len(queryResult.Items))
 			})
 
 			// Test search by channel
 			t.Run("By channel", func(t *testing.T) {
-				entries, teardown := setupTest(t, *sectorAPI)
+				entries, teardown := setupTest(t, sectorAPI, testAuth.Account)
 				defer teardown(t)
 
 				var channelIDs = []types.UUID{entries[10].(v1.Channel).Id}
@@ -1228,15 +1861,15 @@ func TestSectorV1(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, 200, result.StatusCode())
 
-				var queryResult []v1.Message
+				var queryResult v1.MessagePage
 				err = json.Unmarshal(result.Body, &queryResult)
 				require.NoError(t, err)
-				require.Equal(t, 2, len(queryResult))
+				require.Equal(t, 2, len(queryResult.Items))
 			})
 
 			// Test search by pinned status
 			t.Run("By pinned", func(t *testing.T) {
-				_, teardown := setupTest(t, *sectorAPI)
+				_, teardown := setupTest(t, sectorAPI, testAuth.Account)
 				defer teardown(t)
 
 				pinned := true
@@ -1247,15 +1880,15 @@ func TestSectorV1(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, 200, result.StatusCode())
 
-				var queryResult []v1.Message
+				var queryResult v1.MessagePage
 				err = json.Unmarshal(result.Body, &queryResult)
 				require.NoError(t, err)
-				require.Equal(t, 1, len(queryResult))
+				require.Equal(t, 1, len(queryResult.Items))
 			})
 
 			// Test search by message body content
 			t.Run("By body", func(t *testing.T) {
-				_, teardown := setupTest(t, *sectorAPI)
+				_, teardown := setupTest(t, sectorAPI, testAuth.Account)
 				defer teardown(t)
 
 				bodySearch := "Welcome"
@@ -1266,14 +1899,291 @@ func TestSectorV1(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, 200, result.StatusCode())
 
-				var queryResult []v1.Message
+				var queryResult v1.MessagePage
 				err = json.Unmarshal(result.Body, &queryResult)
 				require.NoError(t, err)
-				require.Equal(t, 1, len(queryResult))
+				require.Equal(t, 1, len(queryResult.Items))
+			})
+
+			// Test paging through a channel's messages newest first
+			t.Run("Paginated", func(t *testing.T) {
+				entries, teardown := setupTest(t, sectorAPI, testAuth.Account)
+				defer teardown(t)
+
+				newest := entries[15].(v1.Message)
+				oldest := entries[16].(v1.Message)
+				var channelIDs = []types.UUID{newest.Channel}
+				limit := 1
+				order := v1.CreatedAtDesc
+				query := v1.SearchMessagesJSONRequestBody{
+					Channel: &channelIDs,
+					Limit:   &limit,
+					Sort:    &order,
+				}
+				result, err := testClient.SearchMessagesWithResponse(context.Background(), query, authEditor)
+				require.NoError(t, err)
+				require.Equal(t, 200, result.StatusCode())
+
+				var firstPage v1.MessagePage
+				err = json.Unmarshal(result.Body, &firstPage)
+				require.NoError(t, err)
+				require.Equal(t, 1, len(firstPage.Items))
+				require.Equal(t, newest.Id, firstPage.Items[0].Id)
+				require.NotNil(t, firstPage.NextCursor)
+
+				query.Cursor = firstPage.NextCursor
+				result, err = testClient.SearchMessagesWithResponse(context.Background(), query, authEditor)
+				require.NoError(t, err)
+				require.Equal(t, 200, result.StatusCode())
+
+				var secondPage v1.MessagePage
+				err = json.Unmarshal(result.Body, &secondPage)
+				require.NoError(t, err)
+				require.Equal(t, 1, len(secondPage.Items))
+				require.Equal(t, oldest.Id, secondPage.Items[0].Id)
+				require.Nil(t, secondPage.NextCursor)
+			})
+
+			// Test that a cursor not handed out by the server is rejected
+			t.Run("Invalid cursor", func(t *testing.T) {
+				_, teardown := setupTest(t, sectorAPI, testAuth.Account)
+				defer teardown(t)
+
+				cursor := "not a cursor"
+				query := v1.SearchMessagesJSONRequestBody{
+					Cursor: &cursor,
+				}
+				result, err := testClient.SearchMessagesWithResponse(context.Background(), query, authEditor)
+				require.NoError(t, err)
+				require.Equal(t, 400, result.StatusCode())
 			})
 		})
 	})
 
+	// Test the event stream
+	t.Run("Events", func(t *testing.T) {
+		// Open an event stream for a channel, resuming after lastEventID if it is not empty
+		openStream := func(t *testing.T, ctx context.Context, channelID types.UUID, lastEventID string) *bufio.Reader {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/v1/api/events?channel="+channelID.String(), nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer "+testAuth.JWTToken)
+			if lastEventID != "" {
+				req.Header.Set("Last-Event-ID", lastEventID)
+			}
+
+			resp, err := server.Client().Do(req)
+			require.NoError(t, err)
+			require.Equal(t, 200, resp.StatusCode)
+			require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+			t.Cleanup(func() { resp.Body.Close() })
+			return bufio.NewReader(resp.Body)
+		}
+
+		t.Run("Stream and Resume", func(t *testing.T) {
+			entries, teardown := setupTest(t, sectorAPI, testAuth.Account)
+			defer teardown(t)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			channel := entries[10].(v1.Channel)
+			stream := openStream(t, ctx, channel.Id, "")
+
+			body := v1.PutMessageJSONRequestBody{
+				Id:      uuid.New(),
+				Author:  testAuth.Account.Id,
+				Body:    "Hello from the event stream",
+				Channel: channel.Id,
+			}
+			response, err := testClient.PutMessageWithResponse(ctx, channel.Group, channel.Id, body, authEditor)
+			require.NoError(t, err)
+			require.Equal(t, 201, response.StatusCode())
+
+			created := readEvent(t, stream)
+			require.Equal(t, v1.MessageCreated, created.Type)
+			require.Equal(t, body.Id, created.Item)
+			require.Equal(t, channel.Id, *created.Channel)
+			require.Equal(t, channel.Group, *created.Group)
+			require.Equal(t, body.Body, created.Data["body"])
+
+			// Changes made while disconnected are replayed when resuming from the last event received
+			newBody := "Edited while nobody was listening"
+			updateResponse, err := testClient.UpdateMessageByIDWithResponse(ctx, channel.Group, channel.Id, body.Id, v1.MessageUpdate{Body: &newBody}, authEditor)
+			require.NoError(t, err)
+			require.Equal(t, 201, updateResponse.StatusCode())
+
+			resumed := openStream(t, ctx, channel.Id, fmt.Sprint(created.Id))
+			updated := readEvent(t, resumed)
+			require.Equal(t, v1.MessageUpdated, updated.Type)
+			require.Equal(t, created.Id+1, updated.Id)
+			require.Equal(t, newBody, updated.Data["body"])
+		})
+
+		t.Run("Not a Member", func(t *testing.T) {
+			entries, teardown := setupTest(t, sectorAPI, testAuth.Account)
+			defer teardown(t)
+
+			channel := entries[10].(v1.Channel)
+			req, err := http.NewRequest(http.MethodGet, server.URL+"/v1/api/events?channel="+channel.Id.String(), nil)
+			require.NoError(t, err)
+			require.NoError(t, authAs(t, sectorAPI, entries[2].(v1.Account))(context.Background(), req))
+
+			resp, err := server.Client().Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			require.Equal(t, 403, resp.StatusCode)
+		})
+	})
+
+	// Test the WebSocket gateway
+	t.Run("Gateway", func(t *testing.T) {
+		gatewayURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/v1/ws"
+
+		// Connect to the gateway with the test user's token and wait until it is ready
+		dial := func(t *testing.T) *websocket.Conn {
+			header := http.Header{}
+			header.Set("Authorization", "Bearer "+testAuth.JWTToken)
+			conn, _, err := websocket.DefaultDialer.Dial(gatewayURL, header)
+			require.NoError(t, err)
+			t.Cleanup(func() { conn.Close() })
+			conn.SetReadDeadline(time.Now().Add(30 * time.Second))
+
+			var ready v1.GatewayResponse
+			require.NoError(t, conn.ReadJSON(&ready))
+			require.Equal(t, v1.FrameReady, ready.Type)
+			require.Equal(t, testAuth.Account.Id, *ready.User)
+			return conn
+		}
+
+		t.Run("Authenticate Frame", func(t *testing.T) {
+			conn, _, err := websocket.DefaultDialer.Dial(gatewayURL, nil)
+			require.NoError(t, err)
+			defer conn.Close()
+			conn.SetReadDeadline(time.Now().Add(30 * time.Second))
+
+			require.NoError(t, conn.WriteJSON(v1.GatewayRequest{Type: v1.FrameAuthenticate, Token: testAuth.JWTToken}))
+			var ready v1.GatewayResponse
+			require.NoError(t, conn.ReadJSON(&ready))
+			require.Equal(t, v1.FrameReady, ready.Type)
+		})
+
+		t.Run("Invalid Token", func(t *testing.T) {
+			header := http.Header{}
+			header.Set("Authorization", "Bearer not-a-token")
+			_, response, err := websocket.DefaultDialer.Dial(gatewayURL, header)
+			require.Error(t, err)
+			require.Equal(t, 401, response.StatusCode)
+
+			conn, _, err := websocket.DefaultDialer.Dial(gatewayURL, nil)
+			require.NoError(t, err)
+			defer conn.Close()
+			conn.SetReadDeadline(time.Now().Add(30 * time.Second))
+
+			require.NoError(t, conn.WriteJSON(v1.GatewayRequest{Type: v1.FrameAuthenticate, Token: "not-a-token"}))
+			_, _, err = conn.ReadMessage()
+			require.True(t, websocket.IsCloseError(err, websocket.ClosePolicyViolation))
+		})
+
+		t.Run("Messages and Typing", func(t *testing.T) {
+			entries, teardown := setupTest(t, sectorAPI, testAuth.Account)
+			defer teardown(t)
+
+			channel := entries[10].(v1.Channel)
+			sender := dial(t)
+			listener := dial(t)
+
+			require.NoError(t, listener.WriteJSON(v1.GatewayRequest{Type: v1.FrameSubscribe, Nonce: "subscribe", Channels: []types.UUID{channel.Id}}))
+			var subscribed v1.GatewayResponse
+			require.NoError(t, listener.ReadJSON(&subscribed))
+			require.Equal(t, v1.FrameAck, subscribed.Type)
+			require.Equal(t, "subscribe", subscribed.Nonce)
+			require.Equal(t, []types.UUID{channel.Id}, subscribed.Channels)
+
+			// Typing indicators reach subscribers of the channel
+			require.NoError(t, sender.WriteJSON(v1.GatewayRequest{Type: v1.FrameTyping, Channel: &channel.Id}))
+			var typing v1.GatewayResponse
+			require.NoError(t, listener.ReadJSON(&typing))
+			require.Equal(t, v1.FrameTyping, typing.Type)
+			require.Equal(t, testAuth.Account.Id, *typing.User)
+			require.Equal(t, channel.Id, *typing.Channel)
+			require.Equal(t, channel.Group, *typing.Group)
+
+			// Messages sent through the gateway are acknowledged, and reach subscribers as events
+			require.NoError(t, sender.WriteJSON(v1.GatewayRequest{Type: v1.FrameSendMessage, Nonce: "send", Channel: &channel.Id, Body: "Hello over the gateway"}))
+			var sent v1.GatewayResponse
+			require.NoError(t, sender.ReadJSON(&sent))
+			require.Equal(t, v1.FrameAck, sent.Type)
+			require.Equal(t, "send", sent.Nonce)
+			require.Equal(t, testAuth.Account.Id, sent.Message.Author)
+
+			var event v1.GatewayResponse
+			require.NoError(t, listener.ReadJSON(&event))
+			require.Equal(t, v1.FrameEvent, event.Type)
+			require.Equal(t, v1.MessageCreated, event.Event.Type)
+			require.Equal(t, sent.Message.Id, event.Event.Item)
+
+			// Messages cannot be sent to channels that do not exist
+			missing := uuid.New()
+			require.NoError(t, sender.WriteJSON(v1.GatewayRequest{Type: v1.FrameSendMessage, Nonce: "missing", Channel: &missing, Body: "Hello?"}))
+			var failed v1.GatewayResponse
+			require.NoError(t, sender.ReadJSON(&failed))
+			require.Equal(t, v1.FrameError, failed.Type)
+			require.Equal(t, "missing", failed.Nonce)
+
+			// Channels of groups the user is not a member of cannot be subscribed to
+			outsider := uuid.New()
+			_, err := sectorAPI.DB.Put(context.Background(), database.GroupStore, v1.ToDocument(v1.Group{Id: outsider, Name: "Outsiders", Members: []types.UUID{}}))
+			require.NoError(t, err)
+			require.NoError(t, sender.WriteJSON(v1.GatewayRequest{Type: v1.FrameSubscribe, Nonce: "outsider", Groups: []types.UUID{outsider}}))
+			require.NoError(t, sender.ReadJSON(&failed))
+			require.Equal(t, v1.FrameError, failed.Type)
+			require.Equal(t, "outsider", failed.Nonce)
+
+			// A channel whose group is not an id, as replicated data can have, is refused rather than crashing the server
+			garbled := v1.ToDocument(v1.Group{Id: uuid.New(), Name: "Garbled", Members: []types.UUID{testAuth.Account.Id}})
+			garbled["id"] = "not-an-id"
+			_, err = sectorAPI.DB.Put(context.Background(), database.GroupStore, garbled)
+			require.NoError(t, err)
+			garbledId := uuid.New()
+			garbledChannel := v1.ToDocument(v1.Channel{Id: garbledId, Name: "garbled"})
+			garbledChannel["group"] = "not-an-id"
+			_, err = sectorAPI.DB.Put(context.Background(), database.ChannelStore, garbledChannel)
+			require.NoError(t, err)
+			require.NoError(t, sender.WriteJSON(v1.GatewayRequest{Type: v1.FrameTyping, Nonce: "garbled", Channel: &garbledId}))
+			require.NoError(t, sender.ReadJSON(&failed))
+			require.Equal(t, v1.FrameError, failed.Type)
+			require.Equal(t, "garbled", failed.Nonce)
+		})
+	})
+
+	// Test that the API only lets accounts at the items they own or are a member of
+	t.Run("Authorization", func(t *testing.T) {
+		entries, teardown := setupTest(t, sectorAPI, testAuth.Account)
+		defer teardown(t)
+
+		// Not a member of the first group
+		outsider := authAs(t, sectorAPI, entries[2].(v1.Account))
+		group := entries[5].(v1.Group)
+		channel := entries[10].(v1.Channel)
+
+		channelResponse, err := testClient.GetChannelByIDWithResponse(context.Background(), group.Id, channel.Id, outsider)
+		require.NoError(t, err)
+		require.Equal(t, 403, channelResponse.StatusCode())
+
+		memberResponse, err := testClient.AddGroupMemberWithResponse(context.Background(), group.Id, entries[2].(v1.Account).Id, outsider)
+		require.NoError(t, err)
+		require.Equal(t, 403, memberResponse.StatusCode())
+
+		// Channels of other groups are left out of searches
+		searchResponse, err := testClient.SearchChannelsWithResponse(context.Background(), v1.SearchChannelsJSONRequestBody{}, outsider)
+		require.NoError(t, err)
+		require.Equal(t, 200, searchResponse.StatusCode())
+
+		var page v1.ChannelPage
+		require.NoError(t, json.Unmarshal(searchResponse.Body, &page))
+		require.Empty(t, page.Items)
+	})
+
 	// Test Authentication endpoints and behavior
 	t.Run("Authentication", func(t *testing.T) {
 		t.Run("Test Unauthenticated Access", func(t *testing.T) {
@@ -1305,10 +2215,11 @@ func TestSectorV1(t *testing.T) {
 				Username:   testUsername,
 				ProfilePic: "",
 				Pubkey:     string(pubKeyPEM),
+				Signature:  signRegistration(t, testClient, testUsername, privateKey),
 			}
 
-			// Create account (using the existing auth)
-			createResp, err := testClient.PutAccountWithResponse(context.Background(), accountBody, authEditor)
+			// Create account, proving we hold its key
+			createResp, err := testClient.PutAccountWithResponse(context.Background(), accountBody)
 			require.NoError(t, err)
 			require.Equal(t, 201, createResp.StatusCode())
 
@@ -1328,6 +2239,11 @@ func TestSectorV1(t *testing.T) {
 			require.True(t, ok)
 			require.NotEmpty(t, challenge)
 
+			// A second challenge does not replace the first, either can be answered
+			challengeResp, err = testClient.GetChallengeWithResponse(context.Background(), &challengeParams)
+			require.NoError(t, err)
+			require.Equal(t, 200, challengeResp.StatusCode())
+
 			// Sign challenge
 			challengeBytes, err := base64.StdEncoding.DecodeString(challenge)
 			require.NoError(t, err)
@@ -1362,6 +2278,318 @@ func TestSectorV1(t *testing.T) {
 			accessResp, err := testClient.GetAccountByIDWithResponse(context.Background(), accountBody.Id, newAuthEditor)
 			require.NoError(t, err)
 			require.Equal(t, 200, accessResp.StatusCode())
+
+			// Login also gives a refresh token to stay signed in with
+			require.NotEmpty(t, loginData["refresh_token"])
 		})
+
+		t.Run("Test Refresh and Logout", func(t *testing.T) {
+			tokens, err := sectorAPI.Auth.GenerateTokenPair(testAuth.Account.Id.String(), testAuth.Account.Username, "")
+			require.NoError(t, err)
+
+			refreshResp, err := testClient.RefreshTokenWithResponse(context.Background(), v1.RefreshTokenJSONRequestBody{RefreshToken: tokens.RefreshToken})
+			require.NoError(t, err)
+			require.Equal(t, 200, refreshResp.StatusCode())
+			refreshed := refreshResp.JSON200
+			require.NotEmpty(t, refreshed.Token)
+			require.NotEqual(t, tokens.RefreshToken, refreshed.RefreshToken)
+
+			// Refresh tokens are rotated, so each can only be used once
+			refreshResp, err = testClient.RefreshTokenWithResponse(context.Background(), v1.RefreshTokenJSONRequestBody{RefreshToken: tokens.RefreshToken})
+			require.NoError(t, err)
+			require.Equal(t, 401, refreshResp.StatusCode())
+
+			// Logging out ends the session, along with every token issued in it
+			tokens, err = sectorAPI.Auth.GenerateTokenPair(testAuth.Account.Id.String(), testAuth.Account.Username, "")
+			require.NoError(t, err)
+			session := authRequestEditor(tokens.AccessToken)
+
+			logoutResp, err := testClient.LogoutWithResponse(context.Background(), session)
+			require.NoError(t, err)
+			require.Equal(t, 204, logoutResp.StatusCode())
+
+			accessResp, err := testClient.GetAccountByIDWithResponse(context.Background(), testAuth.Account.Id, session)
+			require.NoError(t, err)
+			require.Equal(t, 401, accessResp.StatusCode())
+
+			refreshResp, err = testClient.RefreshTokenWithResponse(context.Background(), v1.RefreshTokenJSONRequestBody{RefreshToken: tokens.RefreshToken})
+			require.NoError(t, err)
+			require.Equal(t, 401, refreshResp.StatusCode())
+		})
+
+		t.Run("Test Rate Limiting", func(t *testing.T) {
+			limits := config.RateLimitConfig{
+				Auth:   config.RateLimit{Requests: 2, Per: time.Minute},
+				Search: config.RateLimit{Requests: 1, Per: time.Minute},
+			}
+			router := mux.NewRouter().StrictSlash(true)
+			api.AddV1SectorAPIToRouter(router, sectorAPI, limits)
+			limited := httptest.NewServer(router)
+			defer limited.Close()
+
+			limitedClient, err := v1.NewClientWithResponses(limited.URL, v1.WithHTTPClient(limited.Client()), v1.WithBaseURL(limited.URL+"/v1/api"))
+			require.NoError(t, err)
+
+			// Public routes are limited by address
+			params := v1.GetChallengeParams{Username: testAuth.Account.Username}
+			for range 2 {
+				response, err := limitedClient.GetChallengeWithResponse(context.Background(), &params)
+				require.NoError(t, err)
+				require.Equal(t, 200, response.StatusCode())
+			}
+			response, err := limitedClient.GetChallengeWithResponse(context.Background(), &params)
+			require.NoError(t, err)
+			require.Equal(t, 429, response.StatusCode())
+			require.Equal(t, "30", response.HTTPResponse.Header.Get("Retry-After"))
+			require.Equal(t, v1.RateLimited, response.ApplicationproblemJSONDefault.Code)
+
+			// Authenticated routes are limited by account, so one account running out leaves the others alone
+			search := v1.SearchAccountsJSONRequestBody{}
+			searchResp, err := limitedClient.SearchAccountsWithResponse(context.Background(), search, authEditor)
+			require.NoError(t, err)
+			require.Equal(t, 200, searchResp.StatusCode())
+			searchResp, err = limitedClient.SearchAccountsWithResponse(context.Background(), search, authEditor)
+			require.NoError(t, err)
+			require.Equal(t, 429, searchResp.StatusCode())
+
+			other := v1.Account{Id: uuid.New(), Username: "other"}
+			searchResp, err = limitedClient.SearchAccountsWithResponse(context.Background(), search, authAs(t, sectorAPI, other))
+			require.NoError(t, err)
+			require.Equal(t, 200, searchResp.StatusCode())
+		})
+
+		t.Run("Test JWKS", func(t *testing.T) {
+			response, err := server.Client().Get(server.URL + "/.well-known/jwks.json")
+			require.NoError(t, err)
+			defer response.Body.Close()
+			require.Equal(t, 200, response.StatusCode)
+
+			// The public keys of the keyring are served, secret keys never are
+			var set auth.JWKSet
+			require.NoError(t, json.NewDecoder(response.Body).Decode(&set))
+			require.Equal(t, sectorAPI.Auth.PublicKeys(), set)
+		})
+	})
+
+	// Test migration of documents written before items were tagged with their type
+	t.Run("Migration", func(t *testing.T) {
+		t.Run("Untyped Documents", func(t *testing.T) {
+			entries, teardown := setupTest(t, sectorAPI, testAuth.Account)
+			defer teardown(t)
+
+			now := time.Now()
+			legacyAccount := v1.Account{
+				Id:         uuid.New(),
+				CreatedAt:  &now,
+				Username:   "Legacy Account",
+				ProfilePic: "",
+				Pubkey:     "LegacyPublicKey",
+			}
+			legacyChannel := v1.Channel{
+				Id:          uuid.New(),
+				CreatedAt:   &now,
+				Group:       entries[5].(v1.Group).Id,
+				Name:        "Legacy",
+				Description: nil,
+			}
+			untypedMessage := v1.Message{
+				Id:        uuid.New(),
+				CreatedAt: &now,
+				Author:    entries[0].(v1.Account).Id,
+				Channel:   legacyChannel.Id,
+				Body:      "Written before type tags existed.",
+				Pinned:    false,
+			}
+
+			// The legacy store was migrated when the database was opened, it has to be again for the documents below
+			migrated := filepath.Join(sectorAPI.DB.LocalPath, v1.LegacyMigratedFile)
+			require.FileExists(t, migrated)
+			require.NoError(t, os.Remove(migrated))
+
+			// Write documents the way older versions did, without a type tag
+			legacy, err := sectorAPI.DB.OpenStore(context.Background(), database.LegacyStore)
+			require.NoError(t, err)
+			_, err = legacy.PutAll(context.Background(), []interface{}{v1.StructToMap(legacyAccount), v1.StructToMap(legacyChannel)})
+			require.NoError(t, err)
+			require.NoError(t, legacy.Close())
+			_, err = sectorAPI.DB.Store(database.MessageStore).Put(context.Background(), v1.StructToMap(untypedMessage))
+			require.NoError(t, err)
+
+			err = v1.MigrateDatabase(sectorAPI.DB)
+			require.NoError(t, err)
+			require.FileExists(t, migrated)
+
+			accountResp, err := testClient.GetAccountByIDWithResponse(context.Background(), legacyAccount.Id, authEditor)
+			require.NoError(t, err)
+			require.Equal(t, 200, accountResp.StatusCode())
+
+			channelResp, err := testClient.GetChannelByIDWithResponse(context.Background(), legacyChannel.Group, legacyChannel.Id, authEditor)
+			require.NoError(t, err)
+			require.Equal(t, 200, channelResp.StatusCode())
+
+			var ids = []types.UUID{untypedMessage.Id}
+			messageResp, err := testClient.SearchMessagesWithResponse(context.Background(), v1.SearchMessagesJSONRequestBody{Id: &ids}, authEditor)
+			require.NoError(t, err)
+			require.Equal(t, 200, messageResp.StatusCode())
+
+			var queryResult v1.MessagePage
+			err = json.Unmarshal(messageResp.Body, &queryResult)
+			require.NoError(t, err)
+			require.Equal(t, 1, len(queryResult.Items))
+		})
+	})
+
+	t.Run("Integrity", func(t *testing.T) {
+		entries, teardown := setupTest(t, sectorAPI, testAuth.Account)
+		defer teardown(t)
+
+		// Write items whose references are broken, the way a replicated deletion can leave them
+		now := time.Now()
+		group := entries[5].(v1.Group)
+		missingAccount := uuid.New()
+		doc := v1.ToDocument(group)
+		doc["members"] = append(doc["members"].([]interface{}), missingAccount.String())
+		_, err := sectorAPI.DB.Put(context.Background(), database.GroupStore, doc)
+		require.NoError(t, err)
+
+		orphanedChannel := v1.Channel{Id: uuid.New(), CreatedAt: &now, Group: uuid.New(), Name: "Orphaned"}
+		_, err = sectorAPI.DB.Put(context.Background(), database.ChannelStore, v1.ToDocument(orphanedChannel))
+		require.NoError(t, err)
+		orphanedMessage := v1.Message{Id: uuid.New(), CreatedAt: &now, Author: entries[0].(v1.Account).Id, Channel: orphanedChannel.Id, Body: "Orphaned"}
+		_, err = sectorAPI.DB.Put(context.Background(), database.MessageStore, v1.ToDocument(orphanedMessage))
+		require.NoError(t, err)
+
+		// Only admins can check
+		response, err := testClient.CheckIntegrityWithResponse(context.Background(), authEditor)
+		require.NoError(t, err)
+		require.Equal(t, 403, response.StatusCode())
+
+		sectorAPI.Admins = []types.UUID{testAuth.Account.Id}
+		defer func() { sectorAPI.Admins = nil }()
+
+		// Checking reports the broken references without touching them
+		response, err = testClient.CheckIntegrityWithResponse(context.Background(), authEditor)
+		require.NoError(t, err)
+		require.Equal(t, 200, response.StatusCode())
+		require.Len(t, response.JSON200.Issues, 2)
+		require.ElementsMatch(t, []v1.IntegrityIssueKind{v1.MissingMember, v1.MissingGroup}, []v1.IntegrityIssueKind{response.JSON200.Issues[0].Kind, response.JSON200.Issues[1].Kind})
+		for _, issue := range response.JSON200.Issues {
+			require.False(t, issue.Repaired)
+		}
+		require.True(t, sectorAPI.DB.Index(database.ChannelStore).Has(orphanedChannel.Id.String()))
+
+		// Repairing quarantines the channel, then the message it leaves behind, and drops the missing member
+		repairResponse, err := testClient.RepairIntegrityWithResponse(context.Background(), authEditor)
+		require.NoError(t, err)
+		require.Equal(t, 200, repairResponse.StatusCode())
+		require.Len(t, repairResponse.JSON200.Issues, 3)
+		for _, issue := range repairResponse.JSON200.Issues {
+			require.True(t, issue.Repaired)
+		}
+		require.False(t, sectorAPI.DB.Index(database.ChannelStore).Has(orphanedChannel.Id.String()))
+		require.False(t, sectorAPI.DB.Index(database.MessageStore).Has(orphanedMessage.Id.String()))
+		require.True(t, sectorAPI.DB.Index(database.QuarantineStore).Has(orphanedChannel.Id.String()))
+		require.True(t, sectorAPI.DB.Index(database.QuarantineStore).Has(orphanedMessage.Id.String()))
+
+		groupResponse, err := testClient.GetGroupByIDWithResponse(context.Background(), group.Id, authEditor)
+		require.NoError(t, err)
+		require.Equal(t, group.Members, groupResponse.JSON200.Members)
+
+		// Nothing is left to repair
+		response, err = testClient.CheckIntegrityWithResponse(context.Background(), authEditor)
+		require.NoError(t, err)
+		require.Empty(t, response.JSON200.Issues)
+	})
+
+	t.Run("Integrity Missing Owner", func(t *testing.T) {
+		entries, teardown := setupTest(t, sectorAPI, testAuth.Account)
+		defer teardown(t)
+
+		sectorAPI.Admins = []types.UUID{testAuth.Account.Id}
+		defer func() { sectorAPI.Admins = nil }()
+
+		// A group whose owner is gone, and one whose every member is
+		now := time.Now()
+		admin := entries[0].(v1.Account).Id
+		member := entries[1].(v1.Account).Id
+		missingOwner := uuid.New()
+		group := v1.Group{
+			Id:        uuid.New(),
+			CreatedAt: &now,
+			Name:      "Ownerless",
+			Members:   []types.UUID{missingOwner, member, admin},
+			Roles:     &map[string]v1.GroupRole{missingOwner.String(): v1.Owner, admin.String(): v1.Admin},
+		}
+		abandoned := v1.Group{
+			Id:        uuid.New(),
+			CreatedAt: &now,
+			Name:      "Abandoned",
+			Members:   []types.UUID{missingOwner},
+			Roles:     &map[string]v1.GroupRole{missingOwner.String(): v1.Owner},
+		}
+		_, err := sectorAPI.DB.PutAll(context.Background(), database.GroupStore, []interface{}{v1.ToDocument(group), v1.ToDocument(abandoned)})
+		require.NoError(t, err)
+
+		response, err := testClient.CheckIntegrityWithResponse(context.Background(), authEditor)
+		require.NoError(t, err)
+		require.Equal(t, 200, response.StatusCode())
+		repairs := make(map[types.UUID]v1.IntegrityIssueRepair)
+		for _, issue := range response.JSON200.Issues {
+			repairs[issue.Item] = issue.Repair
+		}
+		require.Equal(t, map[types.UUID]v1.IntegrityIssueRepair{group.Id: v1.TransferOwnership, abandoned.Id: v1.Quarantine}, repairs)
+
+		// The admin becomes the owner, rather than the member who joined first, and the abandoned group is quarantined
+		repairResponse, err := testClient.RepairIntegrityWithResponse(context.Background(), authEditor)
+		require.NoError(t, err)
+		require.Equal(t, 200, repairResponse.StatusCode())
+
+		groupResponse, err := testClient.GetGroupByIDWithResponse(context.Background(), group.Id, authAs(t, sectorAPI, entries[0].(v1.Account)))
+		require.NoError(t, err)
+		require.Equal(t, 200, groupResponse.StatusCode())
+		require.Equal(t, []types.UUID{member, admin}, groupResponse.JSON200.Members)
+		require.Equal(t, map[string]v1.GroupRole{admin.String(): v1.Owner}, *groupResponse.JSON200.Roles)
+		require.False(t, sectorAPI.DB.Index(database.GroupStore).Has(abandoned.Id.String()))
+		require.True(t, sectorAPI.DB.Index(database.QuarantineStore).Has(abandoned.Id.String()))
+	})
+	t.Run("Peers", func(t *testing.T) {
+		preferred := "/ip4/104.131.131.82/tcp/4001/p2p/QmaCpDMGvV2BGHeYERUEnRQAwe3N8SzbUtfsmvsqQLuvuJ"
+
+		// Only admins can see or change the peers
+		nodeResponse, err := testClient.GetNodeWithResponse(context.Background(), authEditor)
+		require.NoError(t, err)
+		require.Equal(t, 403, nodeResponse.StatusCode())
+
+		sectorAPI.Admins = []types.UUID{testAuth.Account.Id}
+		defer func() { sectorAPI.Admins = nil }()
+
+		nodeResponse, err = testClient.GetNodeWithResponse(context.Background(), authEditor)
+		require.NoError(t, err)
+		require.Equal(t, 200, nodeResponse.StatusCode())
+		require.NotEmpty(t, nodeResponse.JSON200.Id)
+
+		peersResponse, err := testClient.ListPeersWithResponse(context.Background(), authEditor)
+		require.NoError(t, err)
+		require.Equal(t, 200, peersResponse.StatusCode())
+
+		// Addresses without a peer id are rejected
+		connectResponse, err := testClient.ConnectPeerWithResponse(context.Background(), v1.PeerAddress{Address: "/ip4/127.0.0.1/tcp/4001"}, authEditor)
+		require.NoError(t, err)
+		require.Equal(t, 400, connectResponse.StatusCode())
+
+		setResponse, err := testClient.SetPreferredPeersWithResponse(context.Background(), v1.PreferredPeers{Addresses: []string{"not an address"}}, authEditor)
+		require.NoError(t, err)
+		require.Equal(t, 400, setResponse.StatusCode())
+
+		// The preferred peers are saved once, however many times they are given
+		setResponse, err = testClient.SetPreferredPeersWithResponse(context.Background(), v1.PreferredPeers{Addresses: []string{preferred, preferred}}, authEditor)
+		require.NoError(t, err)
+		require.Equal(t, 200, setResponse.StatusCode())
+		require.Equal(t, []string{preferred}, setResponse.JSON200.Addresses)
+		defer sectorAPI.DB.Preferred.Set(nil)
+
+		getResponse, err := testClient.GetPreferredPeersWithResponse(context.Background(), authEditor)
+		require.NoError(t, err)
+		require.Equal(t, []string{preferred}, getResponse.JSON200.Addresses)
 	})
 }
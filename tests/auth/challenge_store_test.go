@@ -0,0 +1,101 @@
+package authTest
+
+import (
+	"Sector/internal/auth"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock is a clock that only moves when told to
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func TestChallengeStore(t *testing.T) {
+	newStore := func(t *testing.T, options auth.ChallengeStoreOptions) (*auth.MemoryChallengeStore, *fakeClock) {
+		clock := &fakeClock{now: time.Now()}
+		options.Clock = clock
+		store := auth.NewChallengeStore(options)
+		t.Cleanup(store.Close)
+		return store, clock
+	}
+
+	t.Run("Expiry", func(t *testing.T) {
+		store, clock := newStore(t, auth.ChallengeStoreOptions{TTL: time.Minute})
+		require.NoError(t, store.Add("alice", "first"))
+
+		clock.now = clock.now.Add(30 * time.Second)
+		require.NoError(t, store.Add("alice", "second"))
+		require.Equal(t, []string{"first", "second"}, store.Get("alice"))
+
+		// Each challenge lasts for the TTL from when it was issued
+		clock.now = clock.now.Add(30 * time.Second)
+		require.Equal(t, []string{"second"}, store.Get("alice"))
+
+		clock.now = clock.now.Add(30 * time.Second)
+		require.Empty(t, store.Get("alice"))
+		require.Equal(t, 0, store.Len())
+	})
+
+	t.Run("Reap", func(t *testing.T) {
+		store, clock := newStore(t, auth.ChallengeStoreOptions{TTL: time.Minute})
+		require.NoError(t, store.Add("alice", "challenge"))
+		require.NoError(t, store.Add("bob", "challenge"))
+
+		clock.now = clock.now.Add(time.Minute)
+		require.Equal(t, 2, store.Len())
+		store.Reap()
+		require.Equal(t, 0, store.Len())
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		store, _ := newStore(t, auth.ChallengeStoreOptions{})
+		require.NoError(t, store.Add("alice", "first"))
+		require.NoError(t, store.Add("alice", "second"))
+
+		// Answering one challenge leaves the others outstanding
+		require.True(t, store.Remove("alice", "first"))
+		require.False(t, store.Remove("alice", "first"))
+		require.Equal(t, []string{"second"}, store.Get("alice"))
+		require.Equal(t, 1, store.Len())
+	})
+
+	t.Run("Per Key Limit", func(t *testing.T) {
+		store, clock := newStore(t, auth.ChallengeStoreOptions{TTL: time.Minute, PerKey: 3})
+		require.NoError(t, store.Add("alice", "first"))
+		require.NoError(t, store.Add("alice", "second"))
+		require.NoError(t, store.Add("alice", "third"))
+
+		// New challenges are refused rather than dropping the ones that are outstanding
+		require.ErrorIs(t, store.Add("alice", "fourth"), auth.ErrTooManyChallenges)
+		require.Equal(t, []string{"first", "second", "third"}, store.Get("alice"))
+		require.NoError(t, store.Add("bob", "first"))
+		require.Equal(t, 4, store.Len())
+
+		// Room is made once one is answered, or they expire
+		require.True(t, store.Remove("alice", "first"))
+		require.NoError(t, store.Add("alice", "fourth"))
+		clock.now = clock.now.Add(time.Minute)
+		require.NoError(t, store.Add("alice", "fifth"))
+		require.Equal(t, []string{"fifth"}, store.Get("alice"))
+	})
+
+	t.Run("Total Limit", func(t *testing.T) {
+		store, clock := newStore(t, auth.ChallengeStoreOptions{TTL: time.Minute, Total: 2})
+		require.NoError(t, store.Add("alice", "challenge"))
+		require.NoError(t, store.Add("bob", "challenge"))
+		require.ErrorIs(t, store.Add("carol", "challenge"), auth.ErrTooManyChallenges)
+
+		// Room is made once challenges expire
+		clock.now = clock.now.Add(time.Minute)
+		require.NoError(t, store.Add("carol", "challenge"))
+		require.Equal(t, []string{"challenge"}, store.Get("carol"))
+		require.Equal(t, 1, store.Len())
+	})
+}
@@ -0,0 +1,160 @@
+package authTest
+
+import (
+	"Sector/internal/auth"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"encoding/base64"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+// verify parses a token with the keyring, returning its subject
+func verify(k *auth.Keyring, token string) (string, error) {
+	claims := &jwt.RegisteredClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, k.Keyfunc)
+	return claims.Subject, err
+}
+
+func TestKeyring(t *testing.T) {
+	claims := jwt.RegisteredClaims{Subject: "user"}
+
+	t.Run("Rotation", func(t *testing.T) {
+		clock := &fakeClock{now: time.Now()}
+		k := auth.NewKeyring(auth.KeyringOptions{
+			RotationInterval: time.Hour,
+			GracePeriod:      2 * time.Hour,
+			Clock:            clock,
+		})
+
+		old, err := k.Sign(claims)
+		require.NoError(t, err)
+
+		// Once the key is due it is replaced, but still verifies the tokens it signed
+		clock.now = clock.now.Add(time.Hour)
+		current, err := k.Sign(claims)
+		require.NoError(t, err)
+		oldToken, _, err := jwt.NewParser().ParseUnverified(old, &jwt.RegisteredClaims{})
+		require.NoError(t, err)
+		currentToken, _, err := jwt.NewParser().ParseUnverified(current, &jwt.RegisteredClaims{})
+		require.NoError(t, err)
+		require.NotEqual(t, oldToken.Header["kid"], currentToken.Header["kid"])
+
+		subject, err := verify(k, old)
+		require.NoError(t, err)
+		require.Equal(t, "user", subject)
+
+		// Until the grace period is over
+		clock.now = clock.now.Add(2 * time.Hour)
+		_, err = verify(k, old)
+		require.ErrorIs(t, err, auth.ErrUnknownKey)
+	})
+
+	t.Run("Persisted", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "jwt_keys.json")
+		k, err := auth.OpenKeyring(path, auth.KeyringOptions{Algorithm: auth.EdDSA})
+		require.NoError(t, err)
+		token, err := k.Sign(claims)
+		require.NoError(t, err)
+
+		reopened, err := auth.OpenKeyring(path, auth.KeyringOptions{Algorithm: auth.EdDSA})
+		require.NoError(t, err)
+		_, err = verify(reopened, token)
+		require.NoError(t, err)
+
+		// Changing the algorithm replaces the key, without ending the sessions of the old one
+		changed, err := auth.OpenKeyring(path, auth.KeyringOptions{Algorithm: auth.ES256})
+		require.NoError(t, err)
+		_, err = verify(changed, token)
+		require.NoError(t, err)
+		newToken, err := changed.Sign(claims)
+		require.NoError(t, err)
+		parsed, _, err := jwt.NewParser().ParseUnverified(newToken, &jwt.RegisteredClaims{})
+		require.NoError(t, err)
+		require.Equal(t, auth.ES256, parsed.Method.Alg())
+	})
+
+	t.Run("Legacy Secret", func(t *testing.T) {
+		secret := []byte("testing secret")
+		legacy, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(secret)
+		require.NoError(t, err)
+
+		k, err := auth.OpenKeyring(filepath.Join(t.TempDir(), "jwt_keys.json"), auth.KeyringOptions{LegacySecret: secret})
+		require.NoError(t, err)
+		_, err = verify(k, legacy)
+		require.NoError(t, err)
+
+		// Only for the tokens without a key id, and only with the algorithm of the key
+		forged, err := jwt.NewWithClaims(jwt.SigningMethodHS384, claims).SignedString(secret)
+		require.NoError(t, err)
+		_, err = verify(k, forged)
+		require.ErrorIs(t, err, auth.ErrUnsupportedAlgorithm)
+
+		// Sessions signed in to before there was a keyring keep working, their tokens have no type
+		service := &auth.Service{Keyring: k, Revocations: auth.NewRevocationList("")}
+		session, err := jwt.NewWithClaims(jwt.SigningMethodHS256, struct {
+			UserID   string `json:"user_id"`
+			Username string `json:"username"`
+			jwt.RegisteredClaims
+		}{
+			UserID:   "user",
+			Username: "username",
+			RegisteredClaims: jwt.RegisteredClaims{
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+				IssuedAt:  jwt.NewNumericDate(time.Now()),
+				Subject:   "user",
+			},
+		}).SignedString(secret)
+		require.NoError(t, err)
+		validated, err := service.ValidateToken(session)
+		require.NoError(t, err)
+		require.Equal(t, "user", validated.UserID)
+		require.Equal(t, "username", validated.Username)
+
+		// But they cannot be used as refresh tokens
+		_, err = service.RefreshTokens(session)
+		require.ErrorIs(t, err, auth.ErrWrongTokenType)
+
+		// And tokens signed with a key of the keyring always need a type
+		untyped, err := k.Sign(claims)
+		require.NoError(t, err)
+		_, err = service.ValidateToken(untyped)
+		require.ErrorIs(t, err, auth.ErrWrongTokenType)
+	})
+
+	t.Run("JWKS", func(t *testing.T) {
+		// Secret keys are never published
+		require.Empty(t, auth.NewKeyring(auth.KeyringOptions{}).JWKS().Keys)
+
+		ed := auth.NewKeyring(auth.KeyringOptions{Algorithm: auth.EdDSA})
+		token, err := ed.Sign(claims)
+		require.NoError(t, err)
+		set := ed.JWKS()
+		require.Len(t, set.Keys, 1)
+		require.Equal(t, "OKP", set.Keys[0].KeyType)
+		x, err := base64.RawURLEncoding.DecodeString(set.Keys[0].X)
+		require.NoError(t, err)
+		_, err = jwt.Parse(token, func(*jwt.Token) (interface{}, error) { return ed25519.PublicKey(x), nil })
+		require.NoError(t, err)
+
+		es := auth.NewKeyring(auth.KeyringOptions{Algorithm: auth.ES256})
+		token, err = es.Sign(claims)
+		require.NoError(t, err)
+		set = es.JWKS()
+		require.Len(t, set.Keys, 1)
+		require.Equal(t, "EC", set.Keys[0].KeyType)
+		x, err = base64.RawURLEncoding.DecodeString(set.Keys[0].X)
+		require.NoError(t, err)
+		y, err := base64.RawURLEncoding.DecodeString(set.Keys[0].Y)
+		require.NoError(t, err)
+		public := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		_, err = jwt.Parse(token, func(*jwt.Token) (interface{}, error) { return public, nil })
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,158 @@
+package authTest
+
+import (
+	"Sector/internal/auth"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevocationList(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "revoked_tokens.json")
+
+	t.Run("Persisted", func(t *testing.T) {
+		list, err := auth.OpenRevocationList(path)
+		require.NoError(t, err)
+		require.False(t, list.IsRevoked("token"))
+
+		require.NoError(t, list.Revoke("token", time.Now().Add(time.Hour)))
+		require.True(t, list.IsRevoked("token"))
+
+		// Revoked ids are still revoked once the list is opened again
+		reopened, err := auth.OpenRevocationList(path)
+		require.NoError(t, err)
+		require.True(t, reopened.IsRevoked("token"))
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		list, err := auth.OpenRevocationList(path)
+		require.NoError(t, err)
+
+		// Ids that have expired anyway are dropped the next time the list is saved
+		require.NoError(t, list.Revoke("expired", time.Now().Add(-time.Minute)))
+		require.NoError(t, list.Revoke("other", time.Now().Add(time.Hour)))
+		require.False(t, list.IsRevoked("expired"))
+		require.True(t, list.IsRevoked("other"))
+	})
+
+	t.Run("Revoke If New", func(t *testing.T) {
+		list, err := auth.OpenRevocationList(path)
+		require.NoError(t, err)
+
+		// Of many callers revoking the same id at once, only one is told it was new
+		var wg sync.WaitGroup
+		var first atomic.Int32
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				ok, err := list.RevokeIfNew("once", time.Now().Add(time.Hour))
+				if err != nil {
+					t.Error(err)
+				}
+				if ok {
+					first.Add(1)
+				}
+			}()
+		}
+		wg.Wait()
+		require.Equal(t, int32(1), first.Load())
+
+		reopened, err := auth.OpenRevocationList(path)
+		require.NoError(t, err)
+		require.True(t, reopened.IsRevoked("once"))
+		require.True(t, reopened.IsRevoked("token"))
+	})
+
+	t.Run("Saved as an Object", func(t *testing.T) {
+		// Lists saved before revocations were appended to the file can still be opened
+		legacy := filepath.Join(t.TempDir(), "revoked_tokens.json")
+		data, err := json.Marshal(map[string]time.Time{"token": time.Now().Add(time.Hour)})
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(legacy, data, 0600))
+
+		list, err := auth.OpenRevocationList(legacy)
+		require.NoError(t, err)
+		require.True(t, list.IsRevoked("token"))
+		require.NoError(t, list.Revoke("other", time.Now().Add(time.Hour)))
+
+		reopened, err := auth.OpenRevocationList(legacy)
+		require.NoError(t, err)
+		require.True(t, reopened.IsRevoked("token"))
+		require.True(t, reopened.IsRevoked("other"))
+	})
+}
+
+func TestTokens(t *testing.T) {
+	t.Setenv("JWT_SECRET", "testing secret")
+	service := auth.NewService()
+
+	t.Run("Refresh", func(t *testing.T) {
+		tokens, err := service.GenerateTokenPair("user", "name", "")
+		require.NoError(t, err)
+
+		// Refresh tokens cannot be used to make requests
+		_, err = service.ValidateToken(tokens.RefreshToken)
+		require.ErrorIs(t, err, auth.ErrWrongTokenType)
+
+		refreshed, err := service.RefreshTokens(tokens.RefreshToken)
+		require.NoError(t, err)
+		claims, err := service.ValidateToken(refreshed.AccessToken)
+		require.NoError(t, err)
+		require.Equal(t, "user", claims.UserID)
+
+		// Using a refresh token twice ends the whole session
+		_, err = service.RefreshTokens(tokens.RefreshToken)
+		require.ErrorIs(t, err, auth.ErrTokenRevoked)
+		_, err = service.ValidateToken(refreshed.AccessToken)
+		require.ErrorIs(t, err, auth.ErrTokenRevoked)
+		_, err = service.RefreshTokens(refreshed.RefreshToken)
+		require.ErrorIs(t, err, auth.ErrTokenRevoked)
+	})
+
+	t.Run("Refresh at Once", func(t *testing.T) {
+		tokens, err := service.GenerateTokenPair("user", "name", "")
+		require.NoError(t, err)
+
+		// Using a refresh token twice at the same time only gets one new pair of tokens
+		var wg sync.WaitGroup
+		var refreshed atomic.Int32
+		for i := 0; i < 2; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if _, err := service.RefreshTokens(tokens.RefreshToken); err == nil {
+					refreshed.Add(1)
+				}
+			}()
+		}
+		wg.Wait()
+		require.Equal(t, int32(1), refreshed.Load())
+	})
+
+	t.Run("Revoke Session", func(t *testing.T) {
+		tokens, err := service.GenerateTokenPair("user", "name", "")
+		require.NoError(t, err)
+		other, err := service.GenerateTokenPair("user", "name", "")
+		require.NoError(t, err)
+
+		claims, err := service.ValidateToken(tokens.AccessToken)
+		require.NoError(t, err)
+		require.NoError(t, service.RevokeSession(claims))
+
+		_, err = service.ValidateToken(tokens.AccessToken)
+		require.ErrorIs(t, err, auth.ErrTokenRevoked)
+		_, err = service.RefreshTokens(tokens.RefreshToken)
+		require.ErrorIs(t, err, auth.ErrTokenRevoked)
+
+		// Other sessions of the same user are left alone
+		_, err = service.ValidateToken(other.AccessToken)
+		require.NoError(t, err)
+	})
+}
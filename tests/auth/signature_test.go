@@ -0,0 +1,144 @@
+package authTest
+
+import (
+	"Sector/internal/auth"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// encodeKey encodes a public key as PEM, the way it is stored on an account
+func encodeKey(t *testing.T, key crypto.PublicKey) string {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+// encodePSSKey encodes an RSA public key as PEM with the id-RSASSA-PSS algorithm, restricted to the hash if one is given
+func encodePSSKey(t *testing.T, key *rsa.PublicKey, hash asn1.ObjectIdentifier) string {
+	type pssParameters struct {
+		Hash pkix.AlgorithmIdentifier `asn1:"explicit,optional,tag:0"`
+	}
+	type subjectPublicKeyInfo struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+
+	algorithm := pkix.AlgorithmIdentifier{Algorithm: asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 10}}
+	if hash != nil {
+		params, err := asn1.Marshal(pssParameters{Hash: pkix.AlgorithmIdentifier{Algorithm: hash}})
+		require.NoError(t, err)
+		algorithm.Parameters = asn1.RawValue{FullBytes: params}
+	}
+	keyBytes := x509.MarshalPKCS1PublicKey(key)
+	der, err := asn1.Marshal(subjectPublicKeyInfo{
+		Algorithm: algorithm,
+		PublicKey: asn1.BitString{Bytes: keyBytes, BitLength: 8 * len(keyBytes)},
+	})
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func TestVerifySignature(t *testing.T) {
+	message := make([]byte, 32)
+	_, err := rand.Read(message)
+	require.NoError(t, err)
+	challenge := base64.StdEncoding.EncodeToString(message)
+	sha256Hash := sha256.Sum256(message)
+	sha384Hash := sha512.Sum384(message)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	edPublic, edPrivate, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	p256Key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	p384Key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		key       crypto.PublicKey
+		signature func() ([]byte, error)
+	}{
+		{"RSA PKCS1v15", &rsaKey.PublicKey, func() ([]byte, error) {
+			return rsa.SignPKCS1v15(rand.Reader, rsaKey, crypto.SHA256, sha256Hash[:])
+		}},
+		{"RSA PSS", &rsaKey.PublicKey, func() ([]byte, error) {
+			return rsa.SignPSS(rand.Reader, rsaKey, crypto.SHA256, sha256Hash[:], nil)
+		}},
+		{"Ed25519", edPublic, func() ([]byte, error) {
+			return ed25519.Sign(edPrivate, message), nil
+		}},
+		{"ECDSA P-256", &p256Key.PublicKey, func() ([]byte, error) {
+			return ecdsa.SignASN1(rand.Reader, p256Key, sha256Hash[:])
+		}},
+		{"ECDSA P-384", &p384Key.PublicKey, func() ([]byte, error) {
+			return ecdsa.SignASN1(rand.Reader, p384Key, sha384Hash[:])
+		}},
+		{"ECDSA P-256 Raw", &p256Key.PublicKey, func() ([]byte, error) {
+			r, s, err := ecdsa.Sign(rand.Reader, p256Key, sha256Hash[:])
+			return append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...), err
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			signature, err := test.signature()
+			require.NoError(t, err)
+			signatureB64 := base64.StdEncoding.EncodeToString(signature)
+			key := encodeKey(t, test.key)
+			require.NoError(t, auth.VerifySignature(challenge, signatureB64, key))
+
+			// A signature of anything else is refused
+			other := base64.StdEncoding.EncodeToString([]byte("some other challenge"))
+			require.ErrorIs(t, auth.VerifySignature(other, signatureB64, key), auth.ErrInvalidSignature)
+		})
+	}
+
+	t.Run("RSA PSS Only", func(t *testing.T) {
+		sha256OID := asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+		pss, err := rsa.SignPSS(rand.Reader, rsaKey, crypto.SHA256, sha256Hash[:], nil)
+		require.NoError(t, err)
+		pkcs1, err := rsa.SignPKCS1v15(rand.Reader, rsaKey, crypto.SHA256, sha256Hash[:])
+		require.NoError(t, err)
+
+		for _, key := range []string{encodePSSKey(t, &rsaKey.PublicKey, nil), encodePSSKey(t, &rsaKey.PublicKey, sha256OID)} {
+			parsed, err := auth.ParsePublicKey(key)
+			require.NoError(t, err)
+			require.IsType(t, &auth.RSAPSSPublicKey{}, parsed)
+
+			require.NoError(t, auth.VerifySignature(challenge, base64.StdEncoding.EncodeToString(pss), key))
+			// The key may only make PSS signatures
+			require.ErrorIs(t, auth.VerifySignature(challenge, base64.StdEncoding.EncodeToString(pkcs1), key), auth.ErrInvalidSignature)
+		}
+
+		// Keys restricted to a hash other than SHA-256 cannot be checked
+		sha384OID := asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 2}
+		_, err = auth.ParsePublicKey(encodePSSKey(t, &rsaKey.PublicKey, sha384OID))
+		require.ErrorIs(t, err, auth.ErrUnsupportedKey)
+	})
+
+	t.Run("Unsupported Keys", func(t *testing.T) {
+		p224Key, err := ecdsa.GenerateKey(elliptic.P224(), rand.Reader)
+		require.NoError(t, err)
+
+		_, err = auth.ParsePublicKey(encodeKey(t, &p224Key.PublicKey))
+		require.ErrorIs(t, err, auth.ErrUnsupportedKey)
+		_, err = auth.ParsePublicKey("not a key")
+		require.ErrorIs(t, err, auth.ErrUnsupportedKey)
+		require.ErrorIs(t, auth.VerifySignature(challenge, "", "not a key"), auth.ErrUnsupportedKey)
+	})
+}
@@ -0,0 +1,136 @@
+package configTest
+
+import (
+	"Sector/internal/config"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+// writeConfig writes a config file to a temporary directory, returning its path
+func writeConfig(t *testing.T, contents string) string {
+	path := filepath.Join(t.TempDir(), "sector.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0644))
+	return path
+}
+
+func TestConfig(t *testing.T) {
+	t.Run("Defaults", func(t *testing.T) {
+		cfg, err := config.Load("")
+		require.NoError(t, err)
+		require.Equal(t, config.Default(), cfg)
+		require.Equal(t, "127.0.0.1:3000", cfg.Server.Address)
+		require.Equal(t, []string{"http://localhost:8000"}, cfg.Server.AllowedOrigins)
+	})
+
+	t.Run("File", func(t *testing.T) {
+		admin := uuid.New()
+		path := writeConfig(t, `
+server:
+  address: 0.0.0.0:8080
+database:
+  store_timeout: 2m
+auth:
+  challenges_per_user: 3
+rate_limits:
+  search: 5/1s
+log:
+  level: debug
+admins:
+  - `+admin.String()+`
+`)
+
+		cfg, err := config.Load(path)
+		require.NoError(t, err)
+		require.Equal(t, "0.0.0.0:8080", cfg.Server.Address)
+		require.Equal(t, 2*time.Minute, cfg.Database.StoreTimeout)
+		require.Equal(t, 3, cfg.Auth.ChallengesPerUser)
+		require.Equal(t, config.RateLimit{Requests: 5, Per: time.Second}, cfg.RateLimits.Search)
+		require.Equal(t, []uuid.UUID{admin}, cfg.Admins)
+		require.Equal(t, zapcore.DebugLevel, cfg.Log.Level)
+
+		// Settings the file leaves out keep their default
+		require.Equal(t, config.Default().Database.Cache, cfg.Database.Cache)
+	})
+
+	t.Run("Precedence", func(t *testing.T) {
+		path := writeConfig(t, "server:\n  address: 0.0.0.0:8080\nlog:\n  file: file.txt\n")
+		t.Setenv("SECTOR_CONFIG", path)
+		t.Setenv("LISTEN_ADDRESS", "0.0.0.0:9090")
+		t.Setenv("CORS_ORIGINS", "https://sector.example, http://localhost:8000,")
+		t.Setenv("LOG_FILE", "")
+
+		// The environment wins over the file, and flags over both
+		flags := flag.NewFlagSet("test", flag.ContinueOnError)
+		load := config.AddFlags(flags)
+		require.NoError(t, flags.Parse([]string{"-addr", "0.0.0.0:7070"}))
+		cfg, err := load()
+		require.NoError(t, err)
+		require.Equal(t, "0.0.0.0:7070", cfg.Server.Address)
+		require.Equal(t, []string{"https://sector.example", "http://localhost:8000"}, cfg.Server.AllowedOrigins)
+
+		// An empty variable leaves the file's value alone
+		require.Equal(t, "file.txt", cfg.Log.File)
+	})
+
+	t.Run("Rate Limits", func(t *testing.T) {
+		limit, err := config.ParseRateLimit("20/1m")
+		require.NoError(t, err)
+		require.Equal(t, config.RateLimit{Requests: 20, Per: time.Minute}, limit)
+
+		limit, err = config.ParseRateLimit("off")
+		require.NoError(t, err)
+		require.Zero(t, limit.Requests)
+
+		for _, invalid := range []string{"20", "x/1m", "20/x", "-1/1m", "20/0s"} {
+			_, err = config.ParseRateLimit(invalid)
+			require.Error(t, err, invalid)
+		}
+	})
+
+	t.Run("Validation", func(t *testing.T) {
+		// Settings the file does not know about are refused, rather than being silently ignored
+		_, err := config.Load(writeConfig(t, "server:\n  adress: 0.0.0.0:8080\n"))
+		require.Error(t, err)
+
+		t.Setenv("JWT_ALGORITHM", "none")
+		_, err = config.Load("")
+		require.ErrorContains(t, err, "jwt algorithm")
+
+		t.Setenv("JWT_ALGORITHM", "")
+		t.Setenv("RATE_LIMIT_WRITES", "lots")
+		_, err = config.Load("")
+		require.ErrorContains(t, err, "RATE_LIMIT_WRITES")
+
+		t.Setenv("RATE_LIMIT_WRITES", "")
+		t.Setenv("TRACING_SAMPLE_RATIO", "often")
+		_, err = config.Load("")
+		require.ErrorContains(t, err, "TRACING_SAMPLE_RATIO")
+
+		t.Setenv("TRACING_SAMPLE_RATIO", "")
+		t.Setenv("LOG_LEVEL", "loud")
+		_, err = config.Load("")
+		require.ErrorContains(t, err, "LOG_LEVEL")
+
+		cfg := config.Default()
+		cfg.Server.Address = "nowhere"
+		cfg.Auth.AccessTokenExpiry = 0
+		cfg.Tracing.Exporter = "jaeger"
+		cfg.Tracing.SampleRatio = 2
+		cfg.Log.Format = "xml"
+		cfg.Auth.ChallengesTotal = 0
+		err = cfg.Validate()
+		require.ErrorContains(t, err, "server address")
+		require.ErrorContains(t, err, "access token expiry")
+		require.ErrorContains(t, err, "tracing exporter")
+		require.ErrorContains(t, err, "tracing sample ratio")
+		require.ErrorContains(t, err, "log format")
+		require.ErrorContains(t, err, "challenges")
+	})
+}
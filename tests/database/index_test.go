@@ -0,0 +1,93 @@
+package databaseTest
+
+import (
+	"Sector/internal/database"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// document builds a minimal document as it would be stored in the database.
+func document(id string, createdAt time.Time, fields map[string]interface{}) map[string]interface{} {
+	doc := map[string]interface{}{
+		"id":         id,
+		"created_at": createdAt.Format(time.RFC3339Nano),
+	}
+	for k, v := range fields {
+		doc[k] = v
+	}
+	return doc
+}
+
+func TestIndex(t *testing.T) {
+	now := time.Now()
+
+	t.Run("Lookup", func(t *testing.T) {
+		idx := database.NewIndex("channel", "members")
+		idx.Put(document("a", now, map[string]interface{}{"channel": "c1", "members": []interface{}{"u1", "u2"}}))
+		idx.Put(document("b", now, map[string]interface{}{"channel": "c2", "members": []interface{}{"u2"}}))
+
+		require.ElementsMatch(t, []string{"a"}, idx.Lookup("channel", "c1"))
+		require.ElementsMatch(t, []string{"a", "b"}, idx.Lookup("channel", "c1", "c2"))
+		require.ElementsMatch(t, []string{"a", "b"}, idx.Lookup("members", "u2"))
+		require.Empty(t, idx.Lookup("channel", "c3"))
+		require.True(t, idx.Indexes("members"))
+		require.False(t, idx.Indexes("body"))
+	})
+
+	t.Run("Replace and Remove", func(t *testing.T) {
+		idx := database.NewIndex("channel")
+		idx.Put(document("a", now, map[string]interface{}{"channel": "c1"}))
+		idx.Put(document("a", now, map[string]interface{}{"channel": "c2"}))
+
+		require.Equal(t, 1, idx.Len())
+		require.Empty(t, idx.Lookup("channel", "c1"))
+		require.Equal(t, []string{"a"}, idx.Lookup("channel", "c2"))
+
+		idx.Put(document("b", now, nil))
+		require.ElementsMatch(t, []string{"a", "b"}, idx.IDs())
+
+		idx.Remove("a")
+		idx.Remove("b")
+		require.Equal(t, 0, idx.Len())
+		require.False(t, idx.Has("a"))
+		require.Empty(t, idx.Lookup("channel", "c2"))
+		require.Empty(t, idx.Range(nil, nil))
+	})
+
+	t.Run("Range", func(t *testing.T) {
+		idx := database.NewIndex()
+		idx.Put(document("new", now, nil))
+		idx.Put(document("old", now.AddDate(0, 0, -7), nil))
+		idx.Put(document("mid", now.AddDate(0, 0, -3), nil))
+
+		require.Equal(t, []string{"old", "mid", "new"}, idx.Range(nil, nil))
+
+		from := now.AddDate(0, 0, -5)
+		require.Equal(t, []string{"mid", "new"}, idx.Range(&from, nil))
+
+		until := now.AddDate(0, 0, -1)
+		require.Equal(t, []string{"old", "mid"}, idx.Range(nil, &until))
+		require.Equal(t, []string{"mid"}, idx.Range(&from, &until))
+	})
+
+	t.Run("Unique", func(t *testing.T) {
+		idx := database.NewIndex("username").Unique("username")
+		idx.Put(document("a", now, map[string]interface{}{"username": "Alice"}))
+
+		require.Equal(t, []string{"a"}, idx.LookupFold("username", "aLICE"))
+		require.Empty(t, idx.Lookup("username", "aLICE"))
+
+		// Only values a document does not already have are claimed
+		require.Empty(t, idx.Claims(document("a", now, map[string]interface{}{"username": "alice"})))
+		require.Equal(t, map[string]string{"username": "alice"}, idx.Claims(document("b", now, map[string]interface{}{"username": "ALICE"})))
+
+		idx.Put(document("a", now, map[string]interface{}{"username": "Bob"}))
+		require.Empty(t, idx.LookupFold("username", "alice"))
+		require.Equal(t, []string{"a"}, idx.LookupFold("username", "bob"))
+
+		idx.Clear()
+		require.Empty(t, idx.LookupFold("username", "bob"))
+	})
+}
@@ -0,0 +1,43 @@
+package databaseTest
+
+import (
+	"Sector/internal/database"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreferredPeers(t *testing.T) {
+	mars := "/ip4/104.131.131.82/tcp/4001/p2p/QmaCpDMGvV2BGHeYERUEnRQAwe3N8SzbUtfsmvsqQLuvuJ"
+	bootstrap := "/dnsaddr/bootstrap.libp2p.io/p2p/QmNnooDu7bfjPFoTZYxMNLWUQJyrVwtbZg5gBMjTezGAJN"
+
+	t.Run("Saved Across Restarts", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "preferred_peers.json")
+		peers, err := database.OpenPreferredPeers(path)
+		require.NoError(t, err)
+		require.Empty(t, peers.Addresses())
+
+		require.NoError(t, peers.Set([]string{mars, bootstrap, mars}))
+		require.Equal(t, []string{bootstrap, mars}, peers.Addresses())
+		require.Len(t, peers.IDs(), 2)
+
+		reopened, err := database.OpenPreferredPeers(path)
+		require.NoError(t, err)
+		require.Equal(t, []string{bootstrap, mars}, reopened.Addresses())
+	})
+
+	t.Run("Invalid Addresses", func(t *testing.T) {
+		peers, err := database.OpenPreferredPeers("")
+		require.NoError(t, err)
+		require.NoError(t, peers.Set([]string{mars}))
+
+		// Nothing is changed unless every address ends in a peer id
+		for _, address := range []string{"not an address", "/ip4/127.0.0.1/tcp/4001"} {
+			err := peers.Set([]string{bootstrap, address})
+			require.True(t, errors.Is(err, database.ErrInvalidAddress), address)
+		}
+		require.Equal(t, []string{mars}, peers.Addresses())
+	})
+}
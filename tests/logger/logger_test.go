@@ -0,0 +1,67 @@
+package loggerTest
+
+import (
+	"Sector/internal/config"
+	"Sector/internal/logger"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestLogger(t *testing.T) {
+	t.Run("Level and format", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "log.txt")
+		log, err := logger.NewLogger(config.LogConfig{File: path, Format: config.JSONLogFormat, Level: zapcore.WarnLevel})
+		require.NoError(t, err)
+
+		log.Info("Left out")
+		log.Warn("Kept", zap.String("store", "accounts"))
+		require.NoError(t, log.Sync())
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.NotContains(t, string(data), "Left out")
+		require.Contains(t, string(data), `"msg":"Kept","store":"accounts"`)
+
+		_, err = logger.NewLogger(config.LogConfig{File: path, Format: "xml"})
+		require.Error(t, err)
+	})
+
+	t.Run("Rotation", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "log.txt")
+
+		// An expired backup is removed when the logger starts
+		expired := filepath.Join(dir, "log-"+time.Now().Add(-48*time.Hour).Format("2006-01-02T15-04-05.000")+".txt")
+		require.NoError(t, os.WriteFile(expired, []byte("old\n"), 0644))
+
+		log, err := logger.NewLogger(config.LogConfig{File: path, Format: config.ConsoleLogFormat, MaxSize: 1, MaxAge: 24 * time.Hour, MaxBackups: 2})
+		require.NoError(t, err)
+		require.NoFileExists(t, expired)
+
+		// Each megabyte written rotates the file, and only the newest backups are kept
+		line := strings.Repeat("x", 64*1024)
+		for i := 0; i < 16*4; i++ {
+			log.Info(line)
+			if i%16 == 0 {
+				time.Sleep(2 * time.Millisecond) // Backups are named to the millisecond
+			}
+		}
+		require.NoError(t, log.Sync())
+
+		backups, err := filepath.Glob(filepath.Join(dir, "log-*.txt"))
+		require.NoError(t, err)
+		require.Len(t, backups, 2)
+		for _, backup := range append(backups, path) {
+			info, err := os.Stat(backup)
+			require.NoError(t, err)
+			require.LessOrEqual(t, info.Size(), int64(1024*1024))
+		}
+	})
+}
@@ -0,0 +1,50 @@
+package middlewareTest
+
+import (
+	"Sector/internal/logger"
+	"Sector/internal/middleware"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestRequestLogger(t *testing.T) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	handler := middleware.RequestLogger(zap.New(core))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context(), zap.NewNop()).Debug("In handler")
+		w.WriteHeader(http.StatusTeapot)
+	}))
+
+	serve := func(id string) string {
+		request := httptest.NewRequest("GET", "/v1/api/accounts", nil)
+		if id != "" {
+			request.Header.Set(middleware.RequestIDHeader, id)
+		}
+		response := httptest.NewRecorder()
+		handler.ServeHTTP(response, request)
+		return response.Header().Get(middleware.RequestIDHeader)
+	}
+
+	// The id of the client is kept, and both the handler's log and the request's log note it
+	require.Equal(t, "client-id", serve("client-id"))
+	entries := logs.TakeAll()
+	require.Len(t, entries, 2)
+	require.Equal(t, "In handler", entries[0].Message)
+	require.Equal(t, "client-id", entries[0].ContextMap()["request_id"])
+	require.Equal(t, "Handled request", entries[1].Message)
+	require.Equal(t, "client-id", entries[1].ContextMap()["request_id"])
+	require.Equal(t, int64(http.StatusTeapot), entries[1].ContextMap()["code"])
+
+	// Requests without an id, or with one that could not safely be logged, are given one
+	generated := serve("")
+	require.NotEmpty(t, generated)
+	require.NotEqual(t, generated, serve(""))
+	require.NotEqual(t, "bad id\nforged line", serve("bad id\nforged line"))
+	require.NotEqual(t, strings.Repeat("a", 200), serve(strings.Repeat("a", 200)))
+}
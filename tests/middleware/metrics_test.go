@@ -0,0 +1,27 @@
+package middlewareTest
+
+import (
+	"Sector/internal/metrics"
+	"Sector/internal/middleware"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetrics(t *testing.T) {
+	handler := middleware.Metrics(func(r *http.Request) string { return "TestOperation" })(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	}))
+
+	before := testutil.ToFloat64(metrics.HTTPRequests.WithLabelValues("TestOperation", "GET", "418"))
+	for i := 0; i < 3; i++ {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil))
+	}
+
+	// Each request is counted under its operation and status, and its duration recorded
+	require.Equal(t, before+3, testutil.ToFloat64(metrics.HTTPRequests.WithLabelValues("TestOperation", "GET", "418")))
+	require.Equal(t, 1, testutil.CollectAndCount(metrics.HTTPDuration, "sector_http_request_duration_seconds"))
+}
@@ -0,0 +1,82 @@
+package middlewareTest
+
+import (
+	"Sector/internal/config"
+	"Sector/internal/middleware"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock is a clock that only moves when told to
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func TestRateLimiter(t *testing.T) {
+	t.Run("Token Bucket", func(t *testing.T) {
+		clock := &fakeClock{now: time.Now()}
+		limiter := middleware.NewRateLimiter(config.RateLimit{Requests: 2, Per: time.Minute}, clock)
+
+		// The whole allowance can be used at once
+		for range 2 {
+			allowed, _ := limiter.Allow("client")
+			require.True(t, allowed)
+		}
+		allowed, retryAfter := limiter.Allow("client")
+		require.False(t, allowed)
+		require.Equal(t, 30*time.Second, retryAfter)
+
+		// Each client has an allowance of its own
+		allowed, _ = limiter.Allow("other")
+		require.True(t, allowed)
+
+		// The allowance refills evenly over the period
+		clock.now = clock.now.Add(30 * time.Second)
+		allowed, _ = limiter.Allow("client")
+		require.True(t, allowed)
+		allowed, _ = limiter.Allow("client")
+		require.False(t, allowed)
+	})
+
+	t.Run("Off", func(t *testing.T) {
+		limiter := middleware.NewRateLimiter(config.RateLimit{}, nil)
+		for range 100 {
+			allowed, _ := limiter.Allow("client")
+			require.True(t, allowed)
+		}
+	})
+
+	t.Run("Route Groups", func(t *testing.T) {
+		search := middleware.NewRateLimiter(config.RateLimit{Requests: 1, Per: time.Minute}, nil)
+		writes := middleware.NewRateLimiter(config.RateLimit{Requests: 1, Per: time.Minute}, nil)
+		handler := middleware.LimitRouteGroups(search, writes, middleware.CallerID)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		serve := func(method, path string) *httptest.ResponseRecorder {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(method, path, nil))
+			return recorder
+		}
+
+		require.Equal(t, http.StatusOK, serve("POST", "/v1/api/message/search").Code)
+		limited := serve("POST", "/v1/api/message/search")
+		require.Equal(t, http.StatusTooManyRequests, limited.Code)
+		require.Equal(t, "60", limited.Header().Get("Retry-After"))
+
+		// Writes have a limit of their own, and reads are not limited
+		require.Equal(t, http.StatusOK, serve("PUT", "/v1/api/group/").Code)
+		require.Equal(t, http.StatusTooManyRequests, serve("DELETE", "/v1/api/group/id").Code)
+		for range 3 {
+			require.Equal(t, http.StatusOK, serve("GET", "/v1/api/group/id").Code)
+		}
+	})
+}
@@ -0,0 +1,60 @@
+package tracingTest
+
+import (
+	"Sector/internal/config"
+	"Sector/internal/tracing"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracing(t *testing.T) {
+	// Propagate the trace context of requests, without exporting anything
+	shutdown, err := tracing.Setup(context.Background(), config.TracingConfig{Exporter: config.NoExporter, SampleRatio: 1})
+	require.NoError(t, err)
+	defer shutdown(context.Background())
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	defer otel.SetTracerProvider(previous)
+
+	handler := tracing.Middleware(func(r *http.Request) string { return "TestOperation" })(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, span := tracing.Start(r.Context(), "getItem")
+		span.End()
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+
+	request := httptest.NewRequest("GET", "/v1/api/accounts", nil)
+	request.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	handler.ServeHTTP(httptest.NewRecorder(), request)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	child, server := spans[0], spans[1]
+
+	// The request continues the caller's trace, and the spans started by the handler are part of it
+	require.Equal(t, "TestOperation", server.Name())
+	require.Equal(t, trace.SpanKindServer, server.SpanKind())
+	require.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", server.SpanContext().TraceID().String())
+	require.Equal(t, "00f067aa0ba902b7", server.Parent().SpanID().String())
+	require.Equal(t, server.SpanContext().SpanID(), child.Parent().SpanID())
+
+	// Server errors mark the span as failed
+	require.Equal(t, codes.Error, server.Status().Code)
+}
+
+func TestSetup(t *testing.T) {
+	shutdown, err := tracing.Setup(context.Background(), config.TracingConfig{Exporter: config.StdoutExporter, SampleRatio: 1})
+	require.NoError(t, err)
+	require.NoError(t, shutdown(context.Background()))
+}